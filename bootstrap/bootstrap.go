@@ -18,19 +18,24 @@ package bootstrap
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
 	"sync"
+	"sync/atomic"
 	"syscall"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/environment"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/flags"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/health"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/logging"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/registration"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/shutdown"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -41,6 +46,13 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 )
 
+// BootstrapReadinessCheckName is the name of the readiness check which fails until all the bootstrap handlers have run
+// successfully, so the service isn't reported as ready before the handlers have registered their own readiness checks.
+const BootstrapReadinessCheckName = "Bootstrap"
+
+// registerWithRegistry registers the service with the Registry, replaced by the tests
+var registerWithRegistry = registration.RegisterWithRegistry
+
 // Deferred defines the signature of a function returned by RunAndReturnWaitGroup that should be executed via defer.
 type Deferred func()
 
@@ -98,10 +110,16 @@ func RunAndReturnWaitGroup(
 	var wg sync.WaitGroup
 	deferred := func() {}
 
+	// The service key is overridden before anything uses it so the override is used for the Registry, the
+	// Configuration Provider and the metrics alike.
+	serviceKey, keyErr := resolveServiceKey(serviceKey, commonFlags.ServiceKey())
+
 	// Check if service provided an initial Logging Client to use. If not create one and add it to the DIC.
 	lc := container.LoggingClientFrom(dic.Get)
 	if lc == nil {
-		lc = logger.NewClient(serviceKey, models.InfoLog)
+		lc = logging.NewClient(serviceKey, models.InfoLog, environment.GetLogFormat())
+		sampling := environment.GetLogSamplingInfo(lc)
+		lc = logging.NewSamplingClient(lc, sampling.Every, sampling.Interval)
 		dic.Update(di.ServiceConstructorMap{
 			container.LoggingClientInterfaceName: func(get di.Get) interface{} {
 				return lc
@@ -109,13 +127,70 @@ func RunAndReturnWaitGroup(
 		})
 	}
 
+	if keyErr != nil {
+		fatalError(keyErr, lc)
+	}
+
+	if len(commonFlags.ServiceKey()) > 0 {
+		lc.Infof("Service key overridden to '%s' by the -sk/--serviceKey flag", serviceKey)
+	}
+
+	dic.Update(di.ServiceConstructorMap{
+		container.ServiceKeyName: func(get di.Get) interface{} {
+			return serviceKey
+		},
+	})
+
+	// The readiness checks are registered as the service's dependencies are set up by the bootstrap handlers
+	if container.ReadinessFrom(dic.Get) == nil {
+		readiness := health.NewReadiness()
+		dic.Update(di.ServiceConstructorMap{
+			container.ReadinessInterfaceName: func(get di.Get) interface{} {
+				return readiness
+			},
+		})
+	}
+
+	// The cleanup functions registered by the bootstrap handlers are run in priority order once the context is
+	// cancelled, which the returned WaitGroup waits for
+	shutdownRegistry := shutdown.NewRegistry(lc)
+	dic.Update(di.ServiceConstructorMap{
+		container.ShutdownRegistryInterfaceName: func(get di.Get) interface{} {
+			return shutdownRegistry
+		},
+	})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		shutdownRegistry.Run()
+	}()
+
 	utils.AdaptLogrusBasedLogging(lc)
 	translateInterruptToCancel(ctx, &wg, cancel)
 
+	watchdog := startStartupWatchdog(startupTimer.Deadline(), startupTimer.RemainingUntilDeadline(), cancel, lc)
+
 	envVars := environment.NewVariables(lc)
 
+	// Validate only checks the configuration and dependencies, then exits without starting the service
+	if commonFlags.Validate() {
+		passed := validateService(ctx, commonFlags, envVars, serviceKey, configStem, serviceConfig, startupTimer, dic, useSecretProvider, serviceType, lc, validateOutput)
+		watchdog.stop()
+		cancel()
+
+		exitCode := 0
+		if !passed {
+			exitCode = 1
+		}
+		exitFunc(exitCode)
+		return &wg, deferred, false
+	}
+
 	var secretProvider interfaces.SecretProviderExt
 	if useSecretProvider {
+		watchdog.setPending("SecretProvider")
 		secretProvider, err = secret.NewSecretProvider(serviceConfig, envVars, ctx, startupTimer, dic, serviceKey)
 		if err != nil {
 			fatalError(fmt.Errorf("failed to create SecretProvider: %s", err.Error()), lc)
@@ -125,7 +200,8 @@ func RunAndReturnWaitGroup(
 	// The SecretProvider is initialized and placed in the DIS as part of processing the configuration due
 	// to the need for it to be used to get Access Token for the Configuration Provider and having to wait to
 	// initialize it until after the configuration is loaded from file.
-	configProcessor := config.NewProcessor(commonFlags, envVars, startupTimer, ctx, &wg, configUpdated, dic)
+	watchdog.setPending("Configuration")
+	configProcessor := config.NewProcessor(commonFlags, envVars, startupTimer, ctx, &wg, configUpdated, dic, config.WithOverrideTracing())
 	if err := configProcessor.Process(serviceKey, serviceType, configStem, serviceConfig, secretProvider, secret.NewJWTSecretProvider(secretProvider)); err != nil {
 		fatalError(err, lc)
 	}
@@ -134,7 +210,8 @@ func RunAndReturnWaitGroup(
 
 	envUseRegistry, wasOverridden := envVars.UseRegistry()
 	if envUseRegistry || (commonFlags.UseRegistry() && !wasOverridden) {
-		registryClient, err = registration.RegisterWithRegistry(
+		watchdog.setPending("Registry")
+		registryClient, err = registerWithRegistry(
 			ctx,
 			startupTimer,
 			serviceConfig,
@@ -145,13 +222,17 @@ func RunAndReturnWaitGroup(
 			fatalError(err, lc)
 		}
 
-		deferred = func() {
-			lc.Info("Un-Registering service from the Registry")
-			err := registryClient.Unregister()
-			if err != nil {
-				lc.Error("Unable to Un-Register service from the Registry", "error", err.Error())
-			}
+		registration.StartAccessTokenRenewal(ctx, &wg, registryClient, lc)
+
+		unregister, err := registration.NewUnregisterFunc(registryClient, serviceConfig, serviceKey, lc, dic)
+		if err != nil {
+			fatalError(err, lc)
 		}
+
+		// Un-registered first on shutdown so upstreams stop routing to the service before its servers are stopped.
+		// Also deferred for when the service exits without the context being cancelled, only un-registering once.
+		shutdownRegistry.Register("Registry", shutdown.PriorityRegistry, unregister)
+		deferred = unregister
 	}
 
 	dic.Update(di.ServiceConstructorMap{
@@ -166,15 +247,7 @@ func RunAndReturnWaitGroup(
 		},
 	})
 
-	// call individual bootstrap handlers.
-	startedSuccessfully := true
-	for i := range handlers {
-		if !handlers[i](ctx, &wg, startupTimer, dic) {
-			cancel()
-			startedSuccessfully = false
-			break
-		}
-	}
+	startedSuccessfully := runBootstrapHandlers(ctx, cancel, &wg, startupTimer, dic, handlers, watchdog)
 
 	// Service that don't use the Security Provider also will not collect metrics. These are the security services that
 	// run during bootstrapping of the secure deployment
@@ -243,6 +316,49 @@ func Run(
 	wg.Wait()
 }
 
+// runBootstrapHandlers calls the individual bootstrap handlers in order, stopping at the first to fail. Startup fails
+// when any handler fails or the startup deadline is exceeded.
+func runBootstrapHandlers(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	wg *sync.WaitGroup,
+	startupTimer startup.Timer,
+	dic *di.Container,
+	handlers []interfaces.BootstrapHandler,
+	watchdog *startupWatchdog) bool {
+
+	// The HTTP server is started by one of the handlers, so the readiness probe can be called before the rest have run
+	var bootstrapComplete atomic.Bool
+	if readiness := container.ReadinessFrom(dic.Get); readiness != nil {
+		readiness.RegisterCheck(BootstrapReadinessCheckName, func() error {
+			if !bootstrapComplete.Load() {
+				return errors.New("bootstrap has not completed")
+			}
+			return nil
+		})
+	}
+
+	startedSuccessfully := true
+	for i := range handlers {
+		watchdog.setPending(handlerName(handlers[i]))
+		if !handlers[i](ctx, wg, startupTimer, dic) {
+			cancel()
+			startedSuccessfully = false
+			break
+		}
+	}
+
+	if watchdog.stop() {
+		// A handler may still report success after the context is cancelled
+		cancel()
+		startedSuccessfully = false
+	}
+
+	bootstrapComplete.Store(startedSuccessfully)
+
+	return startedSuccessfully
+}
+
 func registerMetrics(metricsManager interfaces.MetricsManager, metrics map[string]interface{}, lc logger.LoggingClient) {
 	for metricName, metric := range metrics {
 		err := metricsManager.Register(metricName, metric, nil)
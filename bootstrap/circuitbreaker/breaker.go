@@ -0,0 +1,287 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	clientinterfaces "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+)
+
+// State is the state of a Breaker, which is reported as the value of the client's circuit breaker state metric
+type State int64
+
+const (
+	// StateClosed is the state in which the requests are sent to the service
+	StateClosed State = iota
+	// StateHalfOpen is the state once the cooldown has elapsed, in which a single trial request is sent to the service
+	// to determine whether to close or re-open the Breaker
+	StateHalfOpen
+	// StateOpen is the state in which the requests fail immediately with ErrOpen, without being sent to the service
+	StateOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "open"
+	}
+}
+
+// DefaultCooldown is how long a Breaker stays open before sending a trial request when the Cooldown isn't set
+const DefaultCooldown = time.Second * 30
+
+// ErrOpen is returned for the requests which aren't sent to the service as the Breaker is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// idempotentMethods are the methods of the requests which are safe to retry
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// Breaker stops sending requests to a failing service once the number of consecutive failed requests reaches the
+// threshold, so callers fail fast rather than waiting on the service. Once the cooldown has elapsed a trial request
+// is sent, which closes the Breaker when it succeeds and re-opens it otherwise. A request fails when it can't be sent
+// or the service responds with a 5xx status. The failed idempotent requests are also retried, while the Breaker is
+// closed, after the retry interval.
+type Breaker struct {
+	serviceKey       string
+	failureThreshold int
+	cooldown         time.Duration
+	retries          int
+	retryInterval    time.Duration
+	lc               logger.LoggingClient
+	now              func() time.Time
+	state            State
+	failures         int
+	openedAt         time.Time
+	trialInFlight    bool
+	mutex            sync.Mutex
+}
+
+// NewBreaker returns the Breaker for the service, which opens after failureThreshold consecutive failed requests and
+// stays open for the cooldown. The Breaker never opens when the failureThreshold is 0. Failed idempotent requests are
+// retried up to retries times after the retryInterval.
+func NewBreaker(
+	serviceKey string,
+	failureThreshold int,
+	cooldown time.Duration,
+	retries int,
+	retryInterval time.Duration,
+	lc logger.LoggingClient) (*Breaker, error) {
+	if failureThreshold < 0 {
+		return nil, fmt.Errorf("FailureThreshold %d for '%s' is invalid", failureThreshold, serviceKey)
+	}
+	if retries < 0 {
+		return nil, fmt.Errorf("Retries %d for '%s' is invalid", retries, serviceKey)
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultCooldown
+	}
+
+	return &Breaker{
+		serviceKey:       serviceKey,
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		retries:          retries,
+		retryInterval:    retryInterval,
+		lc:               lc,
+		now:              time.Now,
+	}, nil
+}
+
+// State returns the Breaker's current state. An open Breaker whose cooldown has elapsed is reported as open until the
+// trial request is sent.
+func (b *Breaker) State() State {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.state
+}
+
+// allow returns ErrOpen unless the request can be sent, moving an open Breaker to half-open once its cooldown has
+// elapsed so the request is sent as the trial request
+func (b *Breaker) allow() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return ErrOpen
+		}
+		b.setState(StateHalfOpen)
+		b.trialInFlight = true
+	case StateHalfOpen:
+		if b.trialInFlight {
+			return ErrOpen
+		}
+		b.trialInFlight = true
+	}
+
+	return nil
+}
+
+// record updates the Breaker with the outcome of a request which was sent
+func (b *Breaker) record(failed bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case StateHalfOpen:
+		b.trialInFlight = false
+		if failed {
+			b.open()
+		} else {
+			b.failures = 0
+			b.setState(StateClosed)
+		}
+	case StateClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+
+		b.failures++
+		if b.failureThreshold > 0 && b.failures >= b.failureThreshold {
+			b.open()
+		}
+	}
+}
+
+// release ends the trial request without an outcome, i.e. when the caller cancelled it, so another can be sent
+func (b *Breaker) release() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.state == StateHalfOpen {
+		b.trialInFlight = false
+	}
+}
+
+func (b *Breaker) open() {
+	b.openedAt = b.now()
+	b.setState(StateOpen)
+}
+
+func (b *Breaker) setState(state State) {
+	if b.state == state {
+		return
+	}
+
+	b.state = state
+	if state == StateOpen {
+		b.lc.Warnf("Circuit breaker for '%s' is open, requests fail without being sent for %s", b.serviceKey, b.cooldown.String())
+		return
+	}
+	b.lc.Infof("Circuit breaker for '%s' is %s", b.serviceKey, state.String())
+}
+
+// RoundTripper returns the http.RoundTripper which sends each request using the next http.RoundTripper, which
+// defaults to http.DefaultTransport when nil, unless the Breaker is open.
+func (b *Breaker) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &roundTripper{
+		breaker: b,
+		next:    next,
+	}
+}
+
+type roundTripper struct {
+	breaker *Breaker
+	next    http.RoundTripper
+}
+
+func (rt *roundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	// The request's body can only be re-sent when it can be recreated
+	retryable := idempotentMethods[request.Method] && (request.Body == nil || request.Body == http.NoBody || request.GetBody != nil)
+
+	for attempt := 0; ; attempt++ {
+		if err := rt.breaker.allow(); err != nil {
+			return nil, fmt.Errorf("unable to send request to '%s': %w", rt.breaker.serviceKey, err)
+		}
+
+		attemptRequest := request
+		if attempt > 0 && request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				rt.breaker.release()
+				return nil, err
+			}
+			attemptRequest = request.Clone(request.Context())
+			attemptRequest.Body = body
+		}
+
+		response, err := rt.next.RoundTrip(attemptRequest)
+
+		// A cancelled request says nothing about the service's health
+		if request.Context().Err() != nil || errors.Is(err, context.Canceled) {
+			rt.breaker.release()
+			return response, err
+		}
+
+		failed := err != nil || response.StatusCode >= http.StatusInternalServerError
+		rt.breaker.record(failed)
+		if !failed || !retryable || attempt >= rt.breaker.retries {
+			return response, err
+		}
+
+		if response != nil {
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(rt.breaker.retryInterval):
+		}
+	}
+}
+
+// NewAuthenticationInjector returns the AuthenticationInjector for the EdgeX clients which sends their requests using
+// the Breaker, after they're authenticated by the injector.
+func NewAuthenticationInjector(injector clientinterfaces.AuthenticationInjector, breaker *Breaker) clientinterfaces.AuthenticationInjector {
+	return &authenticationInjector{
+		AuthenticationInjector: injector,
+		breaker:                breaker,
+	}
+}
+
+type authenticationInjector struct {
+	clientinterfaces.AuthenticationInjector
+	breaker *Breaker
+}
+
+func (a *authenticationInjector) RoundTripper() http.RoundTripper {
+	return a.breaker.RoundTripper(a.AuthenticationInjector.RoundTripper())
+}
@@ -0,0 +1,263 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package circuitbreaker
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeService responds with the status, counting the requests it has received
+type fakeService struct {
+	server   *httptest.Server
+	status   int
+	requests int
+	bodies   []string
+	mutex    sync.Mutex
+}
+
+func newFakeService(t *testing.T) *fakeService {
+	service := &fakeService{status: http.StatusOK}
+	service.server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+
+		service.mutex.Lock()
+		service.requests++
+		service.bodies = append(service.bodies, string(body))
+		status := service.status
+		service.mutex.Unlock()
+
+		writer.WriteHeader(status)
+	}))
+	t.Cleanup(service.server.Close)
+	return service
+}
+
+func (f *fakeService) setStatus(status int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.status = status
+}
+
+func (f *fakeService) requestCount() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.requests
+}
+
+// fakeClock is the time as seen by the Breaker, which the tests advance past the cooldown
+type fakeClock struct {
+	now   time.Time
+	mutex sync.Mutex
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) advance(duration time.Duration) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.now = f.now.Add(duration)
+}
+
+func newTestBreaker(t *testing.T, failureThreshold int, retries int) (*Breaker, *http.Client, *fakeClock) {
+	breaker, err := NewBreaker("core-data", failureThreshold, time.Minute, retries, time.Millisecond, logger.NewMockClient())
+	require.NoError(t, err)
+
+	clock := &fakeClock{now: time.Now()}
+	breaker.now = clock.Now
+
+	return breaker, &http.Client{Transport: breaker.RoundTripper(nil)}, clock
+}
+
+func send(client *http.Client, method string, url string, body string) (int, error) {
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = response.Body.Close() }()
+	return response.StatusCode, nil
+}
+
+func TestNewBreaker(t *testing.T) {
+	breaker, err := NewBreaker("core-data", 3, 0, 0, 0, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCooldown, breaker.cooldown)
+	assert.Equal(t, StateClosed, breaker.State())
+
+	_, err = NewBreaker("core-data", -1, 0, 0, 0, logger.NewMockClient())
+	assert.Error(t, err)
+	_, err = NewBreaker("core-data", 3, 0, -1, 0, logger.NewMockClient())
+	assert.Error(t, err)
+}
+
+func TestBreaker_OpensAndRecovers(t *testing.T) {
+	service := newFakeService(t)
+	breaker, client, clock := newTestBreaker(t, 3, 0)
+
+	service.setStatus(http.StatusServiceUnavailable)
+	for index := 0; index < 3; index++ {
+		status, err := send(client, http.MethodGet, service.server.URL, "")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+	}
+	assert.Equal(t, StateOpen, breaker.State())
+
+	// The requests short-circuit while open, without being sent to the service
+	_, err := send(client, http.MethodGet, service.server.URL, "")
+	require.ErrorIs(t, err, ErrOpen)
+	assert.Equal(t, 3, service.requestCount())
+
+	// The failed trial request once the cooldown has elapsed re-opens the breaker
+	clock.advance(time.Minute)
+	status, err := send(client, http.MethodGet, service.server.URL, "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Equal(t, StateOpen, breaker.State())
+	_, err = send(client, http.MethodGet, service.server.URL, "")
+	require.ErrorIs(t, err, ErrOpen)
+	assert.Equal(t, 4, service.requestCount())
+
+	// The successful trial request closes the breaker
+	service.setStatus(http.StatusOK)
+	clock.advance(time.Minute)
+	status, err = send(client, http.MethodGet, service.server.URL, "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, StateClosed, breaker.State())
+
+	status, err = send(client, http.MethodGet, service.server.URL, "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, 6, service.requestCount())
+}
+
+func TestBreaker_ConsecutiveFailures(t *testing.T) {
+	service := newFakeService(t)
+	breaker, client, _ := newTestBreaker(t, 2, 0)
+
+	// A success resets the count of consecutive failures
+	for _, status := range []int{http.StatusInternalServerError, http.StatusOK, http.StatusInternalServerError, http.StatusNotFound} {
+		service.setStatus(status)
+		_, err := send(client, http.MethodGet, service.server.URL, "")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, StateClosed, breaker.State())
+
+	service.setStatus(http.StatusInternalServerError)
+	_, err := send(client, http.MethodGet, service.server.URL, "")
+	require.NoError(t, err)
+	_, err = send(client, http.MethodGet, service.server.URL, "")
+	require.NoError(t, err)
+	assert.Equal(t, StateOpen, breaker.State())
+}
+
+func TestBreaker_NeverOpensWithoutThreshold(t *testing.T) {
+	service := newFakeService(t)
+	breaker, client, _ := newTestBreaker(t, 0, 0)
+
+	service.setStatus(http.StatusServiceUnavailable)
+	for index := 0; index < 10; index++ {
+		_, err := send(client, http.MethodGet, service.server.URL, "")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, StateClosed, breaker.State())
+	assert.Equal(t, 10, service.requestCount())
+}
+
+func TestBreaker_ConnectionFailure(t *testing.T) {
+	service := newFakeService(t)
+	url := service.server.URL
+	service.server.Close()
+
+	breaker, client, _ := newTestBreaker(t, 1, 0)
+	_, err := send(client, http.MethodGet, url, "")
+	require.Error(t, err)
+	assert.Equal(t, StateOpen, breaker.State())
+}
+
+func TestBreaker_Retries(t *testing.T) {
+	tests := []struct {
+		Name             string
+		Method           string
+		Body             string
+		ExpectedRequests int
+	}{
+		{"Idempotent request retried", http.MethodGet, "", 3},
+		{"Idempotent request with body retried", http.MethodPut, "body", 3},
+		{"Non-idempotent request not retried", http.MethodPost, "body", 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			service := newFakeService(t)
+			_, client, _ := newTestBreaker(t, 0, 2)
+
+			service.setStatus(http.StatusServiceUnavailable)
+			status, err := send(client, test.Method, service.server.URL, test.Body)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusServiceUnavailable, status)
+			assert.Equal(t, test.ExpectedRequests, service.requestCount())
+
+			// The body is re-sent with each retry
+			for _, body := range service.bodies {
+				assert.Equal(t, test.Body, body)
+			}
+		})
+	}
+}
+
+func TestBreaker_RetriesStopWhenOpen(t *testing.T) {
+	service := newFakeService(t)
+	breaker, client, _ := newTestBreaker(t, 2, 5)
+
+	service.setStatus(http.StatusServiceUnavailable)
+	_, err := send(client, http.MethodGet, service.server.URL, "")
+	require.ErrorIs(t, err, ErrOpen)
+	assert.Equal(t, 2, service.requestCount())
+	assert.Equal(t, StateOpen, breaker.State())
+}
+
+func TestBreaker_CancelledRequestNotCounted(t *testing.T) {
+	service := newFakeService(t)
+	breaker, client, _ := newTestBreaker(t, 1, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, service.server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(request)
+	require.Error(t, err)
+	assert.Equal(t, StateClosed, breaker.State())
+}
@@ -18,13 +18,16 @@ package config
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"net"
 	"net/url"
+	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -34,6 +37,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/mitchellh/copystructure"
+	"github.com/pelletier/go-toml/v2"
 	"gopkg.in/yaml.v3"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
@@ -67,6 +71,11 @@ const (
 	SecurityModeKey = "Mode"
 
 	configProviderTypeKeeper = "keeper"
+	configProviderTypeEtcd   = "etcd"
+
+	jsonFileExtension = ".json"
+	yamlFileExtension = ".yaml"
+	ymlFileExtension  = ".yml"
 )
 
 var invalidRemoteHostsError = errors.New("-rsh/--remoteServiceHosts must contain 3 and only 3 comma seperated host names")
@@ -88,6 +97,21 @@ type Processor struct {
 	commonConfigClient configuration.Client
 	appConfigClient    configuration.Client
 	deviceConfigClient configuration.Client
+	traceOverrides     bool
+	writableWatcher    *writableWatcher
+	caller             *providerCaller
+}
+
+// ProcessorOption is a function which sets an optional behavior of the configuration Processor
+type ProcessorOption func(*Processor)
+
+// WithOverrideTracing enables tracing of the configuration settings overridden by environment variables. When enabled
+// a summary of the overridden settings is logged once the configuration has been processed and the provenance of
+// each overridden setting is available from OverrideProvenance.
+func WithOverrideTracing() ProcessorOption {
+	return func(cp *Processor) {
+		cp.traceOverrides = true
+	}
 }
 
 // NewProcessor creates a new configuration Processor
@@ -99,8 +123,9 @@ func NewProcessor(
 	wg *sync.WaitGroup,
 	configUpdated UpdatedStream,
 	dic *di.Container,
+	options ...ProcessorOption,
 ) *Processor {
-	return &Processor{
+	cp := &Processor{
 		lc:            container.LoggingClientFrom(dic.Get),
 		flags:         flags,
 		envVars:       envVars,
@@ -110,6 +135,12 @@ func NewProcessor(
 		configUpdated: configUpdated,
 		dic:           dic,
 	}
+
+	for _, option := range options {
+		option(cp)
+	}
+
+	return cp
 }
 
 func NewProcessorForCustomConfig(
@@ -138,6 +169,15 @@ func (cp *Processor) Process(
 	configProviderUrl := cp.flags.ConfigProviderUrl()
 	remoteHosts := environment.GetRemoteServiceHosts(cp.lc, cp.flags.RemoteServiceHosts())
 
+	configPrefix := environment.GetConfigPrefix(cp.lc, cp.flags.ConfigPrefix())
+	configStem, err := resolveConfigStem(configStem, configPrefix)
+	if err != nil {
+		return err
+	}
+	if len(configPrefix) > 0 {
+		cp.lc.Infof("Configuration Provider key prefix overridden to '%s'", configStem)
+	}
+
 	// Create new ProviderInfo and initialize it from command-line flag or Variables
 	configProviderInfo, err := NewProviderInfo(cp.envVars, configProviderUrl)
 	if err != nil {
@@ -160,7 +200,6 @@ func (cp *Processor) Process(
 	})
 
 	var privateConfigClient configuration.Client
-	var privateServiceConfig interfaces.Configuration
 
 	if useProvider {
 		if remoteHosts != nil {
@@ -196,37 +235,8 @@ func (cp *Processor) Process(
 			},
 		})
 
-		cp.providerHasConfig, err = privateConfigClient.HasConfiguration()
-		if err != nil {
-			return fmt.Errorf("failed check for Configuration Provider has private configiuration: %s", err.Error())
-		}
-
-		if cp.providerHasConfig && !cp.overwriteConfig {
-			privateServiceConfig, err = copyConfigurationStruct(serviceConfig)
-			if err != nil {
-				return err
-			}
-			if err := cp.loadConfigFromProvider(privateServiceConfig, privateConfigClient); err != nil {
-				return err
-			}
-			configKeys, err := privateConfigClient.GetConfigurationKeys("")
-			if err != nil {
-				return err
-			}
-
-			// Must remove any settings in the config that are not actually present in the Config Provider
-			privateConfigKeys := utils.StringSliceToMap(configKeys)
-			privateConfigMap, err := utils.RemoveUnusedSettings(privateServiceConfig, utils.BuildBaseKey(configStem, serviceKey), privateConfigKeys)
-			if err != nil {
-				return fmt.Errorf("could not remove unused settings from private configurations: %s", err.Error())
-			}
-
-			// Now merge only the actual present value with the existing configuration from common.
-			if err := utils.MergeValues(serviceConfig, privateConfigMap); err != nil {
-				return fmt.Errorf("could not merge common and private configurations: %s", err.Error())
-			}
-
-			cp.lc.Info("Private configuration loaded from the Configuration Provider. No overrides applied")
+		if err := cp.loadPrivateConfigFromProvider(serviceConfig, privateConfigClient, utils.BuildBaseKey(configStem, serviceKey)); err != nil {
+			return err
 		}
 	} else {
 		// Now load common configuration from local file if not using config provider and -cc/--commonConfig flag is used.
@@ -249,7 +259,7 @@ func (cp *Processor) Process(
 	// Now load the private config from a local file if any of these conditions are true
 	if !useProvider || !cp.providerHasConfig || cp.overwriteConfig {
 		filePath := GetConfigFileLocation(cp.lc, cp.flags)
-		configMap, err := cp.loadConfigYamlFromFile(filePath)
+		configMap, err := cp.loadConfigFromFile(filePath)
 		if err != nil {
 			return err
 		}
@@ -266,7 +276,10 @@ func (cp *Processor) Process(
 		}
 
 		if useProvider {
-			if err := privateConfigClient.PutConfigurationMap(configMap, cp.overwriteConfig); err != nil {
+			_, err := callConfigProvider(cp.providerCaller(), func() (any, error) {
+				return nil, privateConfigClient.PutConfigurationMap(configMap, cp.overwriteConfig)
+			})
+			if err != nil {
 				return fmt.Errorf("could not push private configuration into Configuration Provider: %s", err.Error())
 			}
 
@@ -274,6 +287,17 @@ func (cp *Processor) Process(
 		}
 	}
 
+	if err := cp.applyOverrideConfigFile(serviceConfig); err != nil {
+		return err
+	}
+
+	cp.writableWatcher = newWritableWatcher(cp.lc, serviceConfig.GetWritablePtr, DefaultWritableWatchDebounce)
+	cp.dic.Update(di.ServiceConstructorMap{
+		container.WritableWatcherInterfaceName: func(get di.Get) any {
+			return cp.writableWatcher
+		},
+	})
+
 	// listen for changes on Writable
 	if useProvider {
 		cp.listenForPrivateChanges(serviceConfig, privateConfigClient, utils.BuildBaseKey(configStem, serviceKey), configProviderInfo.ServiceConfig().Type)
@@ -331,9 +355,131 @@ func (cp *Processor) Process(
 		}
 	}
 
+	if err := validateConfiguration(serviceConfig); err != nil {
+		return fmt.Errorf("configuration is invalid: %s", err.Error())
+	}
+
+	cp.logEffectiveConfig(serviceConfig)
+	cp.logOverrideProvenance()
+
 	return err
 }
 
+// loadPrivateConfigFromProvider merges the service's private configuration from the Configuration Provider into the
+// service's configuration, when the provider has it and it isn't being overwritten.
+func (cp *Processor) loadPrivateConfigFromProvider(serviceConfig interfaces.Configuration, privateConfigClient configuration.Client, baseKey string) error {
+	var err error
+	cp.providerHasConfig, err = callConfigProvider(cp.providerCaller(), privateConfigClient.HasConfiguration)
+	if err != nil {
+		return fmt.Errorf("failed check for Configuration Provider has private configiuration: %s", err.Error())
+	}
+
+	if !cp.providerHasConfig || cp.overwriteConfig {
+		return nil
+	}
+
+	privateServiceConfig, err := copyConfigurationStruct(serviceConfig)
+	if err != nil {
+		return err
+	}
+	if err := cp.loadConfigFromProvider(privateServiceConfig, privateConfigClient); err != nil {
+		return err
+	}
+	configKeys, err := callConfigProvider(cp.providerCaller(), func() ([]string, error) {
+		return privateConfigClient.GetConfigurationKeys("")
+	})
+	if err != nil {
+		return err
+	}
+
+	// Must remove any settings in the config that are not actually present in the Config Provider
+	privateConfigKeys := utils.StringSliceToMap(configKeys)
+	privateConfigMap, err := utils.RemoveUnusedSettings(privateServiceConfig, baseKey, privateConfigKeys)
+	if err != nil {
+		return fmt.Errorf("could not remove unused settings from private configurations: %s", err.Error())
+	}
+
+	// Now merge only the actual present value with the existing configuration from common.
+	if err := utils.MergeValues(serviceConfig, privateConfigMap); err != nil {
+		return fmt.Errorf("could not merge common and private configurations: %s", err.Error())
+	}
+
+	cp.lc.Info("Private configuration loaded from the Configuration Provider. No overrides applied")
+	return nil
+}
+
+// applyOverrideConfigFile merges the settings from the override configuration file, if one was specified via the
+// -ocf/--overrideConfigFile flag, on top of the loaded configuration. Only the settings present in the file are
+// overridden. Environment variable overrides are applied to the file's settings so they still take precedence.
+// The override settings are local only and are not pushed into the Configuration Provider.
+func (cp *Processor) applyOverrideConfigFile(serviceConfig interfaces.Configuration) error {
+	overrideConfigFile := cp.flags.OverrideConfigFile()
+	if len(overrideConfigFile) == 0 {
+		return nil
+	}
+
+	configMap, err := cp.loadConfigFromFile(overrideConfigFile)
+	if err != nil {
+		return fmt.Errorf("failed to load override configuration: %s", err.Error())
+	}
+
+	overrideCount, err := cp.envVars.OverrideConfigMapValues(configMap)
+	if err != nil {
+		return err
+	}
+
+	if err := utils.MergeValues(serviceConfig, configMap); err != nil {
+		return fmt.Errorf("could not merge override configuration: %s", err.Error())
+	}
+
+	cp.lc.Infof("Override configuration loaded from file %s with %d overrides applied", overrideConfigFile, overrideCount)
+	return nil
+}
+
+// OverrideProvenance returns the configuration settings which have been overridden by environment variables, keyed by
+// the path of the setting, along with the environment variable which set each value.
+// Returns nil if override tracing has not been enabled via WithOverrideTracing.
+func (cp *Processor) OverrideProvenance() map[string]environment.Override {
+	if !cp.traceOverrides || cp.envVars == nil {
+		return nil
+	}
+
+	return cp.envVars.Overrides()
+}
+
+// logOverrideProvenance logs a summary of the configuration settings overridden by environment variables when
+// override tracing is enabled
+func (cp *Processor) logOverrideProvenance() {
+	overrides := cp.OverrideProvenance()
+	if overrides == nil {
+		return
+	}
+
+	paths := make([]string, 0, len(overrides))
+	for path := range overrides {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	summary := make([]string, 0, len(paths))
+	for _, path := range paths {
+		summary = append(summary, fmt.Sprintf("%s=%s (%s)", overrides[path].EnvVar, overrides[path].Value, path))
+	}
+
+	cp.lc.Infof("Configuration has %d settings overridden by environment variables: [%s]", len(summary), strings.Join(summary, ", "))
+}
+
+// logEffectiveConfig logs the service's effective configuration at debug level with the secret fields redacted
+func (cp *Processor) logEffectiveConfig(serviceConfig interfaces.Configuration) {
+	contents, err := json.Marshal(RedactSecrets(serviceConfig))
+	if err != nil {
+		cp.lc.Warnf("unable to marshal effective configuration for logging: %s", err.Error())
+		return
+	}
+
+	cp.lc.Debugf("Effective configuration: %s", string(contents))
+}
+
 func getLocalIP() string {
 	// Because of how UDP works, the connection is not established - no handshake is performed, no data is sent.
 	// The purpose of this is to get the local IP address that a UDP connection would use if it were sending data to
@@ -443,7 +589,9 @@ func (cp *Processor) loadCommonConfig(
 		if err != nil {
 			return fmt.Errorf("failed to load the common configuration for %s: %s", appServicesKey, err.Error())
 		}
-		serviceTypeConfigKeys, err = cp.appConfigClient.GetConfigurationKeys("")
+		serviceTypeConfigKeys, err = callConfigProvider(cp.providerCaller(), func() ([]string, error) {
+			return cp.appConfigClient.GetConfigurationKeys("")
+		})
 		if err != nil {
 			return fmt.Errorf("failed to load the common configuration keys for %s: %s", deviceServicesKey, err.Error())
 		}
@@ -463,7 +611,9 @@ func (cp *Processor) loadCommonConfig(
 		if err != nil {
 			return fmt.Errorf("failed to load the common configuration for %s: %s", deviceServicesKey, err.Error())
 		}
-		serviceTypeConfigKeys, err = cp.deviceConfigClient.GetConfigurationKeys("")
+		serviceTypeConfigKeys, err = callConfigProvider(cp.providerCaller(), func() ([]string, error) {
+			return cp.deviceConfigClient.GetConfigurationKeys("")
+		})
 		if err != nil {
 			return fmt.Errorf("failed to load the common configuration keys for %s: %s", deviceServicesKey, err.Error())
 		}
@@ -497,7 +647,7 @@ func (cp *Processor) loadCommonConfigFromFile(
 
 	var err error
 
-	commonConfig, err := cp.loadConfigYamlFromFile(configFile)
+	commonConfig, err := cp.loadConfigFromFile(configFile)
 	if err != nil {
 		return err
 	}
@@ -574,7 +724,7 @@ func (cp *Processor) LoadCustomConfigSection(updatableConfig interfaces.Updatabl
 	if configClient == nil {
 		cp.lc.Info("Skipping use of Configuration Provider for custom configuration: Provider not available")
 		filePath := GetConfigFileLocation(cp.lc, cp.flags)
-		configMap, err := cp.loadConfigYamlFromFile(filePath)
+		configMap, err := cp.loadConfigFromFile(filePath)
 		if err != nil {
 			return err
 		}
@@ -586,7 +736,9 @@ func (cp *Processor) LoadCustomConfigSection(updatableConfig interfaces.Updatabl
 	} else {
 		cp.lc.Infof("Checking if custom configuration ('%s') exists in Configuration Provider", sectionName)
 
-		exists, err := configClient.HasSubConfiguration(sectionName)
+		exists, err := callConfigProvider(cp.providerCaller(), func() (bool, error) {
+			return configClient.HasSubConfiguration(sectionName)
+		})
 		if err != nil {
 			return fmt.Errorf(
 				"unable to determine if custom configuration exists in Configuration Provider: %s",
@@ -594,7 +746,9 @@ func (cp *Processor) LoadCustomConfigSection(updatableConfig interfaces.Updatabl
 		}
 
 		if exists && !cp.flags.OverwriteConfig() {
-			rawConfig, err := configClient.GetConfiguration(updatableConfig)
+			rawConfig, err := callConfigProvider(cp.providerCaller(), func() (any, error) {
+				return configClient.GetConfiguration(updatableConfig)
+			})
 			if err != nil {
 				return fmt.Errorf(
 					"unable to get custom configuration from Configuration Provider: %s", err.Error())
@@ -608,7 +762,7 @@ func (cp *Processor) LoadCustomConfigSection(updatableConfig interfaces.Updatabl
 			cp.lc.Info("Loaded custom configuration from Configuration Provider, no overrides applied")
 		} else {
 			filePath := GetConfigFileLocation(cp.lc, cp.flags)
-			configMap, err := cp.loadConfigYamlFromFile(filePath)
+			configMap, err := cp.loadConfigFromFile(filePath)
 			if err != nil {
 				return err
 			}
@@ -631,7 +785,9 @@ func (cp *Processor) LoadCustomConfigSection(updatableConfig interfaces.Updatabl
 				return err
 			}
 
-			err = configClient.PutConfigurationMap(mapToPush, true)
+			_, err = callConfigProvider(cp.providerCaller(), func() (any, error) {
+				return nil, configClient.PutConfigurationMap(mapToPush, true)
+			})
 			if err != nil {
 				return fmt.Errorf("error pushing custom config to Configuration Provider: %s", err.Error())
 			}
@@ -753,25 +909,63 @@ func CreateProviderClient(
 		providerConfig.GetUrl(),
 		providerConfig.BasePath))
 
+	// etcd isn't supported by go-mod-configuration's factory, so its client is provided here
+	if providerConfig.Type == configProviderTypeEtcd {
+		return newEtcdClient(providerConfig)
+	}
+
 	return configuration.NewConfigurationClient(providerConfig)
 }
 
-// loadConfigYamlFromFile attempts to read the specified configuration yaml file
-func (cp *Processor) loadConfigYamlFromFile(yamlFile string) (map[string]any, error) {
+// loadConfigFromFile attempts to read the specified configuration file, which is parsed as TOML, YAML or JSON based
+// on its extension. When the location is a directory, i.e. a mounted Kubernetes ConfigMap, the configuration is loaded from
+// the directory with one file per key.
+func (cp *Processor) loadConfigFromFile(configFile string) (map[string]any, error) {
+	if info, err := os.Stat(configFile); err == nil && info.IsDir() {
+		cp.lc.Infof("Loading configuration from directory %s", configFile)
+		return loadConfigFromDirectory(configFile)
+	}
+
 	secretProvider := container.SecretProviderExtFrom(cp.dic.Get)
 
-	cp.lc.Infof("Loading configuration file from %s", yamlFile)
-	contents, err := file.Load(yamlFile, secretProvider, cp.lc)
+	cp.lc.Infof("Loading configuration file from %s", configFile)
+	contents, err := file.Load(configFile, secretProvider, cp.lc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file %s: %s", configFile, err.Error())
+	}
+
+	data, err := unmarshalConfigFile(configFile, contents)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read configuration file %s: %s", yamlFile, err.Error())
+		return nil, fmt.Errorf("failed to unmarshall configuration file %s: %s", configFile, err.Error())
+	}
+	return data, nil
+}
+
+// unmarshalConfigFile unmarshals the contents of the configuration file based on the file's extension. Files with
+// the .yaml or .yml extension are parsed as YAML and those with the .json extension as JSON, while all others,
+// including files without an extension, are parsed as TOML, which remains the default format.
+func unmarshalConfigFile(configFile string, contents []byte) (map[string]any, error) {
+	filePath := configFile
+	if parsedUrl, err := url.Parse(configFile); err == nil && (parsedUrl.Scheme == "http" || parsedUrl.Scheme == "https") {
+		filePath = parsedUrl.Path
 	}
 
 	data := make(map[string]any)
 
-	err = yaml.Unmarshal(contents, &data)
+	var err error
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case yamlFileExtension, ymlFileExtension:
+		err = yaml.Unmarshal(contents, &data)
+	case jsonFileExtension:
+		err = json.Unmarshal(contents, &data)
+	default:
+		err = toml.Unmarshal(contents, &data)
+	}
+
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshall configuration file %s: %s", yamlFile, err.Error())
+		return nil, err
 	}
+
 	return data, nil
 }
 
@@ -1014,6 +1208,14 @@ func (cp *Processor) applyWritableUpdates(serviceConfig interfaces.Configuration
 	lc := cp.lc
 	previousLogLevel := serviceConfig.GetLogLevel()
 	previousTelemetryInterval := serviceConfig.GetTelemetryInfo().Interval
+	previousTelemetryTags := make(map[string]string)
+	for name, value := range serviceConfig.GetTelemetryInfo().Tags {
+		previousTelemetryTags[name] = value
+	}
+	previousMetricIntervals := make(map[string]string)
+	for name, value := range serviceConfig.GetTelemetryInfo().MetricIntervals {
+		previousMetricIntervals[name] = value
+	}
 
 	var previousInsecureSecrets config.InsecureSecrets
 	if err := utils.DeepCopy(serviceConfig.GetInsecureSecrets(), &previousInsecureSecrets); err != nil {
@@ -1024,9 +1226,15 @@ func (cp *Processor) applyWritableUpdates(serviceConfig interfaces.Configuration
 		lc.Errorf("failed to apply Writable change to service configuration: %v", err)
 	}
 
+	if cp.writableWatcher != nil {
+		cp.writableWatcher.notify()
+	}
+
 	currentInsecureSecrets := serviceConfig.GetInsecureSecrets()
 	currentLogLevel := serviceConfig.GetLogLevel()
 	currentTelemetryInterval := serviceConfig.GetTelemetryInfo().Interval
+	currentTelemetryTags := serviceConfig.GetTelemetryInfo().Tags
+	currentMetricIntervals := serviceConfig.GetTelemetryInfo().MetricIntervals
 
 	lc.Info("Writable configuration has been updated from the Configuration Provider")
 
@@ -1071,6 +1279,36 @@ func (cp *Processor) applyWritableUpdates(serviceConfig interfaces.Configuration
 
 		metricsManager.ResetInterval(interval)
 
+	// Tags (map) will be nil if not in the original TOML, so compare the lengths to treat nil and empty the same.
+	case !(len(currentTelemetryTags) == 0 && len(previousTelemetryTags) == 0) &&
+		!reflect.DeepEqual(currentTelemetryTags, previousTelemetryTags):
+		lc.Info("Telemetry tags have been updated. Processing new values...")
+		metricsManager := container.MetricsManagerFrom(cp.dic.Get)
+		if metricsManager == nil {
+			lc.Error("metrics manager not available while updating telemetry tags")
+			break
+		}
+
+		metricsManager.ResetServiceTags(currentTelemetryTags)
+
+	// MetricIntervals (map) will be nil if not in the original TOML, so compare the lengths to treat nil and empty the same.
+	case !(len(currentMetricIntervals) == 0 && len(previousMetricIntervals) == 0) &&
+		!reflect.DeepEqual(currentMetricIntervals, previousMetricIntervals):
+		lc.Info("Telemetry metric intervals have been updated. Processing new values...")
+		metricIntervals, err := serviceConfig.GetTelemetryInfo().GetMetricIntervals()
+		if err != nil {
+			lc.Errorf("updated telemetry metric intervals are invalid, using previous values: %s", err.Error())
+			break
+		}
+
+		metricsManager := container.MetricsManagerFrom(cp.dic.Get)
+		if metricsManager == nil {
+			lc.Error("metrics manager not available while updating telemetry metric intervals")
+			break
+		}
+
+		metricsManager.ResetMetricIntervals(metricIntervals)
+
 	default:
 		// Signal that configuration updates exists that have not already been processed.
 		if cp.configUpdated != nil {
@@ -1080,15 +1318,25 @@ func (cp *Processor) applyWritableUpdates(serviceConfig interfaces.Configuration
 }
 
 func (cp *Processor) waitForCommonConfig(configClient configuration.Client, configReadyPath string) error {
+	// Each attempt is bounded so a slow provider fails the attempt rather than using up the startup duration
+	caller := cp.providerCaller()
+
 	// Wait for configuration provider to be available
 	isAlive := false
 	for cp.startupTimer.HasNotElapsed() {
-		if configClient.IsAlive() {
+		alive, err := callConfigProvider(caller, func() (bool, error) {
+			return configClient.IsAlive(), nil
+		})
+		if alive {
 			isAlive = true
 			break
 		}
 
-		cp.lc.Warnf("Waiting for configuration provider to be available")
+		if err != nil {
+			cp.lc.Warnf("Waiting for configuration provider to be available: %s", err.Error())
+		} else {
+			cp.lc.Warnf("Waiting for configuration provider to be available")
+		}
 
 		select {
 		case <-cp.ctx.Done():
@@ -1106,9 +1354,11 @@ func (cp *Processor) waitForCommonConfig(configClient configuration.Client, conf
 	isConfigReady := false
 	isCommonConfigReady := false
 	for cp.startupTimer.HasNotElapsed() {
-		commonConfigReady, err := configClient.GetConfigurationValueByFullPath(configReadyPath)
+		commonConfigReady, err := callConfigProvider(caller, func() ([]byte, error) {
+			return configClient.GetConfigurationValueByFullPath(configReadyPath)
+		})
 		if err != nil {
-			cp.lc.Warn("waiting for Common Configuration to be available from config provider")
+			cp.lc.Warnf("waiting for Common Configuration to be available from config provider: %s", err.Error())
 			cp.startupTimer.SleepForInterval()
 			continue
 		}
@@ -1139,10 +1389,97 @@ func (cp *Processor) waitForCommonConfig(configClient configuration.Client, conf
 	return nil
 }
 
+// resolveConfigStem returns the configStem the service's keys are under in the Configuration Provider, which is
+// replaced by the configPrefix when set so multiple deployments sharing a Configuration Provider are isolated.
+// The prefix is normalized to the same form as the default stem, i.e. edgex/v3, without leading or trailing '/'.
+func resolveConfigStem(configStem string, configPrefix string) (string, error) {
+	if len(configPrefix) == 0 {
+		return configStem, nil
+	}
+
+	prefix := strings.Trim(strings.TrimSpace(configPrefix), "/")
+	if len(prefix) == 0 || strings.Contains(prefix, "//") {
+		return "", fmt.Errorf("configuration prefix '%s' is invalid, it must be a '/' separated path such as edgex/v3", configPrefix)
+	}
+
+	return prefix, nil
+}
+
+// providerCaller bounds the requests made to the Configuration Provider while the service is starting up.
+type providerCaller struct {
+	ctx     context.Context
+	timeout time.Duration
+	// inFlight holds a token while a request is running. The provider's client can't cancel a request, so one which
+	// timed out keeps running in the background and the next request waits for it to complete rather than piling up
+	// another blocked request on an unresponsive provider.
+	inFlight chan struct{}
+}
+
+func newProviderCaller(ctx context.Context, timeout time.Duration) *providerCaller {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return &providerCaller{
+		ctx:      ctx,
+		timeout:  timeout,
+		inFlight: make(chan struct{}, 1),
+	}
+}
+
+// providerCaller returns the caller used for all requests made to the Configuration Provider during startup
+func (cp *Processor) providerCaller() *providerCaller {
+	if cp.caller == nil {
+		cp.caller = newProviderCaller(cp.ctx, environment.GetConfigProviderTimeout(cp.lc))
+	}
+
+	return cp.caller
+}
+
+// callConfigProvider makes a request to the Configuration Provider which fails when it doesn't complete within the
+// caller's timeout, including any time spent waiting for a previous request which timed out to complete, or when the
+// caller's context is done. A request which timed out completes in the background and its result is discarded.
+func callConfigProvider[T any](caller *providerCaller, request func() (T, error)) (T, error) {
+	type result struct {
+		value T
+		err   error
+	}
+
+	var zero T
+	timer := time.NewTimer(caller.timeout)
+	defer timer.Stop()
+
+	select {
+	case caller.inFlight <- struct{}{}:
+	case <-timer.C:
+		return zero, fmt.Errorf("configuration provider didn't respond to a previous request within %s", caller.timeout)
+	case <-caller.ctx.Done():
+		return zero, errors.New("aborted request to the configuration provider")
+	}
+
+	results := make(chan result, 1)
+	go func() {
+		defer func() { <-caller.inFlight }()
+		value, err := request()
+		results <- result{value: value, err: err}
+	}()
+
+	select {
+	case r := <-results:
+		return r.value, r.err
+	case <-timer.C:
+		return zero, fmt.Errorf("configuration provider didn't respond within %s", caller.timeout)
+	case <-caller.ctx.Done():
+		return zero, errors.New("aborted request to the configuration provider")
+	}
+}
+
 // loadConfigFromProvider loads the config into the config structure
 func (cp *Processor) loadConfigFromProvider(serviceConfig interfaces.Configuration, configClient configuration.Client) error {
 	// pull common config and apply config to service config structure
-	rawConfig, err := configClient.GetConfiguration(serviceConfig)
+	rawConfig, err := callConfigProvider(cp.providerCaller(), func() (any, error) {
+		return configClient.GetConfiguration(serviceConfig)
+	})
 	if err != nil {
 		return err
 	}
@@ -23,13 +23,16 @@ import (
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/environment"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/flags"
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-configuration/v3/configuration"
@@ -264,6 +267,159 @@ func TestLoadCommonConfig(t *testing.T) {
 	}
 }
 
+func TestWaitForCommonConfigProviderTimeout(t *testing.T) {
+	t.Setenv("EDGEX_CONFIG_PROVIDER_TIMEOUT", "200ms")
+	configReadyPath := "edgex/v3/core-common-config-bootstrapper/IsCommonConfigReady"
+
+	mockLogger := logger.MockLogger{}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} { return mockLogger },
+	})
+	proc := NewProcessor(flags.New(), environment.NewVariables(mockLogger), startup.NewTimer(10, 1), context.Background(), &sync.WaitGroup{}, nil, dic)
+
+	// the first attempt of each request hangs well past the timeout, but completes before the next attempt is made
+	providerClientMock := &mocks.Client{}
+	providerClientMock.On("IsAlive").After(time.Second).Return(true).Once()
+	providerClientMock.On("IsAlive").Return(true).Once()
+	providerClientMock.On("GetConfigurationValueByFullPath", configReadyPath).After(time.Second).Return([]byte("true"), nil).Once()
+	providerClientMock.On("GetConfigurationValueByFullPath", configReadyPath).Return([]byte("true"), nil).Once()
+
+	start := time.Now()
+	err := proc.waitForCommonConfig(providerClientMock, configReadyPath)
+	elapsed := time.Since(start)
+
+	require.NoError(t, err)
+	// two timed out attempts, each followed by the 1 second startup interval
+	assert.Less(t, elapsed, 4*time.Second)
+	assert.GreaterOrEqual(t, elapsed, 400*time.Millisecond)
+	providerClientMock.AssertNumberOfCalls(t, "IsAlive", 2)
+	providerClientMock.AssertNumberOfCalls(t, "GetConfigurationValueByFullPath", 2)
+}
+
+func TestLoadPrivateConfigFromProviderTimeout(t *testing.T) {
+	t.Setenv("EDGEX_CONFIG_PROVIDER_TIMEOUT", "200ms")
+
+	mockLogger := logger.MockLogger{}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} { return mockLogger },
+	})
+	proc := NewProcessor(flags.New(), environment.NewVariables(mockLogger), startup.NewTimer(10, 1), context.Background(), &sync.WaitGroup{}, nil, dic)
+
+	serviceConfig := ConfigurationMockStruct{Writable: WritableInfo{LogLevel: "INFO"}}
+	providerClientMock := &mocks.Client{}
+	providerClientMock.On("HasConfiguration").Return(true, nil).Once()
+	// the provider hangs longer than the whole test is allowed to take when asked for the private configuration
+	providerClientMock.On("GetConfiguration", mock.Anything).After(5*time.Second).Return(&serviceConfig, nil).Once()
+
+	start := time.Now()
+	err := proc.loadPrivateConfigFromProvider(&serviceConfig, providerClientMock, "edgex/v3/core-data")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "didn't respond within 200ms")
+	assert.Less(t, time.Since(start), time.Second)
+	providerClientMock.AssertNotCalled(t, "GetConfigurationKeys", mock.Anything)
+}
+
+func TestCallConfigProvider(t *testing.T) {
+	caller := newProviderCaller(context.Background(), 200*time.Millisecond)
+
+	value, err := callConfigProvider(caller, func() (string, error) { return "value", nil })
+	require.NoError(t, err)
+	assert.Equal(t, "value", value)
+
+	_, err = callConfigProvider(caller, func() (string, error) { return "", errors.New("failed") })
+	assert.EqualError(t, err, "failed")
+
+	start := time.Now()
+	value, err = callConfigProvider(caller, func() (string, error) {
+		time.Sleep(time.Second)
+		return "late", nil
+	})
+	assert.Error(t, err)
+	assert.Empty(t, value)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// the request which timed out is still running, so the next one waits for it rather than adding another
+	started := false
+	_, err = callConfigProvider(caller, func() (string, error) {
+		started = true
+		return "value", nil
+	})
+	assert.ErrorContains(t, err, "previous request")
+	assert.False(t, started)
+
+	// the next request runs once the one which timed out has completed
+	time.Sleep(time.Second)
+	value, err = callConfigProvider(caller, func() (string, error) { return "value", nil })
+	require.NoError(t, err)
+	assert.Equal(t, "value", value)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = callConfigProvider(newProviderCaller(ctx, time.Second), func() (string, error) {
+		time.Sleep(time.Second)
+		return "value", nil
+	})
+	assert.ErrorContains(t, err, "aborted")
+}
+
+func TestResolveConfigStem(t *testing.T) {
+	tests := []struct {
+		name          string
+		configPrefix  string
+		expected      string
+		expectedError bool
+	}{
+		{"default", "", common.ConfigStemAll, false},
+		{"prefix", "site-a/edgex/v3", "site-a/edgex/v3", false},
+		{"prefix with slashes", "/site-a/edgex/v3/", "site-a/edgex/v3", false},
+		{"only slashes", "/", "", true},
+		{"empty segment", "site-a//edgex", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := resolveConfigStem(common.ConfigStemAll, test.configPrefix)
+			if test.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestLoadCommonConfigWithConfigPrefix(t *testing.T) {
+	f := flags.New()
+	f.Parse([]string{"--configPrefix=/site-a/edgex/v3/"})
+	mockLogger := logger.MockLogger{}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} { return mockLogger },
+	})
+	proc := NewProcessor(f, environment.NewVariables(mockLogger), startup.NewTimer(5, 1), context.Background(), &sync.WaitGroup{}, nil, dic)
+
+	configStem, err := resolveConfigStem(common.ConfigStemAll, environment.GetConfigPrefix(mockLogger, f.ConfigPrefix()))
+	require.NoError(t, err)
+
+	var createdStems []string
+	providerClientMock := &mocks.Client{}
+	providerClientCreator := func(_ logger.LoggingClient, _ string, configStem string, _ types.GetAccessTokenCallback,
+		_ types.ServiceConfig) (configuration.Client, error) {
+		createdStems = append(createdStems, configStem)
+		return providerClientMock, nil
+	}
+	serviceConfig := ConfigurationMockStruct{Writable: WritableInfo{LogLevel: "INFO"}}
+	providerClientMock.On("IsAlive").Return(true)
+	// the common config is read from under the overridden prefix rather than edgex/v3
+	providerClientMock.On("GetConfigurationValueByFullPath", "site-a/edgex/v3/core-common-config-bootstrapper/IsCommonConfigReady").Return([]byte("true"), nil)
+	providerClientMock.On("GetConfiguration", &serviceConfig).Return(&serviceConfig, nil).Once()
+
+	err = proc.loadCommonConfig(configStem, func() (string, error) { return "", nil }, &ProviderInfo{}, &serviceConfig, config.ServiceTypeOther, providerClientCreator)
+	require.NoError(t, err)
+
+	providerClientMock.AssertExpectations(t)
+	assert.Equal(t, []string{"site-a/edgex/v3"}, createdStems)
+}
+
 func TestLoadCommonConfigFromFile(t *testing.T) {
 	tests := []struct {
 		Name          string
@@ -522,3 +678,292 @@ func TestProcessorApplyRemoteHosts(t *testing.T) {
 	err = applyRemoteHosts(hosts, &mockStruct)
 	require.Error(t, err)
 }
+
+func TestProcessorApplyWritableUpdates_TelemetryTags(t *testing.T) {
+	serviceConfig := &ConfigurationMockStruct{
+		Writable: WritableInfo{
+			LogLevel: "INFO",
+			Telemetry: config.TelemetryInfo{
+				Interval: "30s",
+				Tags:     map[string]string{"Gateway": "gateway-1"},
+			},
+		},
+	}
+
+	expectedTags := map[string]string{"Gateway": "gateway-2"}
+	metricsManagerMock := &bootstrapMocks.MetricsManager{}
+	metricsManagerMock.On("ResetServiceTags", expectedTags).Return()
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.MetricsManagerInterfaceName: func(get di.Get) interface{} {
+			return metricsManagerMock
+		},
+	})
+
+	target := &Processor{
+		lc:  container.LoggingClientFrom(dic.Get),
+		dic: dic,
+	}
+
+	raw := map[string]any{
+		"Telemetry": map[string]any{
+			"Tags": map[string]any{"Gateway": "gateway-2"},
+		},
+	}
+
+	target.applyWritableUpdates(serviceConfig, raw)
+	metricsManagerMock.AssertExpectations(t)
+	assert.Equal(t, expectedTags, serviceConfig.GetTelemetryInfo().Tags)
+}
+
+func TestProcessorApplyWritableUpdates_MetricIntervals(t *testing.T) {
+	serviceConfig := &ConfigurationMockStruct{
+		Writable: WritableInfo{
+			LogLevel: "INFO",
+			Telemetry: config.TelemetryInfo{
+				Interval:        "30s",
+				MetricIntervals: map[string]string{"EventsSent": "10s"},
+			},
+		},
+	}
+
+	metricsManagerMock := &bootstrapMocks.MetricsManager{}
+	metricsManagerMock.On("ResetMetricIntervals", map[string]time.Duration{"EventsSent": time.Second * 5}).Return().Once()
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.MetricsManagerInterfaceName: func(get di.Get) interface{} {
+			return metricsManagerMock
+		},
+	})
+
+	target := &Processor{
+		lc:  container.LoggingClientFrom(dic.Get),
+		dic: dic,
+	}
+
+	raw := map[string]any{
+		"Telemetry": map[string]any{
+			"MetricIntervals": map[string]any{"EventsSent": "5s"},
+		},
+	}
+	target.applyWritableUpdates(serviceConfig, raw)
+
+	// An invalid interval is ignored, leaving the previous intervals in use
+	raw = map[string]any{
+		"Telemetry": map[string]any{
+			"MetricIntervals": map[string]any{"EventsSent": "bogus"},
+		},
+	}
+	target.applyWritableUpdates(serviceConfig, raw)
+
+	metricsManagerMock.AssertExpectations(t)
+}
+
+func TestLoadConfigFromFile_Formats(t *testing.T) {
+	yamlConfig := `Writable:
+  LogLevel: DEBUG
+  Telemetry:
+    Interval: 30s
+    Tags:
+      Gateway: gateway-1
+Service:
+  Host: localhost
+  Port: 59880
+Clients:
+  core-metadata:
+    Host: edgex-core-metadata
+    Port: 59881
+`
+	tomlConfig := `[Writable]
+LogLevel = "DEBUG"
+  [Writable.Telemetry]
+  Interval = "30s"
+    [Writable.Telemetry.Tags]
+    Gateway = "gateway-1"
+
+[Service]
+Host = "localhost"
+Port = 59880
+
+[Clients]
+  [Clients.core-metadata]
+  Host = "edgex-core-metadata"
+  Port = 59881
+`
+	jsonConfig := `{
+  "Writable": {
+    "LogLevel": "DEBUG",
+    "Telemetry": {"Interval": "30s", "Tags": {"Gateway": "gateway-1"}}
+  },
+  "Service": {"Host": "localhost", "Port": 59880},
+  "Clients": {"core-metadata": {"Host": "edgex-core-metadata", "Port": 59881}}
+}`
+
+	tests := []struct {
+		Name          string
+		FileName      string
+		Contents      string
+		ExpectedError string
+	}{
+		{"TOML", "configuration.toml", tomlConfig, ""},
+		{"YAML", "configuration.yaml", yamlConfig, ""},
+		{"YML", "configuration.yml", yamlConfig, ""},
+		{"JSON", "configuration.json", jsonConfig, ""},
+		{"JSON - upper case extension", "configuration.JSON", jsonConfig, ""},
+		{"No extension defaults to TOML", "configuration", tomlConfig, ""},
+		{"Invalid TOML", "configuration.toml", yamlConfig, "failed to unmarshall configuration file"},
+		{"Invalid JSON", "configuration.json", yamlConfig, "failed to unmarshall configuration file"},
+	}
+
+	t.Setenv("SERVICE_PORT", "59890")
+
+	var expected *ConfigurationMockStruct
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			configFile := filepath.Join(t.TempDir(), test.FileName)
+			require.NoError(t, os.WriteFile(configFile, []byte(test.Contents), 0644))
+
+			mockLogger := logger.NewMockClient()
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.LoggingClientInterfaceName: func(get di.Get) interface{} { return mockLogger },
+			})
+			f := flags.New()
+			f.Parse(nil)
+			env := environment.NewVariables(mockLogger)
+			proc := NewProcessor(f, env, startup.NewTimer(5, 1), context.Background(), &sync.WaitGroup{}, nil, dic)
+
+			configMap, err := proc.loadConfigFromFile(configFile)
+			if len(test.ExpectedError) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			// Environment overrides apply the same regardless of the file format
+			overrideCount, err := env.OverrideConfigMapValues(configMap)
+			require.NoError(t, err)
+			assert.Equal(t, 1, overrideCount)
+
+			actual := &ConfigurationMockStruct{}
+			require.NoError(t, utils.MergeValues(actual, configMap))
+			assert.Equal(t, "DEBUG", actual.Writable.LogLevel)
+			assert.Equal(t, 59890, actual.Service.Port)
+			assert.Equal(t, 59881, actual.Clients["core-metadata"].Port)
+
+			if expected == nil {
+				expected = actual
+				return
+			}
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestProcessorOverrideProvenance(t *testing.T) {
+	t.Setenv("WRITABLE_LOGLEVEL", "DEBUG")
+	t.Setenv("SERVICE_PORT", "59890")
+
+	tests := []struct {
+		Name     string
+		Options  []ProcessorOption
+		Expected map[string]environment.Override
+	}{
+		{"Tracing disabled", nil, nil},
+		{"Tracing enabled", []ProcessorOption{WithOverrideTracing()}, map[string]environment.Override{
+			"Writable/LogLevel": {EnvVar: "WRITABLE_LOGLEVEL", Value: "DEBUG"},
+			"Service/Port":      {EnvVar: "SERVICE_PORT", Value: "59890"},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mockLogger := logger.NewMockClient()
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.LoggingClientInterfaceName: func(get di.Get) interface{} { return mockLogger },
+			})
+			f := flags.New()
+			f.Parse(nil)
+			env := environment.NewVariables(mockLogger)
+			proc := NewProcessor(f, env, startup.NewTimer(5, 1), context.Background(), &sync.WaitGroup{}, nil, dic, test.Options...)
+
+			configMap := map[string]any{
+				"Writable": map[string]any{"LogLevel": "INFO"},
+				"Service":  map[string]any{"Host": "localhost", "Port": 59880},
+			}
+			overrideCount, err := env.OverrideConfigMapValues(configMap)
+			require.NoError(t, err)
+			require.Equal(t, 2, overrideCount)
+
+			actual := proc.OverrideProvenance()
+			assert.Equal(t, test.Expected, actual)
+			// Settings which weren't overridden have no provenance
+			assert.NotContains(t, actual, "Service/Host")
+		})
+	}
+}
+
+func TestProcessorApplyOverrideConfigFile(t *testing.T) {
+	overrideConfig := "Writable:\n  LogLevel: DEBUG\nService:\n  Port: 59890\n"
+	overrideConfigFile := filepath.Join(t.TempDir(), "override.yaml")
+	require.NoError(t, os.WriteFile(overrideConfigFile, []byte(overrideConfig), 0644))
+
+	tests := []struct {
+		Name             string
+		Args             []string
+		EnvPort          string
+		ExpectedLogLevel string
+		ExpectedPort     int
+		ExpectedError    string
+	}{
+		{"No override file", nil, "", "INFO", 59880, ""},
+		{"Override file", []string{"-ocf=" + overrideConfigFile}, "", "DEBUG", 59890, ""},
+		{"Environment variable takes precedence", []string{"--overrideConfigFile=" + overrideConfigFile}, "59891", "DEBUG", 59891, ""},
+		{"Missing override file", []string{"-ocf=" + filepath.Join(t.TempDir(), "missing.yaml")}, "", "", 0, "failed to load override configuration"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if len(test.EnvPort) > 0 {
+				t.Setenv("SERVICE_PORT", test.EnvPort)
+			}
+
+			mockLogger := logger.NewMockClient()
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.LoggingClientInterfaceName: func(get di.Get) interface{} { return mockLogger },
+			})
+			f := flags.New()
+			f.Parse(test.Args)
+			env := environment.NewVariables(mockLogger)
+			proc := NewProcessor(f, env, startup.NewTimer(5, 1), context.Background(), &sync.WaitGroup{}, nil, dic)
+
+			serviceConfig := &ConfigurationMockStruct{
+				Writable: WritableInfo{LogLevel: "INFO"},
+				Service:  config.ServiceInfo{Host: "localhost", Port: 59880},
+				Clients: config.ClientsCollection{
+					"core-metadata": {Host: "localhost", Port: 59881},
+				},
+			}
+
+			err := proc.applyOverrideConfigFile(serviceConfig)
+			if len(test.ExpectedError) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.ExpectedLogLevel, serviceConfig.Writable.LogLevel)
+			assert.Equal(t, test.ExpectedPort, serviceConfig.Service.Port)
+			// Settings not in the override file retain their base values
+			assert.Equal(t, "localhost", serviceConfig.Service.Host)
+			assert.Equal(t, 59881, serviceConfig.Clients["core-metadata"].Port)
+		})
+	}
+}
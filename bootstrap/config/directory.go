@@ -0,0 +1,126 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// configDirectoryKeySeparator separates the nested keys in a file name, since the keys of a Kubernetes
+	// ConfigMap or Secret can't contain '/', i.e. the file Writable.LogLevel sets the LogLevel in the Writable section
+	configDirectoryKeySeparator = "."
+	// configDirectoryHiddenPrefix is the prefix of the ..data and timestamped directories which Kubernetes uses to
+	// atomically update a mounted ConfigMap or Secret
+	configDirectoryHiddenPrefix = ".."
+)
+
+// loadConfigFromDirectory loads the configuration tree from a directory with one file per leaf key, which is the
+// layout of a mounted Kubernetes ConfigMap or Secret. The keys are nested by sub-directories and/or by separating
+// the keys in the file name with '.', so both Writable/LogLevel and Writable.LogLevel set the Writable LogLevel.
+// The content of each file is parsed as a YAML value, as it would be in the configuration file, so values are typed
+// the same way. A Secret can be mounted in a sub-directory, i.e. Writable/InsecureSecrets/DB/SecretData, to set
+// the secrets with the rest of the configuration.
+func loadConfigFromDirectory(directory string) (map[string]any, error) {
+	data := make(map[string]any)
+	if err := loadConfigDirectoryEntries(directory, nil, data); err != nil {
+		return nil, fmt.Errorf("failed to load configuration from directory %s: %s", directory, err.Error())
+	}
+
+	return data, nil
+}
+
+func loadConfigDirectoryEntries(directory string, parentKeys []string, data map[string]any) error {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), configDirectoryHiddenPrefix) {
+			continue
+		}
+
+		entryPath := filepath.Join(directory, entry.Name())
+		keys := append(append([]string{}, parentKeys...), strings.Split(entry.Name(), configDirectoryKeySeparator)...)
+
+		// ConfigMap and Secret files are symlinks into the ..data directory, so must follow the link
+		info, err := os.Stat(entryPath)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if err := loadConfigDirectoryEntries(entryPath, keys, data); err != nil {
+				return err
+			}
+			continue
+		}
+
+		contents, err := os.ReadFile(entryPath)
+		if err != nil {
+			return err
+		}
+
+		var value any
+		if err := yaml.Unmarshal(contents, &value); err != nil {
+			return fmt.Errorf("failed to parse value of %s: %s", entryPath, err.Error())
+		}
+
+		// An empty file is an empty value rather than null
+		if value == nil {
+			value = ""
+		}
+
+		if err := setConfigDirectoryValue(data, keys, value); err != nil {
+			return fmt.Errorf("failed to set value of %s: %s", entryPath, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// setConfigDirectoryValue sets the value at the path of keys, creating the sub-maps as needed
+func setConfigDirectoryValue(data map[string]any, keys []string, value any) error {
+	current := data
+	for _, key := range keys[:len(keys)-1] {
+		next, exists := current[key]
+		if !exists {
+			subMap := make(map[string]any)
+			current[key] = subMap
+			current = subMap
+			continue
+		}
+
+		subMap, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("key '%s' is both a value and a section", key)
+		}
+		current = subMap
+	}
+
+	leafKey := keys[len(keys)-1]
+	if _, exists := current[leafKey]; exists {
+		return fmt.Errorf("key '%s' is set more than once", strings.Join(keys, "/"))
+	}
+
+	current[leafKey] = value
+	return nil
+}
@@ -0,0 +1,153 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/environment"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/flags"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/utils"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// createConfigMapMount creates a directory with the same layout as a mounted Kubernetes ConfigMap, where each key is
+// a symlink into the ..data directory which links to the timestamped directory holding the files
+func createConfigMapMount(t *testing.T, files map[string]string) string {
+	mountDir := t.TempDir()
+	dataDir := filepath.Join(mountDir, "..2024_01_02_03_04_05.000000001")
+	require.NoError(t, os.Mkdir(dataDir, 0755))
+	require.NoError(t, os.Symlink(filepath.Base(dataDir), filepath.Join(mountDir, "..data")))
+
+	for name, contents := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dataDir, name), []byte(contents), 0644))
+		require.NoError(t, os.Symlink(filepath.Join("..data", name), filepath.Join(mountDir, name)))
+	}
+
+	return mountDir
+}
+
+func TestLoadConfigFromDirectory(t *testing.T) {
+	mountDir := createConfigMapMount(t, map[string]string{
+		"Writable.LogLevel":                 "DEBUG\n",
+		"Writable.Telemetry.Interval":       "30s",
+		"Writable.Telemetry.Tags.Gateway":   "gateway-1",
+		"Service.Port":                      "59880",
+		"Service.StartupMsg":                "",
+		"MessageBus.Optional.AutoProvision": "true",
+	})
+
+	// Secrets mounted in a sub-directory
+	secretDir := filepath.Join(mountDir, "Writable", "InsecureSecrets", "DB", "SecretData")
+	require.NoError(t, os.MkdirAll(secretDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(secretDir, "password"), []byte("secret"), 0600))
+
+	actual, err := loadConfigFromDirectory(mountDir)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"Writable": map[string]any{
+			"LogLevel": "DEBUG",
+			"Telemetry": map[string]any{
+				"Interval": "30s",
+				"Tags":     map[string]any{"Gateway": "gateway-1"},
+			},
+			"InsecureSecrets": map[string]any{
+				"DB": map[string]any{
+					"SecretData": map[string]any{"password": "secret"},
+				},
+			},
+		},
+		"Service": map[string]any{
+			"Port":       59880,
+			"StartupMsg": "",
+		},
+		"MessageBus": map[string]any{
+			"Optional": map[string]any{"AutoProvision": true},
+		},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestLoadConfigFromDirectory_Errors(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Files         map[string]string
+		ExpectedError string
+	}{
+		{"Value and section", map[string]string{"Writable": "INFO", "Writable.LogLevel": "DEBUG"}, "is both a value and a section"},
+		{"Invalid value", map[string]string{"Writable.LogLevel": "[INFO"}, "failed to parse value"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mountDir := createConfigMapMount(t, test.Files)
+			_, err := loadConfigFromDirectory(mountDir)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.ExpectedError)
+		})
+	}
+
+	_, err := loadConfigFromDirectory(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestProcessorLoadConfigFromDirectory(t *testing.T) {
+	mountDir := createConfigMapMount(t, map[string]string{
+		"Writable.LogLevel":               "DEBUG",
+		"Writable.Telemetry.Tags.Gateway": "gateway-1",
+		"Service.Port":                    "59880",
+	})
+
+	t.Setenv("SERVICE_PORT", "59881")
+
+	mockLogger := logger.NewMockClient()
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} { return mockLogger },
+	})
+	f := flags.New()
+	f.Parse(nil)
+	env := environment.NewVariables(mockLogger)
+	proc := NewProcessor(f, env, startup.NewTimer(5, 1), context.Background(), &sync.WaitGroup{}, nil, dic)
+
+	configMap, err := proc.loadConfigFromFile(mountDir)
+	require.NoError(t, err)
+
+	overrideCount, err := env.OverrideConfigMapValues(configMap)
+	require.NoError(t, err)
+	assert.Equal(t, 1, overrideCount)
+
+	// Values not in the ConfigMap keep their defaults
+	serviceConfig := &ConfigurationMockStruct{
+		Service: config.ServiceInfo{Host: "localhost", Port: 59000},
+	}
+	require.NoError(t, utils.MergeValues(serviceConfig, configMap))
+
+	assert.Equal(t, "DEBUG", serviceConfig.Writable.LogLevel)
+	assert.Equal(t, map[string]string{"Gateway": "gateway-1"}, serviceConfig.Writable.Telemetry.Tags)
+	assert.Equal(t, "localhost", serviceConfig.Service.Host)
+	assert.Equal(t, 59881, serviceConfig.Service.Port)
+}
@@ -0,0 +1,639 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-configuration/v3/pkg/types"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	etcdRangePath          = "/v3/kv/range"
+	etcdPutPath            = "/v3/kv/put"
+	etcdWatchPath          = "/v3/watch"
+	etcdHealthPath         = "/health"
+	etcdKeyDelimiter       = "/"
+	etcdWatchRetryInterval = time.Second
+)
+
+// etcdClient is the Configuration Provider client for etcd, selected by the etcd type in the Configuration Provider
+// URL, i.e. etcd://localhost:2379. It uses the etcd v3 JSON gateway so no etcd client library is required.
+// The configuration is stored with one key per value under the service's base path, the same layout used for Consul.
+type etcdClient struct {
+	etcdUrl         string
+	configBasePath  string
+	accessToken     string
+	httpClient      *http.Client
+	watchClient     *http.Client
+	watchingDoneCtx context.Context
+	watchingDone    context.CancelFunc
+	watchingWait    sync.WaitGroup
+}
+
+// etcdKeyValue is a key-value pair in the etcd v3 JSON gateway API. The keys and values are base64 encoded, which
+// encoding/json does for byte slices.
+type etcdKeyValue struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value,omitempty"`
+}
+
+type etcdRangeRequest struct {
+	Key      []byte `json:"key"`
+	RangeEnd []byte `json:"range_end,omitempty"`
+	KeysOnly bool   `json:"keys_only,omitempty"`
+}
+
+type etcdRangeResponse struct {
+	Kvs []etcdKeyValue `json:"kvs"`
+}
+
+type etcdWatchCreateRequest struct {
+	Key      []byte `json:"key"`
+	RangeEnd []byte `json:"range_end,omitempty"`
+}
+
+type etcdWatchRequest struct {
+	CreateRequest etcdWatchCreateRequest `json:"create_request"`
+}
+
+type etcdWatchResponse struct {
+	Result *struct {
+		Created bool              `json:"created"`
+		Events  []json.RawMessage `json:"events"`
+	} `json:"result"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// etcdWatch holds the state of a watch for changes which is kept when the watch is re-established
+type etcdWatch struct {
+	prefix        string
+	target        any
+	updateChannel chan<- any
+	initialSent   bool
+}
+
+// newEtcdClient creates a new etcd Configuration Provider client
+func newEtcdClient(config types.ServiceConfig) (*etcdClient, error) {
+	if config.Host == "" || config.Port == 0 {
+		return nil, errors.New("unable to create etcd Configuration Client: Configuration service host and/or port not set")
+	}
+
+	client := etcdClient{
+		etcdUrl:        config.GetUrl(),
+		configBasePath: config.BasePath,
+		accessToken:    config.AccessToken,
+		httpClient:     &http.Client{Timeout: time.Second * 10},
+		// The watch is a long-lived streaming request so must not have a timeout
+		watchClient: &http.Client{},
+	}
+
+	client.watchingDoneCtx, client.watchingDone = context.WithCancel(context.Background())
+
+	if len(client.configBasePath) > 0 && !strings.HasSuffix(client.configBasePath, etcdKeyDelimiter) {
+		client.configBasePath = client.configBasePath + etcdKeyDelimiter
+	}
+
+	return &client, nil
+}
+
+// IsAlive simply checks if etcd is up and running at the configured URL
+func (client *etcdClient) IsAlive() bool {
+	resp, err := client.httpClient.Get(client.etcdUrl + etcdHealthPath)
+	if err != nil {
+		return false
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	return resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices
+}
+
+// HasConfiguration checks to see if etcd contains the service's configuration.
+func (client *etcdClient) HasConfiguration() (bool, error) {
+	keys, err := client.getKeys(client.configBasePath)
+	if err != nil {
+		return false, fmt.Errorf("checking configuration existence from etcd failed: %v", err)
+	}
+
+	return len(keys) > 0, nil
+}
+
+// HasSubConfiguration checks to see if etcd contains the service's sub configuration.
+func (client *etcdClient) HasSubConfiguration(name string) (bool, error) {
+	keys, err := client.getKeys(client.fullPath(name))
+	if err != nil {
+		return false, fmt.Errorf("checking sub configuration existence from etcd failed: %v", err)
+	}
+
+	return len(keys) > 0, nil
+}
+
+// PutConfigurationMap puts a full configuration map into etcd.
+// The sub-paths to where the values are to be stored in etcd are generated from the map key.
+func (client *etcdClient) PutConfigurationMap(configuration map[string]any, overwrite bool) error {
+	for _, keyValue := range convertToEtcdPairs("", configuration) {
+		exists, _ := client.ConfigurationValueExists(keyValue.Key)
+		if !exists || overwrite {
+			if err := client.PutConfigurationValue(keyValue.Key, []byte(keyValue.Value)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// PutConfiguration puts a full configuration struct into etcd
+func (client *etcdClient) PutConfiguration(configuration interface{}, overwrite bool) error {
+	configMap := make(map[string]any)
+	data, err := json.Marshal(configuration)
+	if err != nil {
+		return err
+	}
+
+	if err = json.Unmarshal(data, &configMap); err != nil {
+		return err
+	}
+
+	return client.PutConfigurationMap(configMap, overwrite)
+}
+
+// GetConfiguration gets the full configuration from etcd into the target configuration struct.
+// Returns the configuration in the target struct as interface{}, which caller must cast
+func (client *etcdClient) GetConfiguration(configStruct interface{}) (interface{}, error) {
+	exists, err := client.HasConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("the Configuration service (etcd) doesn't contain configuration for %s", client.configBasePath)
+	}
+
+	pairs, err := client.getRange(client.configBasePath, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get configuration for %s from etcd: %v", client.configBasePath, err)
+	}
+
+	if err = decodeEtcdPairs(client.configBasePath, pairs, configStruct); err != nil {
+		return nil, err
+	}
+
+	return configStruct, nil
+}
+
+// WatchForChanges sets up an etcd watch for the target key and sends back updates on the update channel.
+// Passed in struct is only a reference for the type to decode to, empty struct is ok
+// Sends the configuration in a new instance of the target struct as interface{} on updateChannel, which caller must
+// cast. The watch is re-established after errors until StopWatching is called.
+func (client *etcdClient) WatchForChanges(updateChannel chan<- interface{}, errorChannel chan<- error, configuration interface{}, watchKey string, _ messaging.MessageClient) {
+	// some watch keys may have start with "/", need to remove it since the base path already has it.
+	watchKey = strings.TrimPrefix(watchKey, etcdKeyDelimiter)
+
+	watch := &etcdWatch{
+		prefix:        client.configBasePath + watchKey,
+		target:        configuration,
+		updateChannel: updateChannel,
+	}
+
+	client.watchingWait.Add(1)
+
+	go func() {
+		defer client.watchingWait.Done()
+
+		for {
+			err := client.watch(watch)
+			if client.watchingDoneCtx.Err() != nil {
+				return
+			}
+
+			if err != nil {
+				select {
+				case errorChannel <- err:
+				case <-client.watchingDoneCtx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-client.watchingDoneCtx.Done():
+				return
+			case <-time.After(etcdWatchRetryInterval):
+			}
+		}
+	}()
+}
+
+// StopWatching causes all WatchForChanges processing to stop and waits until they have exited.
+func (client *etcdClient) StopWatching() {
+	client.watchingDone()
+	client.watchingWait.Wait()
+}
+
+// ConfigurationValueExists checks if a configuration value exists in etcd
+func (client *etcdClient) ConfigurationValueExists(name string) (bool, error) {
+	pairs, err := client.getKey(client.fullPath(name), true)
+	if err != nil {
+		return false, fmt.Errorf("unable to check existence of %s in etcd: %v", client.fullPath(name), err)
+	}
+
+	return len(pairs) > 0, nil
+}
+
+// GetConfigurationValue gets a specific configuration value from etcd
+func (client *etcdClient) GetConfigurationValue(name string) ([]byte, error) {
+	return client.GetConfigurationValueByFullPath(client.fullPath(name))
+}
+
+// GetConfigurationValueByFullPath gets a specific configuration value given the full path from etcd
+func (client *etcdClient) GetConfigurationValueByFullPath(fullPath string) ([]byte, error) {
+	pairs, err := client.getKey(fullPath, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get value for %s from etcd: %v", fullPath, err)
+	}
+
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	return pairs[0].Value, nil
+}
+
+// PutConfigurationValue puts a specific configuration value into etcd
+func (client *etcdClient) PutConfigurationValue(name string, value []byte) error {
+	request := etcdKeyValue{
+		Key:   []byte(client.fullPath(name)),
+		Value: value,
+	}
+
+	if err := client.post(etcdPutPath, request, nil); err != nil {
+		return fmt.Errorf("unable to put value for %s into etcd: %v", client.fullPath(name), err)
+	}
+
+	return nil
+}
+
+// GetConfigurationKeys returns all keys under name
+func (client *etcdClient) GetConfigurationKeys(name string) ([]string, error) {
+	keys, err := client.getKeys(client.fullPath(name))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get list of keys for %s from etcd: %v", client.fullPath(name), err)
+	}
+
+	return keys, nil
+}
+
+// watch creates the etcd watch and sends the configuration on the update channel each time it changes. It returns
+// when the watch stream ends, which is without an error when StopWatching has been called.
+func (client *etcdClient) watch(watch *etcdWatch) error {
+	targetType := reflect.TypeOf(watch.target)
+	if targetType == nil || targetType.Kind() != reflect.Pointer {
+		return fmt.Errorf("unable to watch %s in etcd: watch target must be a pointer, got %T", watch.prefix, watch.target)
+	}
+
+	request := etcdWatchRequest{
+		CreateRequest: etcdWatchCreateRequest{
+			Key:      []byte(watch.prefix),
+			RangeEnd: etcdPrefixEnd(watch.prefix),
+		},
+	}
+
+	resp, err := client.send(client.watchingDoneCtx, client.watchClient, etcdWatchPath, request)
+	if err != nil {
+		return fmt.Errorf("unable to watch %s in etcd: %v", watch.prefix, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var watchResponse etcdWatchResponse
+		if err := decoder.Decode(&watchResponse); err != nil {
+			if client.watchingDoneCtx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("etcd watch for %s ended: %v", watch.prefix, err)
+		}
+
+		if watchResponse.Error != nil {
+			return fmt.Errorf("etcd watch for %s failed: %s", watch.prefix, watchResponse.Error.Message)
+		}
+
+		if watchResponse.Result == nil {
+			continue
+		}
+
+		// An update is sent once the watch is first created, as is done for the other Configuration Providers, since
+		// go-mod-bootstrap ignores the first update. It is not re-sent when the watch is re-established.
+		sendUpdate := len(watchResponse.Result.Events) > 0 || (watchResponse.Result.Created && !watch.initialSent)
+		if !sendUpdate {
+			continue
+		}
+
+		pairs, err := client.getRange(watch.prefix, false)
+		if err != nil {
+			return fmt.Errorf("unable to get changed configuration for %s from etcd: %v", watch.prefix, err)
+		}
+
+		updated := reflect.New(targetType.Elem()).Interface()
+		if err := decodeEtcdPairs(watch.prefix, pairs, updated); err != nil {
+			return err
+		}
+
+		select {
+		case watch.updateChannel <- updated:
+			watch.initialSent = true
+		case <-client.watchingDoneCtx.Done():
+			return nil
+		}
+	}
+}
+
+// getKey gets the key-value pair for the exact key, which is empty if the key doesn't exist
+func (client *etcdClient) getKey(key string, keysOnly bool) ([]etcdKeyValue, error) {
+	request := etcdRangeRequest{
+		Key:      []byte(key),
+		KeysOnly: keysOnly,
+	}
+
+	var response etcdRangeResponse
+	if err := client.post(etcdRangePath, request, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Kvs, nil
+}
+
+// getRange gets all the key-value pairs with keys starting with the prefix
+func (client *etcdClient) getRange(prefix string, keysOnly bool) ([]etcdKeyValue, error) {
+	request := etcdRangeRequest{
+		Key:      []byte(prefix),
+		RangeEnd: etcdPrefixEnd(prefix),
+		KeysOnly: keysOnly,
+	}
+
+	var response etcdRangeResponse
+	if err := client.post(etcdRangePath, request, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Kvs, nil
+}
+
+// getKeys gets all the keys starting with the prefix
+func (client *etcdClient) getKeys(prefix string) ([]string, error) {
+	pairs, err := client.getRange(prefix, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, pair := range pairs {
+		keys = append(keys, string(pair.Key))
+	}
+
+	return keys, nil
+}
+
+// post sends the request to the etcd JSON gateway and decodes the response, if one is expected
+func (client *etcdClient) post(path string, request any, response any) error {
+	resp, err := client.send(context.Background(), client.httpClient, path, request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if response == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("failed to decode etcd response: %v", err)
+	}
+
+	return nil
+}
+
+// send sends the request to the etcd JSON gateway and returns the response when it has a success status code
+func (client *etcdClient) send(ctx context.Context, httpClient *http.Client, path string, request any) (*http.Response, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.etcdUrl+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if len(client.accessToken) > 0 {
+		req.Header.Set("Authorization", client.accessToken)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("etcd responded with status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	return resp, nil
+}
+
+func (client *etcdClient) fullPath(name string) string {
+	return client.configBasePath + name
+}
+
+// etcdPrefixEnd returns the range end for getting all the keys with the prefix, which is the prefix with its last
+// byte incremented
+func etcdPrefixEnd(prefix string) []byte {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+
+	// The prefix is all 0xff bytes so the range is to the end of the key space
+	return []byte{0}
+}
+
+type etcdPair struct {
+	Key   string
+	Value string
+}
+
+// convertToEtcdPairs flattens the configuration map to one key-value pair per value, with the key being the path to
+// the value
+func convertToEtcdPairs(path string, configuration any) []etcdPair {
+	var pairs []etcdPair
+
+	pathPre := ""
+	if path != "" {
+		pathPre = path + etcdKeyDelimiter
+	}
+
+	switch value := configuration.(type) {
+	case []any:
+		for index, item := range value {
+			pairs = append(pairs, convertToEtcdPairs(pathPre+strconv.Itoa(index), item)...)
+		}
+
+	case map[string]any:
+		for key, item := range value {
+			pairs = append(pairs, convertToEtcdPairs(pathPre+key, item)...)
+		}
+
+	case float64:
+		pairs = append(pairs, etcdPair{Key: path, Value: strconv.FormatFloat(value, 'f', -1, 64)})
+
+	case nil:
+		pairs = append(pairs, etcdPair{Key: path, Value: ""})
+
+	default:
+		pairs = append(pairs, etcdPair{Key: path, Value: fmt.Sprint(value)})
+	}
+
+	return pairs
+}
+
+// decodeEtcdPairs converts the key-value pairs from etcd to the target configuration data type
+func decodeEtcdPairs(prefix string, pairs []etcdKeyValue, target any) error {
+	if !strings.HasSuffix(prefix, etcdKeyDelimiter) {
+		prefix += etcdKeyDelimiter
+	}
+
+	raw := make(map[string]any)
+	for _, pair := range pairs {
+		key := strings.TrimPrefix(string(pair.Key), prefix)
+
+		// Determine what map we're writing the value to. We split by '/' to determine any sub-maps that need to be
+		// created.
+		m := raw
+		children := strings.Split(key, etcdKeyDelimiter)
+		key = children[len(children)-1]
+		for _, child := range children[:len(children)-1] {
+			if m[child] == nil {
+				m[child] = make(map[string]any)
+			}
+
+			subMap, ok := m[child].(map[string]any)
+			if !ok {
+				return fmt.Errorf("etcd key '%s' is both a value and a sub-path", child)
+			}
+
+			m = subMap
+		}
+
+		m[key] = string(pair.Value)
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		Result:           target,
+		DecodeHook:       decodeEtcdEmptyValue,
+	})
+	if err != nil {
+		return fmt.Errorf("etcd configuration decoding failed: %v", err)
+	}
+
+	if err := decoder.Decode(etcdMapsToSlices(raw)); err != nil {
+		return fmt.Errorf("etcd configuration decoding failed: %v", err)
+	}
+
+	return nil
+}
+
+// decodeEtcdEmptyValue decodes the empty values, which is how nil values are stored, as the zero value for the maps,
+// slices and structs in the target
+func decodeEtcdEmptyValue(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if from.Kind() != reflect.String || data != "" {
+		return data, nil
+	}
+
+	switch to.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Struct, reflect.Pointer:
+		return reflect.Zero(to).Interface(), nil
+	default:
+		return data, nil
+	}
+}
+
+// etcdMapsToSlices converts the maps whose keys are the indexes 0 to n-1 back to slices, since slices are stored with
+// one key per item
+func etcdMapsToSlices(value any) any {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+
+	for key, item := range m {
+		m[key] = etcdMapsToSlices(item)
+	}
+
+	if len(m) == 0 {
+		return m
+	}
+
+	indexes := make([]int, 0, len(m))
+	for key := range m {
+		index, err := strconv.Atoi(key)
+		if err != nil || strconv.Itoa(index) != key {
+			return m
+		}
+		indexes = append(indexes, index)
+	}
+
+	sort.Ints(indexes)
+	for i, index := range indexes {
+		if i != index {
+			return m
+		}
+	}
+
+	slice := make([]any, len(m))
+	for i := range slice {
+		slice[i] = m[strconv.Itoa(i)]
+	}
+
+	return slice
+}
@@ -0,0 +1,234 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package config
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-configuration/v3/pkg/types"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/server/v3/embed"
+)
+
+type etcdTestWritable struct {
+	LogLevel string
+	Tags     map[string]string
+	Labels   map[string]string
+}
+
+type etcdTestConfig struct {
+	Writable etcdTestWritable
+	Port     int
+	Enabled  bool
+	Ratio    float64
+	Hosts    []string
+}
+
+// newEmbeddedEtcdServer starts a single member etcd server for the test, which serves the v3 JSON gateway used by the
+// etcd client on its client URL, and returns the provider configuration to connect to it.
+func newEmbeddedEtcdServer(t *testing.T) types.ServiceConfig {
+	clientPort := getFreePort(t)
+	clientUrl := url.URL{Scheme: "http", Host: net.JoinHostPort("127.0.0.1", strconv.Itoa(clientPort))}
+	peerUrl := url.URL{Scheme: "http", Host: net.JoinHostPort("127.0.0.1", strconv.Itoa(getFreePort(t)))}
+
+	etcdConfig := embed.NewConfig()
+	etcdConfig.Dir = t.TempDir()
+	etcdConfig.LogLevel = "error"
+	etcdConfig.UnsafeNoFsync = true
+	etcdConfig.ListenClientUrls = []url.URL{clientUrl}
+	etcdConfig.AdvertiseClientUrls = []url.URL{clientUrl}
+	etcdConfig.ListenPeerUrls = []url.URL{peerUrl}
+	etcdConfig.AdvertisePeerUrls = []url.URL{peerUrl}
+	etcdConfig.InitialCluster = etcdConfig.InitialClusterFromName(etcdConfig.Name)
+
+	server, err := embed.StartEtcd(etcdConfig)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	select {
+	case <-server.Server.ReadyNotify():
+	case err := <-server.Err():
+		require.NoError(t, err)
+	case <-time.After(time.Second * 10):
+		require.Fail(t, "timed out waiting for the embedded etcd server to start")
+	}
+
+	return types.ServiceConfig{
+		Type:     configProviderTypeEtcd,
+		Host:     "127.0.0.1",
+		Port:     clientPort,
+		BasePath: "edgex/v3/core-data",
+	}
+}
+
+func getFreePort(t *testing.T) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func TestEtcdClient_PutGetConfiguration(t *testing.T) {
+	serviceConfig := newEmbeddedEtcdServer(t)
+	client, err := newEtcdClient(serviceConfig)
+	require.NoError(t, err)
+
+	assert.True(t, client.IsAlive())
+
+	exists, err := client.HasConfiguration()
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = client.GetConfiguration(&etcdTestConfig{})
+	require.Error(t, err)
+
+	expected := etcdTestConfig{
+		Writable: etcdTestWritable{
+			LogLevel: "INFO",
+			Tags:     map[string]string{"Gateway": "gateway-1"},
+		},
+		Port:    59880,
+		Enabled: true,
+		Ratio:   0.5,
+		Hosts:   []string{"host-a", "host-b"},
+	}
+	require.NoError(t, client.PutConfiguration(expected, false))
+
+	exists, err = client.HasConfiguration()
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = client.HasSubConfiguration("Writable")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	raw, err := client.GetConfiguration(&etcdTestConfig{})
+	require.NoError(t, err)
+	actual, ok := raw.(*etcdTestConfig)
+	require.True(t, ok)
+	assert.Equal(t, expected, *actual)
+
+	// Existing values are only replaced when overwriting
+	updated := expected
+	updated.Port = 59881
+	require.NoError(t, client.PutConfiguration(updated, false))
+	raw, err = client.GetConfiguration(&etcdTestConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, 59880, raw.(*etcdTestConfig).Port)
+
+	require.NoError(t, client.PutConfiguration(updated, true))
+	raw, err = client.GetConfiguration(&etcdTestConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, 59881, raw.(*etcdTestConfig).Port)
+}
+
+func TestEtcdClient_ConfigurationValue(t *testing.T) {
+	serviceConfig := newEmbeddedEtcdServer(t)
+	client, err := newEtcdClient(serviceConfig)
+	require.NoError(t, err)
+
+	exists, err := client.ConfigurationValueExists("Writable/LogLevel")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	value, err := client.GetConfigurationValue("Writable/LogLevel")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, client.PutConfigurationValue("Writable/LogLevel", []byte("DEBUG")))
+	require.NoError(t, client.PutConfigurationValue("Writable/InsecureSecrets/DB/SecretName", []byte("redisdb")))
+
+	exists, err = client.ConfigurationValueExists("Writable/LogLevel")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	value, err = client.GetConfigurationValue("Writable/LogLevel")
+	require.NoError(t, err)
+	assert.Equal(t, "DEBUG", string(value))
+
+	value, err = client.GetConfigurationValueByFullPath("edgex/v3/core-data/Writable/LogLevel")
+	require.NoError(t, err)
+	assert.Equal(t, "DEBUG", string(value))
+
+	keys, err := client.GetConfigurationKeys("Writable")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"edgex/v3/core-data/Writable/InsecureSecrets/DB/SecretName",
+		"edgex/v3/core-data/Writable/LogLevel",
+	}, keys)
+}
+
+func TestEtcdClient_WatchForChanges(t *testing.T) {
+	serviceConfig := newEmbeddedEtcdServer(t)
+	client, err := newEtcdClient(serviceConfig)
+	require.NoError(t, err)
+
+	require.NoError(t, client.PutConfiguration(etcdTestConfig{Writable: etcdTestWritable{LogLevel: "INFO"}}, true))
+
+	updateStream := make(chan any)
+	errorStream := make(chan error)
+	client.WatchForChanges(updateStream, errorStream, &etcdTestWritable{}, "/Writable", nil)
+
+	receive := func() *etcdTestWritable {
+		select {
+		case raw := <-updateStream:
+			writable, ok := raw.(*etcdTestWritable)
+			require.True(t, ok)
+			return writable
+		case err := <-errorStream:
+			require.NoError(t, err)
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "timed out waiting for configuration update")
+		}
+		return nil
+	}
+
+	// An update is sent once the watch is created
+	initial := receive()
+	assert.Equal(t, "INFO", initial.LogLevel)
+
+	require.NoError(t, client.PutConfigurationValue("Writable/LogLevel", []byte("DEBUG")))
+	updated := receive()
+	assert.Equal(t, "DEBUG", updated.LogLevel)
+	assert.Equal(t, "INFO", initial.LogLevel, "previous update must not be modified")
+
+	client.StopWatching()
+}
+
+func TestCreateProviderClient_Etcd(t *testing.T) {
+	providerConfig := types.ServiceConfig{}
+	require.NoError(t, providerConfig.PopulateFromUrl("etcd://localhost:2379"))
+	assert.Equal(t, configProviderTypeEtcd, providerConfig.Type)
+
+	client, err := CreateProviderClient(logger.NewMockClient(), "core-data", "edgex/v3", nil, providerConfig)
+	require.NoError(t, err)
+	actual, ok := client.(*etcdClient)
+	require.True(t, ok)
+	assert.Equal(t, "http://localhost:2379", actual.etcdUrl)
+	assert.Equal(t, "edgex/v3/core-data/", actual.configBasePath)
+}
+
+func TestEtcdPrefixEnd(t *testing.T) {
+	assert.Equal(t, []byte("edgex/v3/core-data0"), etcdPrefixEnd("edgex/v3/core-data/"))
+	assert.Equal(t, []byte("b"), etcdPrefixEnd("a\xff"))
+	assert.Equal(t, []byte{0}, etcdPrefixEnd("\xff"))
+}
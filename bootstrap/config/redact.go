@@ -0,0 +1,110 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package config
+
+import (
+	"reflect"
+)
+
+const (
+	// RedactedValue replaces the value of secret fields redacted by RedactSecrets
+	RedactedValue = "***"
+	// secretTagName is the struct tag which marks a configuration field as secret, i.e. `secret:"true"`
+	secretTagName = "secret"
+)
+
+// RedactSecrets returns a deep copy of the configuration with the fields tagged `secret:"true"` redacted, so the
+// configuration can be logged or returned from an API without leaking secrets. All the strings within a tagged field,
+// including within nested structs, maps and slices, are replaced with RedactedValue unless empty, and any other
+// values are set to their zero value. The original configuration is not modified.
+func RedactSecrets[T any](configuration T) T {
+	redacted, _ := redactValue(reflect.ValueOf(&configuration).Elem(), false).Interface().(T)
+	return redacted
+}
+
+// redactValue returns a deep copy of the value, redacting it if it is within a secret field
+func redactValue(value reflect.Value, redact bool) reflect.Value {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(redactValue(value.Elem(), redact))
+		return copied
+
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(redactValue(value.Elem(), redact))
+		return copied
+
+	case reflect.Struct:
+		// Start with a shallow copy so unexported fields, which can't be set via reflection, are kept
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			isSecret := redact || field.Tag.Get(secretTagName) == "true"
+			copied.Field(i).Set(redactValue(value.Field(i), isSecret))
+		}
+		return copied
+
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), redactValue(iter.Value(), redact))
+		}
+		return copied
+
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(redactValue(value.Index(i), redact))
+		}
+		return copied
+
+	case reflect.Array:
+		copied := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(redactValue(value.Index(i), redact))
+		}
+		return copied
+
+	case reflect.String:
+		if redact && value.Len() > 0 {
+			return reflect.ValueOf(RedactedValue).Convert(value.Type())
+		}
+		return value
+
+	default:
+		if redact && value.IsValid() {
+			return reflect.Zero(value.Type())
+		}
+		return value
+	}
+}
@@ -0,0 +1,124 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+type redactTestDatabase struct {
+	Host        string
+	Port        int
+	Credentials config.Credentials
+}
+
+type redactTestConfig struct {
+	Name      string
+	Token     string `secret:"true"`
+	Retries   int    `secret:"true"`
+	Primary   redactTestDatabase
+	Replica   *redactTestDatabase
+	Databases map[string]redactTestDatabase
+	Secrets   map[string]string `secret:"true"`
+	Insecure  config.InsecureSecrets
+	Hosts     []string
+	Any       any
+	internal  string
+}
+
+func newRedactTestConfig() *redactTestConfig {
+	return &redactTestConfig{
+		Name:    "core-data",
+		Token:   "my-token",
+		Retries: 3,
+		Primary: redactTestDatabase{
+			Host:        "primary",
+			Port:        5432,
+			Credentials: config.Credentials{Username: "primary-user", Password: "primary-password"},
+		},
+		Replica: &redactTestDatabase{
+			Host:        "replica",
+			Credentials: config.Credentials{Username: "replica-user", Password: "replica-password"},
+		},
+		Databases: map[string]redactTestDatabase{
+			"metrics": {Host: "metrics", Credentials: config.Credentials{Username: "metrics-user", Password: "metrics-password"}},
+		},
+		Secrets: map[string]string{"apiKey": "my-api-key"},
+		Insecure: config.InsecureSecrets{
+			"DB": config.InsecureSecretsInfo{
+				SecretName: "redisdb",
+				SecretData: map[string]string{"username": "edgex", "password": "insecure-password"},
+			},
+		},
+		Hosts:    []string{"host-a", "host-b"},
+		Any:      config.Credentials{Username: "any-user", Password: "any-password"},
+		internal: "internal",
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+	original := newRedactTestConfig()
+
+	actual := RedactSecrets(original)
+	require.NotNil(t, actual)
+	require.NotSame(t, original, actual)
+
+	// Only the tagged fields are redacted
+	assert.Equal(t, "core-data", actual.Name)
+	assert.Equal(t, RedactedValue, actual.Token)
+	assert.Equal(t, 0, actual.Retries)
+	assert.Equal(t, []string{"host-a", "host-b"}, actual.Hosts)
+	assert.Equal(t, "internal", actual.internal)
+
+	assert.Equal(t, "primary", actual.Primary.Host)
+	assert.Equal(t, 5432, actual.Primary.Port)
+	assert.Equal(t, "primary-user", actual.Primary.Credentials.Username)
+	assert.Equal(t, RedactedValue, actual.Primary.Credentials.Password)
+
+	require.NotNil(t, actual.Replica)
+	assert.Equal(t, "replica-user", actual.Replica.Credentials.Username)
+	assert.Equal(t, RedactedValue, actual.Replica.Credentials.Password)
+
+	assert.Equal(t, "metrics-user", actual.Databases["metrics"].Credentials.Username)
+	assert.Equal(t, RedactedValue, actual.Databases["metrics"].Credentials.Password)
+
+	assert.Equal(t, map[string]string{"apiKey": RedactedValue}, actual.Secrets)
+
+	assert.Equal(t, "redisdb", actual.Insecure["DB"].SecretName)
+	assert.Equal(t, map[string]string{"username": RedactedValue, "password": RedactedValue}, actual.Insecure["DB"].SecretData)
+
+	anyCredentials, ok := actual.Any.(config.Credentials)
+	require.True(t, ok)
+	assert.Equal(t, "any-user", anyCredentials.Username)
+	assert.Equal(t, RedactedValue, anyCredentials.Password)
+
+	// The original is not modified
+	assert.Equal(t, newRedactTestConfig(), original)
+}
+
+func TestRedactSecrets_NilAndEmpty(t *testing.T) {
+	var nilConfig *redactTestConfig
+	assert.Nil(t, RedactSecrets(nilConfig))
+
+	actual := RedactSecrets(redactTestConfig{Token: ""})
+	assert.Empty(t, actual.Token, "empty secrets should remain empty")
+	assert.Nil(t, actual.Replica)
+	assert.Nil(t, actual.Secrets)
+}
@@ -0,0 +1,107 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+)
+
+// validatorType is the reflected type of the interfaces.Validator interface
+var validatorType = reflect.TypeOf((*interfaces.Validator)(nil)).Elem()
+
+// validateConfiguration calls Validate on every section of the configuration, including nested sections and the
+// values of maps and slices, which implements interfaces.Validator. All the failures are aggregated into the
+// returned error, each prefixed with the path to the invalid section, e.g. Writable.Telemetry
+func validateConfiguration(configuration any) error {
+	return validateValue(reflect.ValueOf(configuration), "", nil)
+}
+
+// validateValue validates the value and its nested values, appending any failures to result
+func validateValue(value reflect.Value, path string, result error) error {
+	if !value.IsValid() {
+		return result
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return result
+		}
+		return validateValue(value.Elem(), path, result)
+	}
+
+	// Values within maps and interfaces aren't addressable, so must be copied for pointer receiver Validate methods
+	if !value.CanAddr() {
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		value = copied
+	}
+
+	if value.Addr().Type().Implements(validatorType) {
+		if err := value.Addr().Interface().(interfaces.Validator).Validate(); err != nil {
+			// Each of an aggregated error's failures is reported separately with the path of the section
+			failures := []error{err}
+			var multiErr *multierror.Error
+			if errors.As(err, &multiErr) {
+				failures = multiErr.Errors
+			}
+
+			for _, failure := range failures {
+				if len(path) > 0 {
+					failure = fmt.Errorf("%s.%w", path, failure)
+				}
+				result = multierror.Append(result, failure)
+			}
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			result = validateValue(value.Field(i), joinValidationPath(path, field.Name), result)
+		}
+
+	case reflect.Map:
+		iter := value.MapRange()
+		for iter.Next() {
+			result = validateValue(iter.Value(), joinValidationPath(path, fmt.Sprintf("%v", iter.Key().Interface())), result)
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			result = validateValue(value.Index(i), fmt.Sprintf("%s[%d]", path, i), result)
+		}
+	}
+
+	return result
+}
+
+// joinValidationPath appends the name to the path of the parent section
+func joinValidationPath(path string, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,94 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/environment"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/flags"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+func TestValidateConfiguration(t *testing.T) {
+	valid := func() *ConfigurationMockStruct {
+		return &ConfigurationMockStruct{
+			Writable: WritableInfo{
+				LogLevel:  "INFO",
+				Telemetry: config.TelemetryInfo{Interval: "30s"},
+			},
+			Database: config.Database{Type: "postgres", Host: "localhost", Port: 5432},
+		}
+	}
+
+	require.NoError(t, validateConfiguration(valid()))
+	require.NoError(t, validateConfiguration(&ConfigurationMockStruct{}), "unconfigured sections should be valid")
+
+	invalid := valid()
+	invalid.Writable.Telemetry.Interval = "-30s"
+	invalid.Database.Port = 0
+	err := validateConfiguration(invalid)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Writable.Telemetry.Interval '-30s' must not be negative")
+	assert.Contains(t, err.Error(), "Database.Port 0 is not a valid port number")
+
+	// Sections within maps and behind pointers are also validated
+	nested := struct {
+		Databases map[string]config.Database
+		Telemetry *config.TelemetryInfo
+	}{
+		Databases: map[string]config.Database{"primary": {Type: "postgres", Port: 5432}},
+		Telemetry: &config.TelemetryInfo{Interval: "soon"},
+	}
+	err = validateConfiguration(&nested)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Databases.primary.Host is required")
+	assert.Contains(t, err.Error(), "Telemetry.Interval 'soon' is invalid time duration")
+}
+
+func TestProcessInvalidConfiguration(t *testing.T) {
+	// The configuration is only loaded from the file when no Configuration Provider is set
+	t.Setenv("EDGEX_CONFIG_PROVIDER", "")
+
+	configDir := t.TempDir()
+	configFile := filepath.Join(configDir, "configuration.yaml")
+	contents := "Writable:\n  LogLevel: INFO\n  Telemetry:\n    Interval: -30s\n"
+	require.NoError(t, os.WriteFile(configFile, []byte(contents), 0644))
+
+	mockLogger := logger.NewMockClient()
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} { return mockLogger },
+	})
+	f := flags.New()
+	f.Parse([]string{"-cd=" + configDir, "-cf=configuration.yaml"})
+	env := environment.NewVariables(mockLogger)
+	proc := NewProcessor(f, env, startup.NewTimer(5, 1), context.Background(), &sync.WaitGroup{}, nil, dic)
+
+	err := proc.Process("core-data", config.ServiceTypeOther, "edgex/v3", &ConfigurationMockStruct{}, nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "configuration is invalid")
+	assert.Contains(t, err.Error(), "Writable.Telemetry.Interval '-30s' must not be negative")
+}
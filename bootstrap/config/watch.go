@@ -0,0 +1,217 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/utils"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// DefaultWritableWatchDebounce is the time a Writable section must be unchanged before its watchers are called
+const DefaultWritableWatchDebounce = 500 * time.Millisecond
+
+const writableSectionSeparator = "."
+
+// writableWatch is a callback registered for a section of the Writable configuration
+type writableWatch struct {
+	path      []string
+	newTarget func() any
+	callback  func(value any)
+	previous  []byte
+	pending   []byte
+	timer     *time.Timer
+}
+
+// writableWatcher is the interfaces.WritableWatcher which calls the watches registered for the sections of the
+// service's Writable configuration when the Writable configuration is updated from the Configuration Provider.
+type writableWatcher struct {
+	lc       logger.LoggingClient
+	writable func() any
+	debounce time.Duration
+	mutex    sync.Mutex
+	watches  []*writableWatch
+}
+
+var _ interfaces.WritableWatcher = (*writableWatcher)(nil)
+
+// newWritableWatcher creates the writableWatcher for the Writable configuration returned by writable
+func newWritableWatcher(lc logger.LoggingClient, writable func() any, debounce time.Duration) *writableWatcher {
+	return &writableWatcher{
+		lc:       lc,
+		writable: writable,
+		debounce: debounce,
+	}
+}
+
+// Watch implements interfaces.WritableWatcher
+func (w *writableWatcher) Watch(section string, newTarget func() any, callback func(value any)) error {
+	if len(strings.TrimSpace(section)) == 0 {
+		return errors.New("the Writable section to watch must be specified")
+	}
+	if newTarget == nil || callback == nil {
+		return fmt.Errorf("the target and callback must be specified to watch Writable section '%s'", section)
+	}
+
+	watch := &writableWatch{
+		path:      strings.Split(section, writableSectionSeparator),
+		newTarget: newTarget,
+		callback:  callback,
+	}
+
+	writable, err := w.writableMap()
+	if err != nil {
+		return err
+	}
+
+	previous, err := sectionValue(writable, watch.path)
+	if err != nil {
+		return err
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	watch.previous = previous
+	w.watches = append(w.watches, watch)
+
+	return nil
+}
+
+// notify checks the watched sections for changes after the Writable configuration has been updated. The callbacks
+// of the changed sections are called once the sections have been unchanged for the debounce time.
+func (w *writableWatcher) notify() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if len(w.watches) == 0 {
+		return
+	}
+
+	writable, err := w.writableMap()
+	if err != nil {
+		w.lc.Errorf("unable to check Writable sections for changes: %s", err.Error())
+		return
+	}
+
+	for _, watch := range w.watches {
+		current, err := sectionValue(writable, watch.path)
+		if err != nil {
+			w.lc.Errorf("unable to check Writable section for changes: %s", err.Error())
+			continue
+		}
+
+		if bytes.Equal(current, watch.previous) {
+			continue
+		}
+
+		watch.previous = current
+		watch.pending = current
+
+		if watch.timer == nil {
+			watch := watch
+			watch.timer = time.AfterFunc(w.debounce, func() { w.fire(watch) })
+			continue
+		}
+
+		watch.timer.Reset(w.debounce)
+	}
+}
+
+// fire calls the watch's callback with the last change to the watched section
+func (w *writableWatcher) fire(watch *writableWatch) {
+	w.mutex.Lock()
+	pending := watch.pending
+	watch.pending = nil
+	w.mutex.Unlock()
+
+	if pending == nil {
+		return
+	}
+
+	section := strings.Join(watch.path, writableSectionSeparator)
+	target := watch.newTarget()
+	if err := json.Unmarshal(pending, target); err != nil {
+		w.lc.Errorf("unable to decode updated Writable section '%s' into %T: %s", section, target, err.Error())
+		return
+	}
+
+	w.lc.Debugf("Calling watcher for updated Writable section '%s'", section)
+	watch.callback(target)
+}
+
+func (w *writableWatcher) writableMap() (map[string]any, error) {
+	var writable map[string]any
+	if err := utils.ConvertToMap(w.writable(), &writable); err != nil {
+		return nil, fmt.Errorf("unable to read the Writable configuration: %s", err.Error())
+	}
+
+	return writable, nil
+}
+
+// sectionValue returns the JSON encoding of the Writable section at the path. The section names are matched ignoring
+// case as the Configuration Provider keys aren't guaranteed to match the case of the configuration struct.
+func sectionValue(writable map[string]any, path []string) ([]byte, error) {
+	var value any = writable
+	for index, name := range path {
+		current, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("'%s' in the Writable configuration is not a section", strings.Join(path[:index], writableSectionSeparator))
+		}
+
+		value, ok = current[name]
+		if !ok {
+			found := false
+			for key, keyValue := range current {
+				if strings.EqualFold(key, name) {
+					value = keyValue
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return nil, fmt.Errorf("section '%s' not found in the Writable configuration", strings.Join(path[:index+1], writableSectionSeparator))
+			}
+		}
+	}
+
+	return json.Marshal(value)
+}
+
+// WatchWritable registers the callback invoked with the new value of the Writable section when it is changed in the
+// Configuration Provider. The section is the path of the section within Writable, i.e. "Pipeline.Functions", and is
+// decoded into T. This must be called once the configuration has been processed, i.e. from a BootstrapHandler.
+func WatchWritable[T any](dic *di.Container, section string, callback func(value T)) error {
+	watcher := container.WritableWatcherFrom(dic.Get)
+	if watcher == nil {
+		return errors.New("WritableWatcher not available, configuration must be processed before watching Writable sections")
+	}
+
+	return watcher.Watch(
+		section,
+		func() any { return new(T) },
+		func(value any) { callback(*value.(*T)) })
+}
@@ -0,0 +1,165 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+const testWatchDebounce = 50 * time.Millisecond
+
+func newWatchTestProcessor(serviceConfig *ConfigurationMockStruct) *Processor {
+	lc := logger.NewMockClient()
+	watcher := newWritableWatcher(lc, serviceConfig.GetWritablePtr, testWatchDebounce)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return lc
+		},
+		container.WritableWatcherInterfaceName: func(get di.Get) interface{} {
+			return watcher
+		},
+	})
+
+	return &Processor{
+		lc:              lc,
+		dic:             dic,
+		writableWatcher: watcher,
+	}
+}
+
+func newWatchTestConfig() *ConfigurationMockStruct {
+	return &ConfigurationMockStruct{
+		Writable: WritableInfo{
+			LogLevel: "INFO",
+			StoreAndForward: StoreAndForwardInfo{
+				Enabled:       false,
+				RetryInterval: "5m",
+				MaxRetryCount: 10,
+			},
+			Telemetry: config.TelemetryInfo{
+				Interval: "30s",
+			},
+		},
+	}
+}
+
+func TestWatchWritable(t *testing.T) {
+	serviceConfig := newWatchTestConfig()
+	target := newWatchTestProcessor(serviceConfig)
+
+	updates := make(chan StoreAndForwardInfo, 10)
+	err := WatchWritable(target.dic, "StoreAndForward", func(value StoreAndForwardInfo) {
+		updates <- value
+	})
+	require.NoError(t, err)
+
+	// Simulate rapid successive updates from the Configuration Provider, only the last is expected
+	target.applyWritableUpdates(serviceConfig, map[string]any{
+		"StoreAndForward": map[string]any{"Enabled": true},
+	})
+	target.applyWritableUpdates(serviceConfig, map[string]any{
+		"StoreAndForward": map[string]any{"RetryInterval": "10m"},
+	})
+
+	select {
+	case value := <-updates:
+		assert.Equal(t, StoreAndForwardInfo{Enabled: true, RetryInterval: "10m", MaxRetryCount: 10}, value)
+	case <-time.After(time.Second):
+		require.Fail(t, "watcher not called for updated Writable section")
+	}
+
+	select {
+	case value := <-updates:
+		assert.Failf(t, "watcher called more than once", "unexpected value %v", value)
+	case <-time.After(4 * testWatchDebounce):
+	}
+}
+
+func TestWatchWritableNestedSection(t *testing.T) {
+	serviceConfig := newWatchTestConfig()
+	target := newWatchTestProcessor(serviceConfig)
+
+	updates := make(chan string, 10)
+	err := WatchWritable(target.dic, "Telemetry.Interval", func(value string) {
+		updates <- value
+	})
+	require.NoError(t, err)
+
+	target.applyWritableUpdates(serviceConfig, map[string]any{
+		"Telemetry": map[string]any{"Interval": "45s"},
+	})
+
+	select {
+	case value := <-updates:
+		assert.Equal(t, "45s", value)
+	case <-time.After(time.Second):
+		require.Fail(t, "watcher not called for updated Writable section")
+	}
+}
+
+func TestWatchWritableUnchangedSection(t *testing.T) {
+	serviceConfig := newWatchTestConfig()
+	target := newWatchTestProcessor(serviceConfig)
+
+	updates := make(chan StoreAndForwardInfo, 10)
+	err := WatchWritable(target.dic, "StoreAndForward", func(value StoreAndForwardInfo) {
+		updates <- value
+	})
+	require.NoError(t, err)
+
+	target.applyWritableUpdates(serviceConfig, map[string]any{"LogLevel": "DEBUG"})
+
+	select {
+	case value := <-updates:
+		assert.Failf(t, "watcher called for unchanged Writable section", "unexpected value %v", value)
+	case <-time.After(4 * testWatchDebounce):
+	}
+}
+
+func TestWatchWritableErrors(t *testing.T) {
+	serviceConfig := newWatchTestConfig()
+	target := newWatchTestProcessor(serviceConfig)
+
+	tests := []struct {
+		name    string
+		section string
+	}{
+		{"no section", ""},
+		{"section not found", "Pipeline"},
+		{"nested section not found", "StoreAndForward.Unknown"},
+		{"not a section", "LogLevel.Level"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := WatchWritable(target.dic, test.section, func(value any) {})
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("watcher not available", func(t *testing.T) {
+		dic := di.NewContainer(di.ServiceConstructorMap{})
+		err := WatchWritable(dic, "StoreAndForward", func(value StoreAndForwardInfo) {})
+		assert.Error(t, err)
+	})
+}
@@ -25,10 +25,5 @@ var CancelFuncName = di.TypeInstanceToName((*context.CancelFunc)(nil))
 
 // CancelFuncFrom helper function queries the DIC and returns the context.CancelFunc.
 func CancelFuncFrom(get di.Get) context.CancelFunc {
-	cancelFunc, ok := get(CancelFuncName).(context.CancelFunc)
-	if !ok {
-		return nil
-	}
-
-	return cancelFunc
+	return GetFromName[context.CancelFunc](get, CancelFuncName)
 }
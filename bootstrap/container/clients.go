@@ -26,11 +26,7 @@ var EventClientName = di.TypeInstanceToName((*interfaces.EventClient)(nil))
 
 // EventClientFrom helper function queries the DIC and returns the EventClient's implementation.
 func EventClientFrom(get di.Get) interfaces.EventClient {
-	if get(EventClientName) == nil {
-		return nil
-	}
-
-	return get(EventClientName).(interfaces.EventClient)
+	return GetFromName[interfaces.EventClient](get, EventClientName)
 }
 
 // ReadingClientName contains the name of the ReadingClient instance in the DIC.
@@ -38,12 +34,7 @@ var ReadingClientName = di.TypeInstanceToName((*interfaces.ReadingClient)(nil))
 
 // ReadingClientFrom helper function queries the DIC and returns the ReadingClient instance.
 func ReadingClientFrom(get di.Get) interfaces.ReadingClient {
-	client, ok := get(ReadingClientName).(interfaces.ReadingClient)
-	if !ok {
-		return nil
-	}
-
-	return client
+	return GetFromName[interfaces.ReadingClient](get, ReadingClientName)
 }
 
 // CommandClientName contains the name of the CommandClient's implementation in the DIC.
@@ -51,11 +42,7 @@ var CommandClientName = di.TypeInstanceToName((*interfaces.CommandClient)(nil))
 
 // CommandClientFrom helper function queries the DIC and returns the CommandClient's implementation.
 func CommandClientFrom(get di.Get) interfaces.CommandClient {
-	if get(CommandClientName) == nil {
-		return nil
-	}
-
-	return get(CommandClientName).(interfaces.CommandClient)
+	return GetFromName[interfaces.CommandClient](get, CommandClientName)
 }
 
 // NotificationClientName contains the name of the NotificationClient's implementation in the DIC.
@@ -63,11 +50,7 @@ var NotificationClientName = di.TypeInstanceToName((*interfaces.NotificationClie
 
 // NotificationClientFrom helper function queries the DIC and returns the NotificationClient's implementation.
 func NotificationClientFrom(get di.Get) interfaces.NotificationClient {
-	if get(NotificationClientName) == nil {
-		return nil
-	}
-
-	return get(NotificationClientName).(interfaces.NotificationClient)
+	return GetFromName[interfaces.NotificationClient](get, NotificationClientName)
 }
 
 // SubscriptionClientName contains the name of the SubscriptionClient's implementation in the DIC.
@@ -75,11 +58,7 @@ var SubscriptionClientName = di.TypeInstanceToName((*interfaces.SubscriptionClie
 
 // SubscriptionClientFrom helper function queries the DIC and returns the SubscriptionClient's implementation.
 func SubscriptionClientFrom(get di.Get) interfaces.SubscriptionClient {
-	if get(SubscriptionClientName) == nil {
-		return nil
-	}
-
-	return get(SubscriptionClientName).(interfaces.SubscriptionClient)
+	return GetFromName[interfaces.SubscriptionClient](get, SubscriptionClientName)
 }
 
 // DeviceServiceClientName contains the name of the DeviceServiceClient's implementation in the DIC.
@@ -87,11 +66,7 @@ var DeviceServiceClientName = di.TypeInstanceToName((*interfaces.DeviceServiceCl
 
 // DeviceServiceClientFrom helper function queries the DIC and returns the DeviceServiceClient's implementation.
 func DeviceServiceClientFrom(get di.Get) interfaces.DeviceServiceClient {
-	if get(DeviceServiceClientName) == nil {
-		return nil
-	}
-
-	return get(DeviceServiceClientName).(interfaces.DeviceServiceClient)
+	return GetFromName[interfaces.DeviceServiceClient](get, DeviceServiceClientName)
 }
 
 // DeviceProfileClientName contains the name of the DeviceProfileClient's implementation in the DIC.
@@ -99,11 +74,7 @@ var DeviceProfileClientName = di.TypeInstanceToName((*interfaces.DeviceProfileCl
 
 // DeviceProfileClientFrom helper function queries the DIC and returns the DeviceProfileClient's implementation.
 func DeviceProfileClientFrom(get di.Get) interfaces.DeviceProfileClient {
-	if get(DeviceProfileClientName) == nil {
-		return nil
-	}
-
-	return get(DeviceProfileClientName).(interfaces.DeviceProfileClient)
+	return GetFromName[interfaces.DeviceProfileClient](get, DeviceProfileClientName)
 }
 
 // DeviceClientName contains the name of the DeviceClient's implementation in the DIC.
@@ -111,11 +82,7 @@ var DeviceClientName = di.TypeInstanceToName((*interfaces.DeviceClient)(nil))
 
 // DeviceClientFrom helper function queries the DIC and returns the DeviceClient's implementation.
 func DeviceClientFrom(get di.Get) interfaces.DeviceClient {
-	if get(DeviceClientName) == nil {
-		return nil
-	}
-
-	return get(DeviceClientName).(interfaces.DeviceClient)
+	return GetFromName[interfaces.DeviceClient](get, DeviceClientName)
 }
 
 // ProvisionWatcherClientName contains the name of the ProvisionWatcherClient's implementation in the DIC.
@@ -123,11 +90,7 @@ var ProvisionWatcherClientName = di.TypeInstanceToName((*interfaces.ProvisionWat
 
 // ProvisionWatcherClientFrom helper function queries the DIC and returns the ProvisionWatcherClient's implementation.
 func ProvisionWatcherClientFrom(get di.Get) interfaces.ProvisionWatcherClient {
-	if get(ProvisionWatcherClientName) == nil {
-		return nil
-	}
-
-	return get(ProvisionWatcherClientName).(interfaces.ProvisionWatcherClient)
+	return GetFromName[interfaces.ProvisionWatcherClient](get, ProvisionWatcherClientName)
 }
 
 // IntervalClientName contains the name of the IntervalClient's implementation in the DIC.
@@ -135,11 +98,7 @@ var IntervalClientName = di.TypeInstanceToName((*interfaces.IntervalClient)(nil)
 
 // IntervalClientFrom helper function queries the DIC and returns the IntervalClient's implementation.
 func IntervalClientFrom(get di.Get) interfaces.IntervalClient {
-	if get(IntervalClientName) == nil {
-		return nil
-	}
-
-	return get(IntervalClientName).(interfaces.IntervalClient)
+	return GetFromName[interfaces.IntervalClient](get, IntervalClientName)
 }
 
 // IntervalActionClientName contains the name of the IntervalActionClient's implementation in the DIC.
@@ -147,11 +106,7 @@ var IntervalActionClientName = di.TypeInstanceToName((*interfaces.IntervalAction
 
 // IntervalActionClientFrom helper function queries the DIC and returns the IntervalActionClient's implementation.
 func IntervalActionClientFrom(get di.Get) interfaces.IntervalActionClient {
-	if get(IntervalActionClientName) == nil {
-		return nil
-	}
-
-	return get(IntervalActionClientName).(interfaces.IntervalActionClient)
+	return GetFromName[interfaces.IntervalActionClient](get, IntervalActionClientName)
 }
 
 // DeviceServiceCallbackClientName contains the name of the DeviceServiceCallbackClient instance in the DIC.
@@ -162,20 +117,10 @@ var DeviceServiceCommandClientName = di.TypeInstanceToName((*interfaces.DeviceSe
 
 // DeviceServiceCallbackClientFrom helper function queries the DIC and returns the DeviceServiceCallbackClient instance.
 func DeviceServiceCallbackClientFrom(get di.Get) interfaces.DeviceServiceCallbackClient {
-	client, ok := get(DeviceServiceCallbackClientName).(interfaces.DeviceServiceCallbackClient)
-	if !ok {
-		return nil
-	}
-
-	return client
+	return GetFromName[interfaces.DeviceServiceCallbackClient](get, DeviceServiceCallbackClientName)
 }
 
 // DeviceServiceCommandClientFrom helper function queries the DIC and returns the DeviceServiceCommandClient instance.
 func DeviceServiceCommandClientFrom(get di.Get) interfaces.DeviceServiceCommandClient {
-	client, ok := get(DeviceServiceCommandClientName).(interfaces.DeviceServiceCommandClient)
-	if !ok {
-		return nil
-	}
-
-	return client
+	return GetFromName[interfaces.DeviceServiceCommandClient](get, DeviceServiceCommandClientName)
 }
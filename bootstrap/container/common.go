@@ -15,10 +15,5 @@ var CommonClientName = di.TypeInstanceToName((*interfaces.CommonClient)(nil))
 
 // CommonClientFrom helper function queries the DIC and returns the CommonClient instance.
 func CommonClientFrom(get di.Get) interfaces.CommonClient {
-	client, ok := get(CommonClientName).(interfaces.CommonClient)
-	if !ok {
-		return nil
-	}
-
-	return client
+	return GetFromName[interfaces.CommonClient](get, CommonClientName)
 }
@@ -26,12 +26,7 @@ var ConfigurationInterfaceName = di.TypeInstanceToName((*interfaces.Configuratio
 
 // ConfigurationFrom helper function queries the DIC and returns the interfaces.Configuration implementation.
 func ConfigurationFrom(get di.Get) interfaces.Configuration {
-	configuration, ok := get(ConfigurationInterfaceName).(interfaces.Configuration)
-	if !ok {
-		return nil
-	}
-
-	return configuration
+	return GetFromName[interfaces.Configuration](get, ConfigurationInterfaceName)
 }
 
 // ConfigClientInterfaceName contains the name of the configuration.Client implementation in the DIC.
@@ -39,10 +34,13 @@ var ConfigClientInterfaceName = di.TypeInstanceToName((*configuration.Client)(ni
 
 // ConfigClientFrom helper function queries the DIC and returns the configuration.Client implementation.
 func ConfigClientFrom(get di.Get) configuration.Client {
-	client, ok := get(ConfigClientInterfaceName).(configuration.Client)
-	if !ok {
-		return nil
-	}
+	return GetFromName[configuration.Client](get, ConfigClientInterfaceName)
+}
+
+// WritableWatcherInterfaceName contains the name of the interfaces.WritableWatcher implementation in the DIC.
+var WritableWatcherInterfaceName = di.TypeInstanceToName((*interfaces.WritableWatcher)(nil))
 
-	return client
+// WritableWatcherFrom helper function queries the DIC and returns the interfaces.WritableWatcher implementation.
+func WritableWatcherFrom(get di.Get) interfaces.WritableWatcher {
+	return GetFromName[interfaces.WritableWatcher](get, WritableWatcherInterfaceName)
 }
@@ -0,0 +1,34 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// credentialsNamePrefix is combined with the database key to name the database's config.Credentials in the DIC
+var credentialsNamePrefix = di.TypeInstanceToName(config.Credentials{}) + "-"
+
+// CredentialsName returns the name of the config.Credentials instance for the named database in the DIC.
+func CredentialsName(databaseName string) string {
+	return credentialsNamePrefix + databaseName
+}
+
+// CredentialsFromName helper function queries the DIC and returns the config.Credentials for the named database.
+// Returns nil if the credentials for the database have not been resolved.
+func CredentialsFromName(get di.Get, databaseName string) *config.Credentials {
+	return GetFromName[*config.Credentials](get, CredentialsName(databaseName))
+}
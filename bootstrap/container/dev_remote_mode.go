@@ -28,8 +28,8 @@ var DevRemoteModeName = di.TypeInstanceToName((*DevRemoteMode)(nil))
 
 // DevRemoteModeFrom helper function queries the DIC and returns the Dev and Remotes mode flags.
 func DevRemoteModeFrom(get di.Get) DevRemoteMode {
-	devOrRemoteMode, ok := get(DevRemoteModeName).(*DevRemoteMode)
-	if !ok {
+	devOrRemoteMode := GetFromName[*DevRemoteMode](get, DevRemoteModeName)
+	if devOrRemoteMode == nil {
 		return DevRemoteMode{}
 	}
 
@@ -16,10 +16,5 @@ var ExternalMQTTMessagingClientName = di.TypeInstanceToName((*mqtt.Client)(nil))
 
 // ExternalMQTTMessagingClientFrom helper function queries the DIC and returns the external messaging client.
 func ExternalMQTTMessagingClientFrom(get di.Get) mqtt.Client {
-	client, ok := get(ExternalMQTTMessagingClientName).(mqtt.Client)
-	if !ok {
-		return nil
-	}
-
-	return client
+	return GetFromName[mqtt.Client](get, ExternalMQTTMessagingClientName)
 }
@@ -0,0 +1,77 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"reflect"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// NameOf returns the name of the instance of type T in the DIC as derived by di.TypeInstanceToName, which is the
+// same name as the existing XxxName variables, i.e. NameOf[interfaces.CommonClient]() == CommonClientName.
+// For a pointer type, such as *DevRemoteMode, the name is derived from the type pointed to.
+func NameOf[T any]() string {
+	var zero T
+	if t := reflect.TypeOf(zero); t != nil && t.Kind() == reflect.Pointer {
+		return di.TypeInstanceToName(zero)
+	}
+
+	return di.TypeInstanceToName((*T)(nil))
+}
+
+// GetFrom helper function queries the DIC and returns the instance of type T, which is named using NameOf.
+// Returns the zero value of T if the instance is not in the DIC or is not of type T.
+func GetFrom[T any](get di.Get) T {
+	return GetFromName[T](get, NameOf[T]())
+}
+
+// GetFromName helper function queries the DIC and returns the named instance of type T.
+// Returns the zero value of T if the instance is not in the DIC or is not of type T.
+func GetFromName[T any](get di.Get, name string) T {
+	instance, ok := get(name).(T)
+	if !ok {
+		var zero T
+		return zero
+	}
+
+	return instance
+}
+
+// GetFromNameOk helper function queries the DIC and returns the named instance of type T and whether the name has
+// been registered in the DIC. This allows a service to fail fast when a dependency has not been registered, rather
+// than treating it the same as a dependency registered as nil. Returns the zero value of T if the instance is nil or
+// is not of type T.
+func GetFromNameOk[T any](dic *di.Container, name string) (T, bool) {
+	var zero T
+
+	instance, registered := dic.GetOk(name)
+	if !registered {
+		return zero, false
+	}
+
+	typed, ok := instance.(T)
+	if !ok {
+		return zero, true
+	}
+
+	return typed, true
+}
+
+// GetFromOk helper function queries the DIC and returns the instance of type T, which is named using NameOf,
+// and whether it has been registered in the DIC. See GetFromNameOk.
+func GetFromOk[T any](dic *di.Container) (T, bool) {
+	return GetFromNameOk[T](dic, NameOf[T]())
+}
@@ -0,0 +1,102 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+func TestNameOf(t *testing.T) {
+	assert.Equal(t, CommonClientName, NameOf[interfaces.CommonClient]())
+	assert.Equal(t, LoggingClientInterfaceName, NameOf[logger.LoggingClient]())
+	assert.Equal(t, DevRemoteModeName, NameOf[*DevRemoteMode]())
+	assert.Equal(t, CancelFuncName, NameOf[context.CancelFunc]())
+}
+
+func TestGetFrom(t *testing.T) {
+	lc := logger.NewMockClient()
+	devRemoteMode := &DevRemoteMode{InDevMode: true}
+
+	tests := []struct {
+		Name       string
+		Registered interface{}
+		Expected   logger.LoggingClient
+	}{
+		{"Present", lc, lc},
+		{"Absent", nil, nil},
+		{"Wrong type", devRemoteMode, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			dic := di.NewContainer(di.ServiceConstructorMap{})
+			if test.Registered != nil {
+				dic.Update(di.ServiceConstructorMap{
+					LoggingClientInterfaceName: func(get di.Get) interface{} {
+						return test.Registered
+					},
+				})
+			}
+
+			assert.Equal(t, test.Expected, GetFrom[logger.LoggingClient](dic.Get))
+			assert.Equal(t, test.Expected, LoggingClientFrom(dic.Get))
+		})
+	}
+}
+
+func TestGetFromName_Pointer(t *testing.T) {
+	expected := &DevRemoteMode{InDevMode: true}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		DevRemoteModeName: func(get di.Get) interface{} {
+			return expected
+		},
+	})
+
+	assert.Equal(t, expected, GetFrom[*DevRemoteMode](dic.Get))
+	assert.Equal(t, *expected, DevRemoteModeFrom(dic.Get))
+	assert.Nil(t, GetFromName[*DevRemoteMode](dic.Get, "unknown"))
+}
+
+func TestGetFromOk(t *testing.T) {
+	lc := logger.NewMockClient()
+
+	tests := []struct {
+		Name               string
+		Constructors       di.ServiceConstructorMap
+		Expected           logger.LoggingClient
+		ExpectedRegistered bool
+	}{
+		{"Registered non-nil", di.ServiceConstructorMap{LoggingClientInterfaceName: func(get di.Get) interface{} { return lc }}, lc, true},
+		{"Registered nil", di.ServiceConstructorMap{LoggingClientInterfaceName: func(get di.Get) interface{} { return nil }}, nil, true},
+		{"Unregistered", di.ServiceConstructorMap{}, nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			dic := di.NewContainer(test.Constructors)
+
+			actual, registered := GetFromOk[logger.LoggingClient](dic)
+			assert.Equal(t, test.Expected, actual)
+			assert.Equal(t, test.ExpectedRegistered, registered)
+		})
+	}
+}
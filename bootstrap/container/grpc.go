@@ -0,0 +1,29 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// GrpcServerName contains the name of the gRPC server instance in the DIC.
+var GrpcServerName = di.TypeInstanceToName((*grpc.Server)(nil))
+
+// GrpcServerFrom helper function queries the DIC and returns the gRPC server.
+func GrpcServerFrom(get di.Get) *grpc.Server {
+	return GetFromName[*grpc.Server](get, GrpcServerName)
+}
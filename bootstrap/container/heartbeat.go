@@ -0,0 +1,29 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// RegistryHeartbeatInterfaceName contains the name of the interfaces.RegistryHeartbeat implementation in the DIC.
+var RegistryHeartbeatInterfaceName = di.TypeInstanceToName((*interfaces.RegistryHeartbeat)(nil))
+
+// RegistryHeartbeatFrom helper function queries the DIC and returns the interfaces.RegistryHeartbeat implementation.
+// Returns nil unless the service is registered with a TTL health check.
+func RegistryHeartbeatFrom(get di.Get) interfaces.RegistryHeartbeat {
+	return GetFromName[interfaces.RegistryHeartbeat](get, RegistryHeartbeatInterfaceName)
+}
@@ -25,10 +25,5 @@ var LoggingClientInterfaceName = di.TypeInstanceToName((*logger.LoggingClient)(n
 
 // LoggingClientFrom helper function queries the DIC and returns the logger.loggingClient implementation.
 func LoggingClientFrom(get di.Get) logger.LoggingClient {
-	loggingClient, ok := get(LoggingClientInterfaceName).(logger.LoggingClient)
-	if !ok {
-		return nil
-	}
-
-	return loggingClient
+	return GetFromName[logger.LoggingClient](get, LoggingClientInterfaceName)
 }
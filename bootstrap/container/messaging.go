@@ -25,10 +25,19 @@ var MessagingClientName = di.TypeInstanceToName((*messaging.MessageClient)(nil))
 
 // MessagingClientFrom helper function queries the DIC and returns the messaging client.
 func MessagingClientFrom(get di.Get) messaging.MessageClient {
-	client, ok := get(MessagingClientName).(messaging.MessageClient)
-	if !ok {
-		return nil
-	}
+	return GetFromName[messaging.MessageClient](get, MessagingClientName)
+}
+
+// messagingClientNamePrefix is combined with the MessageBus key to name the additional messaging clients in the DIC
+var messagingClientNamePrefix = MessagingClientName + "-"
+
+// MessagingClientNameFor returns the name of the messaging client instance for the named MessageBus in the DIC.
+func MessagingClientNameFor(messageBusName string) string {
+	return messagingClientNamePrefix + messageBusName
+}
 
-	return client
+// MessageClientFromName helper function queries the DIC and returns the messaging client for the named MessageBus.
+// Returns nil if the named MessageBus has not been connected.
+func MessageClientFromName(get di.Get, messageBusName string) messaging.MessageClient {
+	return GetFromName[messaging.MessageClient](get, MessagingClientNameFor(messageBusName))
 }
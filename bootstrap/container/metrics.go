@@ -25,10 +25,5 @@ var MetricsManagerInterfaceName = di.TypeInstanceToName((*interfaces.MetricsMana
 
 // MetricsManagerFrom helper function queries the DIC and returns the metrics.Manager implementation.
 func MetricsManagerFrom(get di.Get) interfaces.MetricsManager {
-	manager, ok := get(MetricsManagerInterfaceName).(interfaces.MetricsManager)
-	if !ok {
-		return nil
-	}
-
-	return manager
+	return GetFromName[interfaces.MetricsManager](get, MetricsManagerInterfaceName)
 }
@@ -25,10 +25,5 @@ var RegistryClientInterfaceName = di.TypeInstanceToName((*registry.Client)(nil))
 
 // RegistryFrom helper function queries the DIC and returns the registry.Client implementation.
 func RegistryFrom(get di.Get) registry.Client {
-	registryClient, ok := get(RegistryClientInterfaceName).(registry.Client)
-	if !ok {
-		return nil
-	}
-
-	return registryClient
+	return GetFromName[registry.Client](get, RegistryClientInterfaceName)
 }
@@ -25,10 +25,5 @@ var RuntimeTokenProviderInterfaceName = di.TypeInstanceToName((*runtimetokenprov
 
 // RuntimeTokenProviderFrom helper function queries the DIC and returns the runtimetokenprovider.RuntimeTokenProvider implementation.
 func RuntimeTokenProviderFrom(get di.Get) runtimetokenprovider.RuntimeTokenProvider {
-	runtimeTokenProvider, ok := get(RuntimeTokenProviderInterfaceName).(runtimetokenprovider.RuntimeTokenProvider)
-	if !ok {
-		return nil
-	}
-
-	return runtimeTokenProvider
+	return GetFromName[runtimetokenprovider.RuntimeTokenProvider](get, RuntimeTokenProviderInterfaceName)
 }
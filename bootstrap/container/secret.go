@@ -17,6 +17,7 @@ package container
 
 import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 )
 
@@ -26,12 +27,7 @@ var SecretProviderName = di.TypeInstanceToName((*interfaces.SecretProvider)(nil)
 // SecretProviderFrom helper function queries the DIC and returns the interfaces.SecretProvider
 // implementation.
 func SecretProviderFrom(get di.Get) interfaces.SecretProvider {
-	provider, ok := get(SecretProviderName).(interfaces.SecretProvider)
-	if !ok {
-		return nil
-	}
-
-	return provider
+	return GetFromName[interfaces.SecretProvider](get, SecretProviderName)
 }
 
 // SecretProviderExtName contains the name of the interfaces.SecretProviderExt implementation in the DIC.
@@ -40,10 +36,15 @@ var SecretProviderExtName = di.TypeInstanceToName((*interfaces.SecretProvider)(n
 // SecretProviderExtFrom helper function queries the DIC and returns the interfaces.SecretProviderExt
 // implementation.
 func SecretProviderExtFrom(get di.Get) interfaces.SecretProviderExt {
-	provider, ok := get(SecretProviderExtName).(interfaces.SecretProviderExt)
-	if !ok {
-		return nil
-	}
+	return GetFromName[interfaces.SecretProviderExt](get, SecretProviderExtName)
+}
+
+// SecretStoreConfigName contains the name of the SecretStore configuration the secure SecretProvider was created with
+// in the DIC.
+var SecretStoreConfigName = di.TypeInstanceToName((*config.SecretStoreInfo)(nil))
 
-	return provider
+// SecretStoreConfigFrom helper function queries the DIC and returns the SecretStore configuration, which is nil when
+// security is disabled.
+func SecretStoreConfigFrom(get di.Get) *config.SecretStoreInfo {
+	return GetFromName[*config.SecretStoreInfo](get, SecretStoreConfigName)
 }
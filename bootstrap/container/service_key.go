@@ -0,0 +1,27 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// ServiceKeyName contains the name of the service's key in the DIC, which is the compiled-in key unless overridden
+// with the -sk/--serviceKey flag.
+const ServiceKeyName = "ServiceKey"
+
+// ServiceKeyFrom helper function queries the DIC and returns the service's key.
+func ServiceKeyFrom(get di.Get) string {
+	return GetFromName[string](get, ServiceKeyName)
+}
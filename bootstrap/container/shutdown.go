@@ -0,0 +1,28 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// ShutdownRegistryInterfaceName contains the name of the interfaces.ShutdownRegistry implementation in the DIC.
+var ShutdownRegistryInterfaceName = di.TypeInstanceToName((*interfaces.ShutdownRegistry)(nil))
+
+// ShutdownRegistryFrom helper function queries the DIC and returns the interfaces.ShutdownRegistry implementation.
+func ShutdownRegistryFrom(get di.Get) interfaces.ShutdownRegistry {
+	return GetFromName[interfaces.ShutdownRegistry](get, ShutdownRegistryInterfaceName)
+}
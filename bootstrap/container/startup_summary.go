@@ -0,0 +1,56 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// EndpointSummary is the resolved location of a dependency of the service, without any credentials
+type EndpointSummary struct {
+	Type string `json:"type,omitempty"`
+	Url  string `json:"url"`
+}
+
+// StartupSummary is the summary of the dependency endpoints the service resolved on startup, keyed by the service
+// key of the clients and the name of the additional named MessageBuses and Databases
+type StartupSummary struct {
+	ServiceKey   string                     `json:"serviceKey"`
+	Clients      map[string]EndpointSummary `json:"clients,omitempty"`
+	MessageBus   *EndpointSummary           `json:"messageBus,omitempty"`
+	MessageBuses map[string]EndpointSummary `json:"messageBuses,omitempty"`
+	Database     *EndpointSummary           `json:"database,omitempty"`
+	Databases    map[string]EndpointSummary `json:"databases,omitempty"`
+	Registry     *EndpointSummary           `json:"registry,omitempty"`
+	SecretStore  *EndpointSummary           `json:"secretStore,omitempty"`
+}
+
+// StartupSummaryName contains the name of the StartupSummary in the DIC.
+var StartupSummaryName = di.TypeInstanceToName((*StartupSummary)(nil))
+
+// StartupSummaryFrom helper function queries the DIC and returns the StartupSummary, which is nil unless the service
+// serves its startup summary.
+func StartupSummaryFrom(get di.Get) *StartupSummary {
+	return GetFromName[*StartupSummary](get, StartupSummaryName)
+}
+
+// ClientUrlsName contains the name of the URLs the clients resolved to, keyed by the client's service key, in the DIC.
+const ClientUrlsName = "ClientUrls"
+
+// ClientUrlsFrom helper function queries the DIC and returns the URLs the clients resolved to, keyed by the client's
+// service key.
+func ClientUrlsFrom(get di.Get) map[string]string {
+	return GetFromName[map[string]string](get, ClientUrlsName)
+}
@@ -37,10 +37,5 @@ var AuthTokenLoaderInterfaceName = di.TypeInstanceToName((*authtokenloader.AuthT
 
 // AuthTokenLoaderFrom helper function queries the DIC and returns the authtokenloader.AuthTokenLoader implementation.
 func AuthTokenLoaderFrom(get di.Get) authtokenloader.AuthTokenLoader {
-	loader, ok := get(AuthTokenLoaderInterfaceName).(authtokenloader.AuthTokenLoader)
-	if !ok {
-		return nil
-	}
-
-	return loader
+	return GetFromName[authtokenloader.AuthTokenLoader](get, AuthTokenLoaderInterfaceName)
 }
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strings"
 
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/handlers"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
@@ -17,6 +18,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
@@ -24,6 +26,41 @@ import (
 	"github.com/mitchellh/mapstructure"
 )
 
+const (
+	// LivenessRoute is the route of the liveness probe, which succeeds as soon as the service is up
+	LivenessRoute = "/livez"
+	// ReadinessRoute is the route of the readiness probe, which succeeds once all the readiness checks pass
+	ReadinessRoute = "/readyz"
+	// MetricsRoute is the admin route which dumps the current values of the service's enabled metrics
+	MetricsRoute = common.ApiBase + "/admin/metrics"
+	// StartupSummaryRoute is the admin route which serves the summary of the dependency endpoints the service resolved
+	// on startup, when the service serves its startup summary
+	StartupSummaryRoute = common.ApiBase + "/admin/startup-summary"
+)
+
+// ReadinessResponse is the response to the readiness probe. Failures holds the error of each of the readiness checks
+// which failed keyed by the check's name.
+type ReadinessResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	ServiceName            string            `json:"serviceName"`
+	Failures               map[string]string `json:"failures,omitempty"`
+}
+
+// MetricsResponse is the response to the request for the current metrics, which holds the current values of the
+// metrics enabled in the service's Telemetry configuration
+type MetricsResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	ServiceName            string        `json:"serviceName"`
+	Metrics                []dtos.Metric `json:"metrics"`
+}
+
+// StartupSummaryResponse is the response to the request for the startup summary
+type StartupSummaryResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	ServiceName            string                   `json:"serviceName"`
+	Summary                container.StartupSummary `json:"summary"`
+}
+
 // CommonController controller for common REST APIs
 type CommonController struct {
 	dic         *di.Container
@@ -62,9 +99,13 @@ func NewCommonController(dic *di.Container, r *echo.Echo, serviceName string, se
 		},
 	}
 	r.GET(common.ApiPingRoute, c.Ping) // Health check is always unauthenticated
+	r.GET(LivenessRoute, c.Livez)      // As are the liveness and readiness probes
+	r.GET(ReadinessRoute, c.Readyz)
 	r.GET(common.ApiVersionRoute, c.Version, authenticationHook)
 	r.GET(common.ApiConfigRoute, c.Config, authenticationHook)
 	r.POST(common.ApiSecretRoute, c.AddSecret, authenticationHook)
+	r.GET(MetricsRoute, c.Metrics, authenticationHook)
+	r.GET(StartupSummaryRoute, c.StartupSummary, authenticationHook)
 
 	return &c
 }
@@ -89,6 +130,47 @@ func (c *CommonController) Ping(e echo.Context) error {
 	return utils.SendJsonResp(c.lc, writer, request, response, http.StatusOK)
 }
 
+// Livez handles the request to the /livez endpoint. Is used as the liveness probe, so always responds with 200 OK
+// while the service is able to handle requests.
+func (c *CommonController) Livez(e echo.Context) error {
+	request := e.Request()
+	writer := e.Response()
+	response := commonDTO.NewPingResponse(c.serviceName)
+
+	return utils.SendJsonResp(c.lc, writer, request, response, http.StatusOK)
+}
+
+// Readyz handles the request to the /readyz endpoint. Is used as the readiness probe, so responds with
+// 503 Service Unavailable, along with the failures, until all the registered readiness checks pass.
+func (c *CommonController) Readyz(e echo.Context) error {
+	request := e.Request()
+	writer := e.Response()
+
+	var failures map[string]error
+	if readiness := container.ReadinessFrom(c.dic.Get); readiness != nil {
+		failures = readiness.Check()
+	}
+
+	if len(failures) == 0 {
+		response := ReadinessResponse{
+			BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+			ServiceName:  c.serviceName,
+		}
+		return utils.SendJsonResp(c.lc, writer, request, response, http.StatusOK)
+	}
+
+	response := ReadinessResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "service is not ready", http.StatusServiceUnavailable),
+		ServiceName:  c.serviceName,
+		Failures:     make(map[string]string, len(failures)),
+	}
+	for name, err := range failures {
+		response.Failures[name] = err.Error()
+	}
+
+	return utils.SendJsonResp(c.lc, writer, request, response, http.StatusServiceUnavailable)
+}
+
 // Version handles the request to /version endpoint. Is used to request the service's versions
 // It returns a response as specified by the API swagger in the openapi directory
 func (c *CommonController) Version(e echo.Context) error {
@@ -105,18 +187,20 @@ func (c *CommonController) Version(e echo.Context) error {
 
 // Config handles the request to /config endpoint. Is used to request the service's configuration
 // It returns a response as specified by the swagger in openapi/common
+// The configuration is the effective configuration after all the layers and overrides have been applied, with the
+// fields tagged as secret redacted.
 func (c *CommonController) Config(e echo.Context) error {
 	request := e.Request()
 	writer := e.Response()
 	var fullConfig interface{}
 	m := make(map[string]any)
-	err := mapstructure.Decode(c.config.configuration, &m)
+	err := mapstructure.Decode(bootstrapConfig.RedactSecrets(c.config.configuration), &m)
 	if err != nil {
 		c.lc.Errorf("%v", err.Error())
 		return utils.SendJsonErrResp(c.lc, writer, request, errors.KindServerError, "config can not convert to map", err, "")
 	}
 	if c.config.customConfig != nil {
-		m["CustomConfiguration"] = c.config.customConfig
+		m["CustomConfiguration"] = bootstrapConfig.RedactSecrets(c.config.customConfig)
 	}
 	fullConfig = m
 
@@ -124,6 +208,50 @@ func (c *CommonController) Config(e echo.Context) error {
 	return utils.SendJsonResp(c.lc, writer, request, response, http.StatusOK)
 }
 
+// Metrics handles the request to the /admin/metrics endpoint. Is used to request the current values of the service's
+// metrics without subscribing to the MessageBus. Only the metrics enabled in the Telemetry configuration are included,
+// so the response matches what is reported.
+func (c *CommonController) Metrics(e echo.Context) error {
+	request := e.Request()
+	writer := e.Response()
+
+	manager := container.MetricsManagerFrom(c.dic.Get)
+	if manager == nil {
+		return utils.SendJsonErrResp(c.lc, writer, request, errors.KindServiceUnavailable, "metrics manager is not available", nil, "")
+	}
+
+	metrics, err := manager.Snapshot(container.ConfigurationFrom(c.dic.Get).GetTelemetryInfo())
+	if err != nil {
+		// The metrics which could be converted are still returned since this is a debugging aid
+		c.lc.Warnf("Unable to include all metrics in the response: %s", err.Error())
+	}
+
+	response := MetricsResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		ServiceName:  c.serviceName,
+		Metrics:      metrics,
+	}
+	return utils.SendJsonResp(c.lc, writer, request, response, http.StatusOK)
+}
+
+// StartupSummary handles the request for the summary of the dependency endpoints the service resolved on startup
+func (c *CommonController) StartupSummary(e echo.Context) error {
+	request := e.Request()
+	writer := e.Response()
+
+	summary := container.StartupSummaryFrom(c.dic.Get)
+	if summary == nil {
+		return utils.SendJsonErrResp(c.lc, writer, request, errors.KindEntityDoesNotExist, "startup summary is not served", nil, "")
+	}
+
+	response := StartupSummaryResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		ServiceName:  c.serviceName,
+		Summary:      *summary,
+	}
+	return utils.SendJsonResp(c.lc, writer, request, response, http.StatusOK)
+}
+
 // AddSecret handles the request to the /secret endpoint. Is used to add EdgeX Service exclusive secret to the Secret Store
 // It returns a response as specified by the API swagger in the openapi directory
 func (c *CommonController) AddSecret(e echo.Context) error {
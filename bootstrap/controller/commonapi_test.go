@@ -17,11 +17,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	gometrics "github.com/rcrowley/go-metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/health"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/metrics"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
@@ -150,6 +154,207 @@ func TestPingRequest(t *testing.T) {
 	assert.Equal(t, serviceName, actual.ServiceName)
 }
 
+func TestLivezRequest(t *testing.T) {
+	e := echo.New()
+	serviceName := uuid.NewString()
+	dic := mockDic()
+	// The service is alive even when it isn't ready
+	readiness := health.NewReadiness()
+	readiness.RegisterCheck("MessageBus", func() error { return errors.New("not connected") })
+	dic.Update(di.ServiceConstructorMap{
+		container.ReadinessInterfaceName: func(get di.Get) interface{} {
+			return readiness
+		},
+	})
+	target := NewCommonController(dic, e, serviceName, serviceVersion)
+
+	recorder := doRequest(t, http.MethodGet, LivenessRoute, target.Livez, nil)
+
+	actual := commonDTO.PingResponse{}
+	err := json.Unmarshal(recorder.Body.Bytes(), &actual)
+	require.NoError(t, err)
+	assert.Equal(t, serviceName, actual.ServiceName)
+}
+
+func TestReadyzRequest(t *testing.T) {
+	tests := []struct {
+		Name             string
+		Checks           map[string]error
+		ExpectedStatus   int
+		ExpectedFailures map[string]string
+	}{
+		{"No checks", nil, http.StatusOK, nil},
+		{"Passing checks", map[string]error{"SecretStore": nil, "MessageBus": nil}, http.StatusOK, nil},
+		{"Failing check", map[string]error{"SecretStore": errors.New("SecretStore is sealed"), "MessageBus": nil},
+			http.StatusServiceUnavailable, map[string]string{"SecretStore": "SecretStore is sealed"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			e := echo.New()
+			serviceName := uuid.NewString()
+			dic := mockDic()
+			readiness := health.NewReadiness()
+			for name, err := range test.Checks {
+				err := err
+				readiness.RegisterCheck(name, func() error { return err })
+			}
+			dic.Update(di.ServiceConstructorMap{
+				container.ReadinessInterfaceName: func(get di.Get) interface{} {
+					return readiness
+				},
+			})
+			_ = NewCommonController(dic, e, serviceName, serviceVersion)
+
+			req, err := http.NewRequest(http.MethodGet, ReadinessRoute, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, req)
+
+			require.Equal(t, test.ExpectedStatus, recorder.Code)
+			actual := ReadinessResponse{}
+			err = json.Unmarshal(recorder.Body.Bytes(), &actual)
+			require.NoError(t, err)
+			assert.Equal(t, serviceName, actual.ServiceName)
+			assert.Equal(t, test.ExpectedStatus, actual.StatusCode)
+			assert.Equal(t, test.ExpectedFailures, actual.Failures)
+		})
+	}
+}
+
+func TestMetricsRequest(t *testing.T) {
+	telemetryConfig := &bootstrapConfig.TelemetryInfo{
+		Metrics: map[string]bool{"Counter": true, "Gauge": true, "Timer": true},
+	}
+
+	manager := metrics.NewManager(logger.NewMockClient(), time.Second, metrics.NewNullReporter())
+	counter := gometrics.NewCounter()
+	counter.Inc(3)
+	gauge := gometrics.NewGauge()
+	gauge.Update(11)
+	timer := gometrics.NewTimer()
+	timer.Update(time.Millisecond * 250)
+	require.NoError(t, manager.Register("Counter", counter, nil))
+	require.NoError(t, manager.Register("Gauge", gauge, nil))
+	require.NoError(t, manager.Register("Timer", timer, nil))
+	require.NoError(t, manager.Register("Disabled", gometrics.NewCounter(), nil))
+
+	mockConfig := &mocks.Configuration{}
+	mockConfig.On("GetTelemetryInfo").Return(telemetryConfig)
+
+	tests := []struct {
+		Name           string
+		Manager        interfaces.MetricsManager
+		ExpectedStatus int
+	}{
+		{"Valid", manager, http.StatusOK},
+		{"No Metrics Manager", nil, http.StatusServiceUnavailable},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			e := echo.New()
+			serviceName := uuid.NewString()
+			dic := mockDic()
+			dic.Update(di.ServiceConstructorMap{
+				container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+					return mockConfig
+				},
+			})
+			if test.Manager != nil {
+				dic.Update(di.ServiceConstructorMap{
+					container.MetricsManagerInterfaceName: func(get di.Get) interface{} {
+						return test.Manager
+					},
+				})
+			}
+			target := NewCommonController(dic, e, serviceName, serviceVersion)
+
+			// The handler is called directly, as the route requires authentication
+			req, err := http.NewRequest(http.MethodGet, MetricsRoute, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			require.NoError(t, target.Metrics(e.NewContext(req, recorder)))
+
+			require.Equal(t, test.ExpectedStatus, recorder.Code)
+			if test.ExpectedStatus != http.StatusOK {
+				return
+			}
+
+			actual := MetricsResponse{}
+			err = json.Unmarshal(recorder.Body.Bytes(), &actual)
+			require.NoError(t, err)
+			assert.Equal(t, serviceName, actual.ServiceName)
+			require.Len(t, actual.Metrics, 3)
+
+			// The values are decoded from JSON, so are all float64
+			actualValues := make(map[string]any)
+			for _, metric := range actual.Metrics {
+				for _, field := range metric.Fields {
+					actualValues[metric.Name+"."+field.Name] = field.Value
+				}
+			}
+			assert.Equal(t, float64(3), actualValues["Counter.counter-count"])
+			assert.Equal(t, float64(11), actualValues["Gauge.gauge-value"])
+			assert.Equal(t, float64(1), actualValues["Timer.timer-count"])
+			assert.Equal(t, float64(time.Millisecond*250), actualValues["Timer.timer-max"])
+			assert.NotContains(t, actualValues, "Disabled.counter-count")
+		})
+	}
+}
+
+func TestStartupSummaryRequest(t *testing.T) {
+	summary := &container.StartupSummary{
+		ServiceKey: "unit-test",
+		MessageBus: &container.EndpointSummary{Type: "mqtt", Url: "tcp://edgex-mqtt-broker:1883"},
+		Clients: map[string]container.EndpointSummary{
+			"core-metadata": {Url: "http://edgex-core-metadata:59881"},
+		},
+	}
+
+	tests := []struct {
+		Name           string
+		Summary        *container.StartupSummary
+		ExpectedStatus int
+	}{
+		{"Served", summary, http.StatusOK},
+		{"Not served", nil, http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			e := echo.New()
+			serviceName := uuid.NewString()
+			dic := mockDic()
+			if test.Summary != nil {
+				dic.Update(di.ServiceConstructorMap{
+					container.StartupSummaryName: func(get di.Get) interface{} {
+						return test.Summary
+					},
+				})
+			}
+			target := NewCommonController(dic, e, serviceName, serviceVersion)
+
+			// The handler is called directly, as the route requires authentication
+			req, err := http.NewRequest(http.MethodGet, StartupSummaryRoute, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			require.NoError(t, target.StartupSummary(e.NewContext(req, recorder)))
+
+			require.Equal(t, test.ExpectedStatus, recorder.Code)
+			if test.ExpectedStatus != http.StatusOK {
+				return
+			}
+
+			actual := StartupSummaryResponse{}
+			err = json.Unmarshal(recorder.Body.Bytes(), &actual)
+			require.NoError(t, err)
+			assert.Equal(t, serviceName, actual.ServiceName)
+			assert.Equal(t, *summary, actual.Summary)
+		})
+	}
+}
+
 func TestVersionRequest(t *testing.T) {
 	e := echo.New()
 	expectedSdkVersion := "1.3.1"
@@ -210,6 +415,64 @@ func TestConfigRequest(t *testing.T) {
 	assert.Equal(t, expectedConfig, actualConfig)
 }
 
+func TestConfigRequest_RedactsSecrets(t *testing.T) {
+	e := echo.New()
+	serviceConfig := TestConfig{
+		Service: bootstrapConfig.ServiceInfo{
+			Host: "localhost",
+			Port: 8080,
+		},
+		SecretStore: bootstrapConfig.NewSecretStoreInfo("core-data"),
+		Credentials: bootstrapConfig.Credentials{
+			Username: "edgex",
+			Password: "password",
+		},
+		InsecureSecrets: bootstrapConfig.InsecureSecrets{
+			"DB": {
+				SecretName: "redisdb",
+				SecretData: map[string]string{"username": "edgex", "password": "password"},
+			},
+		},
+	}
+	serviceConfig.SecretStore.Authentication.AuthToken = "token"
+
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return serviceConfig
+		},
+	})
+	target := NewCommonController(dic, e, uuid.NewString(), serviceVersion)
+
+	recorder := doRequest(t, http.MethodGet, common.ApiConfigRoute, target.Config, nil)
+
+	actualResponse := commonDTO.ConfigResponse{}
+	err := json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
+	require.NoError(t, err)
+
+	configJson, err := json.Marshal(actualResponse.Config)
+	require.NoError(t, err)
+
+	actualConfig := TestConfig{}
+	err = json.Unmarshal(configJson, &actualConfig)
+	require.NoError(t, err)
+
+	// Merged values are returned as is
+	assert.Equal(t, serviceConfig.Service, actualConfig.Service)
+	assert.Equal(t, "edgex", actualConfig.Credentials.Username)
+	assert.Equal(t, serviceConfig.SecretStore.Host, actualConfig.SecretStore.Host)
+	assert.Equal(t, "redisdb", actualConfig.InsecureSecrets["DB"].SecretName)
+
+	// Secrets are redacted
+	assert.Equal(t, "***", actualConfig.Credentials.Password)
+	assert.Equal(t, "***", actualConfig.SecretStore.Authentication.AuthToken)
+	assert.Equal(t, map[string]string{"username": "***", "password": "***"}, actualConfig.InsecureSecrets["DB"].SecretData)
+
+	// The service's configuration isn't modified
+	assert.Equal(t, "password", serviceConfig.Credentials.Password)
+	assert.Equal(t, "password", serviceConfig.InsecureSecrets["DB"].SecretData["password"])
+}
+
 func TestConfigRequest_CustomConfig(t *testing.T) {
 	e := echo.New()
 	expectedConfig := TestConfig{
@@ -287,7 +550,10 @@ func doRequest(t *testing.T, method string, api string, handler echo.HandlerFunc
 }
 
 type TestConfig struct {
-	Service bootstrapConfig.ServiceInfo
+	Service         bootstrapConfig.ServiceInfo
+	SecretStore     bootstrapConfig.SecretStoreInfo
+	Credentials     bootstrapConfig.Credentials
+	InsecureSecrets bootstrapConfig.InsecureSecrets
 }
 
 func (tc TestConfig) UpdateFromRaw(_ interface{}) bool {
@@ -0,0 +1,151 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package correlation provides the helpers to consistently extract, generate and propagate the correlation id
+// which identifies a request across HTTP, the MessageBus and logging.
+package correlation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/google/uuid"
+)
+
+// NewID generates a new correlation id
+func NewID() string {
+	return uuid.NewString()
+}
+
+// FromRequest returns the correlation id from the request's header or a newly generated one when absent.
+func FromRequest(r *http.Request) string {
+	if id := r.Header.Get(common.CorrelationHeader); len(id) > 0 {
+		return id
+	}
+	return NewID()
+}
+
+// FromEnvelope returns the correlation id from the message envelope or a newly generated one when absent.
+func FromEnvelope(envelope types.MessageEnvelope) string {
+	if len(envelope.CorrelationID) > 0 {
+		return envelope.CorrelationID
+	}
+	return NewID()
+}
+
+// NewContext returns a copy of the context holding the correlation id. The id is stored under the
+// common.CorrelationHeader key which is where the EdgeX modules, i.e. types.NewMessageEnvelope, expect to find it.
+func NewContext(ctx context.Context, id string) context.Context {
+	// lint:ignore SA1029 legacy
+	// nolint:staticcheck // See golangci-lint #741
+	return context.WithValue(ctx, common.CorrelationHeader, id)
+}
+
+// FromContext returns the correlation id held by the context, which is empty when it doesn't hold one.
+func FromContext(ctx context.Context) string {
+	id, ok := ctx.Value(common.CorrelationHeader).(string)
+	if !ok {
+		return ""
+	}
+	return id
+}
+
+// InjectRequest sets the correlation id held by the context in the outgoing request's header, if it holds one.
+func InjectRequest(ctx context.Context, r *http.Request) {
+	if id := FromContext(ctx); len(id) > 0 {
+		r.Header.Set(common.CorrelationHeader, id)
+	}
+}
+
+// InjectEnvelope sets the correlation id held by the context in the outgoing message envelope, if it holds one.
+func InjectEnvelope(ctx context.Context, envelope *types.MessageEnvelope) {
+	if id := FromContext(ctx); len(id) > 0 {
+		envelope.CorrelationID = id
+	}
+}
+
+// correlatedLogger is a LoggingClient which adds the correlation id to every entry
+type correlatedLogger struct {
+	logger.LoggingClient
+	id string
+}
+
+// LoggingClient returns a LoggingClient which includes the correlation id held by the context in every entry as the
+// common.CorrelationHeader key/value pair. The LoggingClient is returned as is when the context doesn't hold one.
+func LoggingClient(ctx context.Context, lc logger.LoggingClient) logger.LoggingClient {
+	id := FromContext(ctx)
+	if len(id) == 0 {
+		return lc
+	}
+
+	return correlatedLogger{LoggingClient: lc, id: id}
+}
+
+// withID puts the correlation id first so entries keep the format the request logging always had
+func (c correlatedLogger) withID(args []interface{}) []interface{} {
+	withID := make([]interface{}, 0, len(args)+3)
+	withID = append(withID, common.CorrelationHeader, c.id)
+	withID = append(withID, args...)
+	if len(args)%2 == 1 {
+		// add an empty string to keep k/v pairs correct
+		withID = append(withID, "")
+	}
+	return withID
+}
+
+func (c correlatedLogger) Info(msg string, args ...interface{}) {
+	c.LoggingClient.Info(msg, c.withID(args)...)
+}
+
+func (c correlatedLogger) Trace(msg string, args ...interface{}) {
+	c.LoggingClient.Trace(msg, c.withID(args)...)
+}
+
+func (c correlatedLogger) Debug(msg string, args ...interface{}) {
+	c.LoggingClient.Debug(msg, c.withID(args)...)
+}
+
+func (c correlatedLogger) Warn(msg string, args ...interface{}) {
+	c.LoggingClient.Warn(msg, c.withID(args)...)
+}
+
+func (c correlatedLogger) Error(msg string, args ...interface{}) {
+	c.LoggingClient.Error(msg, c.withID(args)...)
+}
+
+// The formatted messages are logged as the message of the key/value form so the correlation id can be added
+
+func (c correlatedLogger) Infof(msg string, args ...interface{}) {
+	c.LoggingClient.Info(fmt.Sprintf(msg, args...), common.CorrelationHeader, c.id)
+}
+
+func (c correlatedLogger) Tracef(msg string, args ...interface{}) {
+	c.LoggingClient.Trace(fmt.Sprintf(msg, args...), common.CorrelationHeader, c.id)
+}
+
+func (c correlatedLogger) Debugf(msg string, args ...interface{}) {
+	c.LoggingClient.Debug(fmt.Sprintf(msg, args...), common.CorrelationHeader, c.id)
+}
+
+func (c correlatedLogger) Warnf(msg string, args ...interface{}) {
+	c.LoggingClient.Warn(fmt.Sprintf(msg, args...), common.CorrelationHeader, c.id)
+}
+
+func (c correlatedLogger) Errorf(msg string, args ...interface{}) {
+	c.LoggingClient.Error(fmt.Sprintf(msg, args...), common.CorrelationHeader, c.id)
+}
@@ -0,0 +1,106 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package correlation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/logging"
+)
+
+const testCorrelationID = "0f7c2ab9-1e04-4d1b-8a48-1b7a8d0e6a10"
+
+func TestFromRequest(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/api/v3/ping", nil)
+	request.Header.Set(common.CorrelationHeader, testCorrelationID)
+	assert.Equal(t, testCorrelationID, FromRequest(request))
+
+	generated := FromRequest(httptest.NewRequest(http.MethodGet, "/api/v3/ping", nil))
+	_, err := uuid.Parse(generated)
+	assert.NoError(t, err)
+	assert.NotEqual(t, generated, FromRequest(httptest.NewRequest(http.MethodGet, "/api/v3/ping", nil)))
+}
+
+func TestFromEnvelope(t *testing.T) {
+	assert.Equal(t, testCorrelationID, FromEnvelope(types.MessageEnvelope{CorrelationID: testCorrelationID}))
+
+	_, err := uuid.Parse(FromEnvelope(types.MessageEnvelope{}))
+	assert.NoError(t, err)
+}
+
+func TestContextRoundTrip(t *testing.T) {
+	assert.Empty(t, FromContext(context.Background()))
+
+	ctx := NewContext(context.Background(), testCorrelationID)
+	assert.Equal(t, testCorrelationID, FromContext(ctx))
+
+	// The id is found by the EdgeX modules which expect it under the correlation header key
+	assert.Equal(t, testCorrelationID, types.NewMessageEnvelope(nil, ctx).CorrelationID)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v3/ping", nil)
+	InjectRequest(ctx, request)
+	assert.Equal(t, testCorrelationID, request.Header.Get(common.CorrelationHeader))
+	assert.Equal(t, testCorrelationID, FromRequest(request))
+
+	var envelope types.MessageEnvelope
+	InjectEnvelope(ctx, &envelope)
+	assert.Equal(t, testCorrelationID, envelope.CorrelationID)
+
+	envelope = types.MessageEnvelope{CorrelationID: "unchanged"}
+	InjectEnvelope(context.Background(), &envelope)
+	assert.Equal(t, "unchanged", envelope.CorrelationID)
+}
+
+func TestLoggingClient(t *testing.T) {
+	output := &bytes.Buffer{}
+	lc := logging.NewJSONClient("unit-test", models.InfoLog, output)
+
+	assert.Equal(t, lc, LoggingClient(context.Background(), lc))
+
+	correlated := LoggingClient(NewContext(context.Background(), testCorrelationID), lc)
+	correlated.Info("received", "path", "/api/v3/ping")
+	correlated.Warnf("retrying %d", 2)
+	correlated.Error("odd args", "key")
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(t, lines, 3)
+	for _, line := range lines {
+		entry := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		assert.Equal(t, testCorrelationID, entry[logging.CorrelationIDField])
+		assert.Contains(t, entry["source"], "correlation_test.go:")
+	}
+	assert.Contains(t, lines[1], "retrying 2")
+}
+
+func TestLoggingClientLogLevel(t *testing.T) {
+	lc := logger.NewMockClient()
+	correlated := LoggingClient(NewContext(context.Background(), testCorrelationID), lc)
+	assert.Equal(t, lc.LogLevel(), correlated.LogLevel())
+}
@@ -0,0 +1,127 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package bootstrap
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+)
+
+// defaultStartupAbortGracePeriod is how long the pending step is given to return once the bootstrap context is
+// cancelled due to the startup deadline being exceeded, before the process is forcibly exited
+const defaultStartupAbortGracePeriod = time.Second * 5
+
+var (
+	startupAbortGracePeriod = defaultStartupAbortGracePeriod
+
+	// exitFunc exits the process, replaced by the tests
+	exitFunc = osExit
+	osExit   = os.Exit
+)
+
+// startupWatchdog aborts the bootstrap when it doesn't complete within the startup deadline. Once the deadline is
+// exceeded the pending step is logged and the bootstrap context is cancelled so the retry loops stop, failing the
+// bootstrap. The process is exited if the pending step doesn't return within the grace period, i.e. a step which
+// doesn't observe the context.
+type startupWatchdog struct {
+	lc       logger.LoggingClient
+	cancel   context.CancelFunc
+	timer    *time.Timer
+	pending  string
+	expired  bool
+	finished chan struct{}
+	mutex    sync.Mutex
+}
+
+// startStartupWatchdog starts the watchdog for the remaining time until the deadline. The watchdog does nothing when
+// there is no deadline.
+func startStartupWatchdog(deadline time.Duration, remaining time.Duration, cancel context.CancelFunc, lc logger.LoggingClient) *startupWatchdog {
+	w := &startupWatchdog{
+		lc:       lc,
+		cancel:   cancel,
+		finished: make(chan struct{}),
+	}
+
+	if deadline <= 0 {
+		return w
+	}
+
+	w.timer = time.AfterFunc(remaining, func() { w.abort(deadline) })
+	return w
+}
+
+// setPending records the bootstrap step which is in progress
+func (w *startupWatchdog) setPending(step string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.pending = step
+}
+
+// stop stops the watchdog once the bootstrap has completed, returning whether the deadline was exceeded.
+func (w *startupWatchdog) stop() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.timer != nil {
+		w.timer.Stop()
+		close(w.finished)
+		w.timer = nil
+	}
+
+	return w.expired
+}
+
+func (w *startupWatchdog) abort(deadline time.Duration) {
+	w.mutex.Lock()
+	if w.timer == nil {
+		// The bootstrap completed just as the deadline was exceeded
+		w.mutex.Unlock()
+		return
+	}
+	w.expired = true
+	pending := w.pending
+	w.mutex.Unlock()
+
+	w.lc.Errorf("startup deadline of %s exceeded while '%s' was still pending, aborting startup", deadline.String(), pending)
+	w.cancel()
+
+	select {
+	case <-w.finished:
+	case <-time.After(startupAbortGracePeriod):
+		w.lc.Errorf("'%s' didn't stop within %s of startup being aborted, exiting", pending, startupAbortGracePeriod.String())
+		exitFunc(1)
+	}
+}
+
+// handlerName returns the name of the bootstrap handler function for logging, i.e.
+// "handlers.(*HttpServer).BootstrapHandler"
+func handlerName(handler interfaces.BootstrapHandler) string {
+	name := runtime.FuncForPC(reflect.ValueOf(handler).Pointer()).Name()
+	name = strings.TrimSuffix(name, "-fm")
+	if index := strings.LastIndex(name, "/"); index >= 0 {
+		name = name[index+1:]
+	}
+	return name
+}
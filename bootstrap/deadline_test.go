@@ -0,0 +1,109 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package bootstrap
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+func succeedingHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, _ *di.Container) bool {
+	return true
+}
+
+// neverSucceedingHandler retries until the startup timer elapses or the context is cancelled
+func neverSucceedingHandler(ctx context.Context, _ *sync.WaitGroup, startupTimer startup.Timer, _ *di.Container) bool {
+	for startupTimer.HasNotElapsed() {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+	return false
+}
+
+func TestRunBootstrapHandlers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	timer := startup.NewTimer(60, 1)
+	watchdog := startStartupWatchdog(timer.Deadline(), timer.RemainingUntilDeadline(), cancel, logger.NewMockClient())
+
+	handlers := []interfaces.BootstrapHandler{succeedingHandler, succeedingHandler}
+	assert.True(t, runBootstrapHandlers(ctx, cancel, &sync.WaitGroup{}, timer, di.NewContainer(nil), handlers, watchdog))
+	assert.NoError(t, ctx.Err())
+}
+
+func TestRunBootstrapHandlers_DeadlineExceeded(t *testing.T) {
+	exited := false
+	exitFunc = func(int) { exited = true }
+	defer func() { exitFunc = osExit }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The retry duration is well beyond the deadline so only the deadline stops the handler
+	timer := startup.NewTimer(60, 1).WithDeadline(1)
+	watchdog := startStartupWatchdog(timer.Deadline(), timer.RemainingUntilDeadline(), cancel, logger.NewMockClient())
+
+	started := time.Now()
+	handlers := []interfaces.BootstrapHandler{succeedingHandler, neverSucceedingHandler}
+	assert.False(t, runBootstrapHandlers(ctx, cancel, &sync.WaitGroup{}, timer, di.NewContainer(nil), handlers, watchdog))
+	assert.Less(t, time.Since(started), time.Second*5)
+	assert.Error(t, ctx.Err())
+	assert.Equal(t, "bootstrap.neverSucceedingHandler", watchdog.pending)
+	assert.False(t, exited)
+}
+
+func TestRunBootstrapHandlers_DeadlineExceededAndIgnored(t *testing.T) {
+	exited := make(chan struct{})
+	exitFunc = func(code int) {
+		assert.Equal(t, 1, code)
+		close(exited)
+	}
+	startupAbortGracePeriod = time.Millisecond * 100
+	defer func() {
+		exitFunc = osExit
+		startupAbortGracePeriod = defaultStartupAbortGracePeriod
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	timer := startup.NewTimer(60, 1).WithDeadline(1)
+	watchdog := startStartupWatchdog(timer.Deadline(), timer.RemainingUntilDeadline(), cancel, logger.NewMockClient())
+
+	// The handler ignores the context being cancelled, so the process is exited
+	ignoringHandler := func(context.Context, *sync.WaitGroup, startup.Timer, *di.Container) bool {
+		<-exited
+		return true
+	}
+
+	assert.False(t, runBootstrapHandlers(ctx, cancel, &sync.WaitGroup{}, timer, di.NewContainer(nil), []interfaces.BootstrapHandler{ignoringHandler}, watchdog))
+}
+
+func TestHandlerName(t *testing.T) {
+	assert.Equal(t, "bootstrap.succeedingHandler", handlerName(succeedingHandler))
+}
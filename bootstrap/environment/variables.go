@@ -21,8 +21,10 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/utils"
@@ -35,24 +37,33 @@ import (
 const (
 	bootTimeoutSecondsDefault = 60
 	bootRetrySecondsDefault   = 1
+	bootRetryJitterDefault    = 0.2
 	defaultConfigDirValue     = "./res"
 
-	envKeyConfigUrl          = "EDGEX_CONFIG_PROVIDER"
-	envKeyCommonConfig       = "EDGEX_COMMON_CONFIG"
-	envKeyUseRegistry        = "EDGEX_USE_REGISTRY"
-	envKeyStartupDuration    = "EDGEX_STARTUP_DURATION"
-	envKeyStartupInterval    = "EDGEX_STARTUP_INTERVAL"
-	envKeyConfigDir          = "EDGEX_CONFIG_DIR"
-	envKeyProfile            = "EDGEX_PROFILE"
-	envKeyConfigFile         = "EDGEX_CONFIG_FILE"
-	envKeyFileURITimeout     = "EDGEX_FILE_URI_TIMEOUT"
-	envKeyRemoteServiceHosts = "EDGEX_REMOTE_SERVICE_HOSTS"
+	envKeyConfigUrl             = "EDGEX_CONFIG_PROVIDER"
+	envKeyConfigProviderTimeout = "EDGEX_CONFIG_PROVIDER_TIMEOUT"
+	envKeyConfigPrefix          = "EDGEX_CONFIG_PREFIX"
+	envKeyCommonConfig          = "EDGEX_COMMON_CONFIG"
+	envKeyUseRegistry           = "EDGEX_USE_REGISTRY"
+	envKeyStartupDuration       = "EDGEX_STARTUP_DURATION"
+	envKeyStartupInterval       = "EDGEX_STARTUP_INTERVAL"
+	envKeyStartupDeadline       = "EDGEX_STARTUP_DEADLINE"
+	envKeyStartupJitter         = "EDGEX_STARTUP_JITTER"
+	envKeyLogFormat             = "EDGEX_LOG_FORMAT"
+	envKeyLogSampleEvery        = "EDGEX_LOG_SAMPLE_EVERY"
+	envKeyLogSampleInterval     = "EDGEX_LOG_SAMPLE_INTERVAL"
+	envKeyConfigDir             = "EDGEX_CONFIG_DIR"
+	envKeyProfile               = "EDGEX_PROFILE"
+	envKeyConfigFile            = "EDGEX_CONFIG_FILE"
+	envKeyFileURITimeout        = "EDGEX_FILE_URI_TIMEOUT"
+	envKeyRemoteServiceHosts    = "EDGEX_REMOTE_SERVICE_HOSTS"
 
 	noConfigProviderValue = "none"
 
 	configPathSeparator = "/"
 	configNameSeparator = "-"
 	envNameSeparator    = "_"
+	envMapKeySeparator  = "__"
 
 	// insecureSecretsRegexStr is a regex to look for toml keys that are under the Secrets sub-key of values within the
 	// Writable.InsecureSecrets topology.
@@ -64,6 +75,9 @@ const (
 	redactedStr = "<redacted>"
 
 	defaultFileURITimeout = 15 * time.Second
+	// DefaultConfigProviderTimeout is the time each request to the Configuration Provider is given during startup
+	// when EDGEX_CONFIG_PROVIDER_TIMEOUT isn't set
+	DefaultConfigProviderTimeout = 5 * time.Second
 )
 
 var (
@@ -78,9 +92,25 @@ var (
 //	         		baz string
 //	 			}
 //			}
+//
+// Individual elements of a slice are overridden by appending the element's index to the slice's name, e.g.
+// MESSAGEBUS_SUBSCRIBERWAITTOPICS_1=events/device replaces the second topic. Using the index one past the
+// last element appends a new element. Entries of a map are overridden by appending "__" and the entry's key, which
+// is used as is, to the map's name, e.g. WRITABLE_TELEMETRY_TAGS__Gateway=gateway-1 adds or replaces the
+// "Gateway" tag. Existing map entries can also be overridden the same as struct fields, e.g. WRITABLE_TELEMETRY_TAGS_GATEWAY.
 type Variables struct {
 	variables map[string]string
 	lc        logger.LoggingClient
+	overrides map[string]Override
+	mutex     sync.RWMutex
+}
+
+// Override records the environment variable which overrode a configuration setting
+type Override struct {
+	// EnvVar is the name of the environment variable which set the value
+	EnvVar string
+	// Value is the value set by the environment variable, which is redacted for insecure secrets
+	Value string
 }
 
 // NewVariables constructor reads/stores os.Environ() for use by Variables receiver methods.
@@ -89,6 +119,7 @@ func NewVariables(lc logger.LoggingClient) *Variables {
 	e := &Variables{
 		variables: make(map[string]string, len(osEnv)),
 		lc:        lc,
+		overrides: make(map[string]Override),
 	}
 
 	for _, env := range osEnv {
@@ -171,10 +202,194 @@ func (e *Variables) OverrideConfigMapValues(configMap map[string]any) (int, erro
 
 		setConfigMapValue(path, newValue, configMap)
 		overrideCount++
-		logEnvironmentOverride(e.lc, path, envVar, envValue)
+		e.recordOverride(path, envVar, envValue)
 	}
 
-	return overrideCount, nil
+	elementCount, err := e.overrideElementValues(configMap, overrideNames)
+	if err != nil {
+		return 0, err
+	}
+
+	return overrideCount + elementCount, nil
+}
+
+// recordOverride logs the override of the configuration setting at the path and records it so that the provenance
+// of the setting's value can be retrieved via Overrides
+func (e *Variables) recordOverride(path string, envVar string, envValue string) {
+	logEnvironmentOverride(e.lc, path, envVar, envValue)
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.overrides == nil {
+		e.overrides = make(map[string]Override)
+	}
+	e.overrides[path] = Override{EnvVar: envVar, Value: redactOverrideValue(path, envValue)}
+}
+
+// Overrides returns a copy of the configuration settings which have been overridden by environment variables,
+// keyed by the path of the setting, e.g. Writable/LogLevel
+func (e *Variables) Overrides() map[string]Override {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	overrides := make(map[string]Override, len(e.overrides))
+	for path, override := range e.overrides {
+		overrides[path] = override
+	}
+
+	return overrides
+}
+
+// elementOverride is an environment variable override of a single slice element or map entry
+type elementOverride struct {
+	envVar   string
+	envValue string
+	path     string
+	index    int
+}
+
+// overrideElementValues replaces the slice elements and map entries in the config map for matching indexed and keyed
+// environment variable names. Variables that exactly match a setting's override name have already been applied.
+func (e *Variables) overrideElementValues(configMap map[string]any, overrideNames map[string]string) (int, error) {
+	sliceNames := map[string]string{}
+	mapNames := map[string]string{}
+	e.buildCollectionNames(configMap, "", sliceNames, mapNames)
+
+	var sliceOverrides []elementOverride
+	var mapOverrides []elementOverride
+
+	for envVar, envValue := range e.variables {
+		if _, found := overrideNames[envVar]; found {
+			continue
+		}
+
+		if index := strings.Index(envVar, envMapKeySeparator); index > 0 {
+			path, found := mapNames[envVar[:index]]
+			key := envVar[index+len(envMapKeySeparator):]
+			if found && len(key) > 0 {
+				mapOverrides = append(mapOverrides, elementOverride{
+					envVar:   envVar,
+					envValue: envValue,
+					path:     path + configPathSeparator + key,
+				})
+			}
+			continue
+		}
+
+		index := strings.LastIndex(envVar, envNameSeparator)
+		if index <= 0 {
+			continue
+		}
+		path, found := sliceNames[envVar[:index]]
+		if !found {
+			continue
+		}
+		elementIndex, err := strconv.Atoi(envVar[index+1:])
+		if err != nil || elementIndex < 0 {
+			continue
+		}
+		sliceOverrides = append(sliceOverrides, elementOverride{
+			envVar:   envVar,
+			envValue: envValue,
+			path:     path,
+			index:    elementIndex,
+		})
+	}
+
+	// Apply the slice overrides in index order so that consecutive new elements can be appended
+	sort.Slice(sliceOverrides, func(i, j int) bool {
+		return sliceOverrides[i].index < sliceOverrides[j].index
+	})
+
+	for _, override := range sliceOverrides {
+		elements := toAnySlice(getConfigMapValue(override.path, configMap))
+		if override.index > len(elements) {
+			return 0, fmt.Errorf("environment value override failed for %s=%s: index %d is out of range for %d elements",
+				override.envVar, override.envValue, override.index, len(elements))
+		}
+
+		// New elements take on the type of the existing elements
+		var oldValue any = ""
+		if override.index < len(elements) {
+			oldValue = elements[override.index]
+		} else if len(elements) > 0 {
+			oldValue = elements[0]
+		}
+
+		newValue, err := e.convertToType(oldValue, override.envValue)
+		if err != nil {
+			return 0, fmt.Errorf("environment value override failed for %s=%s: %s", override.envVar, override.envValue, err.Error())
+		}
+
+		if override.index == len(elements) {
+			elements = append(elements, newValue)
+		} else {
+			elements[override.index] = newValue
+		}
+
+		setConfigMapValue(override.path, elements, configMap)
+		e.recordOverride(fmt.Sprintf("%s/%d", override.path, override.index), override.envVar, override.envValue)
+	}
+
+	for _, override := range mapOverrides {
+		// New entries take on the type of the existing entries
+		oldValue := getConfigMapValue(override.path, configMap)
+		if oldValue == nil {
+			oldValue = ""
+			entries, _ := getConfigMapValue(override.path[:strings.LastIndex(override.path, configPathSeparator)], configMap).(map[string]any)
+			for _, entry := range entries {
+				if entry != nil {
+					oldValue = entry
+					break
+				}
+			}
+		}
+
+		newValue, err := e.convertToType(oldValue, override.envValue)
+		if err != nil {
+			return 0, fmt.Errorf("environment value override failed for %s=%s: %s", override.envVar, override.envValue, err.Error())
+		}
+
+		setConfigMapValue(override.path, newValue, configMap)
+		e.recordOverride(override.path, override.envVar, override.envValue)
+	}
+
+	return len(sliceOverrides) + len(mapOverrides), nil
+}
+
+// buildCollectionNames collects the override names of all the slices and maps in the Config key map, keyed to their paths
+func (e *Variables) buildCollectionNames(keyMap map[string]any, parentPath string, sliceNames map[string]string, mapNames map[string]string) {
+	for key, item := range keyMap {
+		path := key
+		if len(parentPath) > 0 {
+			path = parentPath + configPathSeparator + key
+		}
+
+		switch value := item.(type) {
+		case []any, []string:
+			sliceNames[e.getOverrideNameFor(path)] = path
+		case map[string]any:
+			mapNames[e.getOverrideNameFor(path)] = path
+			e.buildCollectionNames(value, path, sliceNames, mapNames)
+		}
+	}
+}
+
+// toAnySlice returns a copy of the slice value as a slice of any
+func toAnySlice(value any) []any {
+	switch slice := value.(type) {
+	case []any:
+		return append([]any{}, slice...)
+	case []string:
+		elements := make([]any, len(slice))
+		for i, element := range slice {
+			elements[i] = element
+		}
+		return elements
+	}
+
+	return nil
 }
 
 func getConfigMapValue(path string, configMap map[string]any) any {
@@ -348,6 +563,12 @@ func (_ *Variables) convertToType(oldValue any, value string) (newValue any, err
 type StartupInfo struct {
 	Duration int
 	Interval int
+	// Deadline is the absolute number of seconds the whole bootstrap must complete within, otherwise it is aborted.
+	// The deadline is disabled when zero, which is the default.
+	Deadline int
+	// Jitter is the fraction of the Interval which retries are randomly spread by, so services started together
+	// don't retry in lockstep. Zero disables the jitter.
+	Jitter float64
 }
 
 // GetStartupInfo gets the Service StartupInfo values from an Variables variable value (if it exists)
@@ -359,6 +580,7 @@ func GetStartupInfo(serviceKey string) StartupInfo {
 	startup := StartupInfo{
 		Duration: bootTimeoutSecondsDefault,
 		Interval: bootRetrySecondsDefault,
+		Jitter:   bootRetryJitterDefault,
 	}
 
 	// Get the startup timer configuration from environment, if provided.
@@ -381,9 +603,71 @@ func GetStartupInfo(serviceKey string) StartupInfo {
 		}
 	}
 
+	// Get the startup deadline, if provided.
+	value = os.Getenv(envKeyStartupDeadline)
+	if len(value) > 0 {
+		logEnvironmentOverride(lc, "Startup Deadline", envKeyStartupDeadline, value)
+
+		if n, err := strconv.ParseInt(value, 10, 0); err == nil && n > 0 {
+			startup.Deadline = int(n)
+		}
+	}
+
+	// Get the startup interval jitter, if provided.
+	value = os.Getenv(envKeyStartupJitter)
+	if len(value) > 0 {
+		logEnvironmentOverride(lc, "Startup Jitter", envKeyStartupJitter, value)
+
+		if n, err := strconv.ParseFloat(value, 64); err == nil && n >= 0 && n <= 1 {
+			startup.Jitter = n
+		}
+	}
+
 	return startup
 }
 
+// GetLogFormat gets the format of the service's log output, i.e. "json", from the Variables variable value (if it
+// exists). The format is only available from the environment since the LoggingClient is created before the
+// configuration is loaded. Empty means the default human-readable format.
+func GetLogFormat() string {
+	return strings.TrimSpace(os.Getenv(envKeyLogFormat))
+}
+
+// LogSamplingInfo provides the settings for sampling repeated Trace and Debug log messages
+type LogSamplingInfo struct {
+	// Every is the number of occurrences of a repeated message which are logged once, zero disables it.
+	Every int
+	// Interval is the minimum time between logging a repeated message, zero disables it.
+	Interval time.Duration
+}
+
+// GetLogSamplingInfo gets the log sampling settings from the Variables variable values (if they exist). Sampling is
+// disabled by default. The settings are only available from the environment since the LoggingClient is created
+// before the configuration is loaded.
+func GetLogSamplingInfo(lc logger.LoggingClient) LogSamplingInfo {
+	var sampling LogSamplingInfo
+
+	value := os.Getenv(envKeyLogSampleEvery)
+	if len(value) > 0 {
+		logEnvironmentOverride(lc, "Log Sample Every", envKeyLogSampleEvery, value)
+
+		if n, err := strconv.ParseInt(value, 10, 0); err == nil && n > 0 {
+			sampling.Every = int(n)
+		}
+	}
+
+	value = os.Getenv(envKeyLogSampleInterval)
+	if len(value) > 0 {
+		logEnvironmentOverride(lc, "Log Sample Interval", envKeyLogSampleInterval, value)
+
+		if interval, err := time.ParseDuration(value); err == nil && interval > 0 {
+			sampling.Interval = interval
+		}
+	}
+
+	return sampling
+}
+
 // GetConfigDir get the config directory value from a Variables variable value (if it exists)
 // or uses passed in value or default if previous result in blank.
 func GetConfigDir(lc logger.LoggingClient, configDir string) string {
@@ -455,11 +739,15 @@ func parseCommaSeparatedSlice(value string) (values []any) {
 // logEnvironmentOverride logs that an option or configuration has been override by an environment variable.
 // If the key belongs to a Secret within Writable.InsecureSecrets, the value is redacted when printing it.
 func logEnvironmentOverride(lc logger.LoggingClient, name string, key string, value string) {
-	valueStr := value
+	lc.Infof("Variables override of '%s' by environment variable: %s=%s", name, key, redactOverrideValue(name, value))
+}
+
+// redactOverrideValue returns the value redacted if the name belongs to a Secret within Writable.InsecureSecrets
+func redactOverrideValue(name string, value string) string {
 	if insecureSecretsRegex.MatchString(name) {
-		valueStr = redactedStr
+		return redactedStr
 	}
-	lc.Infof("Variables override of '%s' by environment variable: %s=%s", name, key, valueStr)
+	return value
 }
 
 // GetURIRequestTimeout gets the configuration request timeout value from an environment variable (if it exists)
@@ -480,6 +768,39 @@ func GetURIRequestTimeout(lc logger.LoggingClient) time.Duration {
 	return requestTimeout
 }
 
+// GetConfigProviderTimeout gets the time each request to the Configuration Provider is given during startup from an
+// environment variable (if it exists), if not returns DefaultConfigProviderTimeout. A request which doesn't complete
+// in time fails; while waiting for the provider and the common configuration the attempt is retried on the next startup
+// interval, otherwise loading the configuration fails.
+func GetConfigProviderTimeout(lc logger.LoggingClient) time.Duration {
+	envValue := os.Getenv(envKeyConfigProviderTimeout)
+	if len(envValue) <= 0 {
+		return DefaultConfigProviderTimeout
+	}
+
+	timeout, err := time.ParseDuration(envValue)
+	if err != nil || timeout <= 0 {
+		lc.Warnf("Could not parse value for %s = %s, must be a positive duration. Using default of %s",
+			envKeyConfigProviderTimeout, envValue, DefaultConfigProviderTimeout)
+		return DefaultConfigProviderTimeout
+	}
+
+	lc.Infof("Variables override of 'Config Provider Timeout' by environment variable: %s=%s", envKeyConfigProviderTimeout, envValue)
+	return timeout
+}
+
+// GetConfigPrefix gets the Configuration Provider key prefix from an environment variable (if it exists), if not
+// returns the passed in (default) value, which is empty when the default prefix is used.
+func GetConfigPrefix(lc logger.LoggingClient, configPrefix string) string {
+	envValue := os.Getenv(envKeyConfigPrefix)
+	if len(envValue) > 0 {
+		configPrefix = envValue
+		logEnvironmentOverride(lc, "--configPrefix", envKeyConfigPrefix, envValue)
+	}
+
+	return configPrefix
+}
+
 // GetRemoteServiceHosts gets the Remote Service host name list from an environment variable (if it exists), if not returns the passed in (default) value
 func GetRemoteServiceHosts(lc logger.LoggingClient, remoteHosts []string) []string {
 	envValue := os.Getenv(envKeyRemoteServiceHosts)
@@ -125,9 +125,15 @@ func TestGetStartupInfo(t *testing.T) {
 		ExpectedDuration int
 		IntervalEnvName  string
 		ExpectedInterval int
+		DeadlineEnvName  string
+		ExpectedDeadline int
+		JitterEnvValue   string
+		ExpectedJitter   float64
 	}{
-		{"V2 Envs", envKeyStartupDuration, 120, envKeyStartupInterval, 30},
-		{"No Envs", "", bootTimeoutSecondsDefault, "", bootRetrySecondsDefault},
+		{"V2 Envs", envKeyStartupDuration, 120, envKeyStartupInterval, 30, envKeyStartupDeadline, 300, "0.5", 0.5},
+		{"No Envs", "", bootTimeoutSecondsDefault, "", bootRetrySecondsDefault, "", 0, "", bootRetryJitterDefault},
+		{"Jitter disabled", "", bootTimeoutSecondsDefault, "", bootRetrySecondsDefault, "", 0, "0", 0},
+		{"Jitter invalid", "", bootTimeoutSecondsDefault, "", bootRetrySecondsDefault, "", 0, "1.5", bootRetryJitterDefault},
 	}
 
 	for _, test := range testCases {
@@ -144,9 +150,21 @@ func TestGetStartupInfo(t *testing.T) {
 				require.NoError(t, err)
 			}
 
+			if len(test.DeadlineEnvName) > 0 {
+				err := os.Setenv(test.DeadlineEnvName, strconv.Itoa(test.ExpectedDeadline))
+				require.NoError(t, err)
+			}
+
+			if len(test.JitterEnvValue) > 0 {
+				err := os.Setenv(envKeyStartupJitter, test.JitterEnvValue)
+				require.NoError(t, err)
+			}
+
 			actual := GetStartupInfo("unit-test")
 			assert.Equal(t, test.ExpectedDuration, actual.Duration)
 			assert.Equal(t, test.ExpectedInterval, actual.Interval)
+			assert.Equal(t, test.ExpectedDeadline, actual.Deadline)
+			assert.Equal(t, test.ExpectedJitter, actual.Jitter)
 		})
 	}
 }
@@ -240,6 +258,30 @@ func TestGetProfileDir(t *testing.T) {
 	}
 }
 
+func TestGetLogFormat(t *testing.T) {
+	os.Clearenv()
+	assert.Equal(t, "", GetLogFormat())
+
+	err := os.Setenv(envKeyLogFormat, " json ")
+	require.NoError(t, err)
+	assert.Equal(t, "json", GetLogFormat())
+}
+
+func TestGetLogSamplingInfo(t *testing.T) {
+	_, lc := initializeTest()
+
+	os.Clearenv()
+	assert.Equal(t, LogSamplingInfo{}, GetLogSamplingInfo(lc))
+
+	require.NoError(t, os.Setenv(envKeyLogSampleEvery, "100"))
+	require.NoError(t, os.Setenv(envKeyLogSampleInterval, "10s"))
+	assert.Equal(t, LogSamplingInfo{Every: 100, Interval: time.Second * 10}, GetLogSamplingInfo(lc))
+
+	require.NoError(t, os.Setenv(envKeyLogSampleEvery, "-1"))
+	require.NoError(t, os.Setenv(envKeyLogSampleInterval, "bogus"))
+	assert.Equal(t, LogSamplingInfo{}, GetLogSamplingInfo(lc))
+}
+
 func TestGetConfigFileName(t *testing.T) {
 	_, lc := initializeTest()
 
@@ -445,6 +487,73 @@ func TestOverrideConfigurationUppercase(t *testing.T) {
 	assert.Equal(t, expectedFloatVal, serviceConfig.FloatVal)
 }
 
+func TestOverrideConfigurationElements(t *testing.T) {
+	_, lc := initializeTest()
+
+	serviceConfig := struct {
+		Hosts    []string
+		Ports    []int
+		Writable struct {
+			Tags map[string]string
+		}
+	}{
+		Hosts: []string{"host-a", "host-b"},
+		Ports: []int{59880},
+	}
+	serviceConfig.Writable.Tags = map[string]string{"Gateway": "gateway-1"}
+
+	_ = os.Setenv("HOSTS_1", "host-c")
+	_ = os.Setenv("HOSTS_2", "host-d")
+	_ = os.Setenv("HOSTS_3", "host-e")
+	_ = os.Setenv("PORTS_0", "59881")
+	_ = os.Setenv("WRITABLE_TAGS__Gateway", "gateway-2")
+	_ = os.Setenv("WRITABLE_TAGS__Location", "building-1")
+	// Indexes that aren't numbers are not overrides
+	_ = os.Setenv("HOSTS_FIRST", "host-f")
+
+	env := NewVariables(lc)
+	actualCount, err := env.OverrideConfiguration(&serviceConfig)
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, actualCount)
+	assert.Equal(t, []string{"host-a", "host-c", "host-d", "host-e"}, serviceConfig.Hosts)
+	assert.Equal(t, []int{59881}, serviceConfig.Ports)
+	assert.Equal(t, map[string]string{"Gateway": "gateway-2", "Location": "building-1"}, serviceConfig.Writable.Tags)
+}
+
+func TestOverrideConfigurationElements_Errors(t *testing.T) {
+	tests := []struct {
+		Name     string
+		EnvVar   string
+		EnvValue string
+	}{
+		{"Index out of range", "PORTS_2", "59881"},
+		{"Invalid element value", "PORTS_0", "not-a-port"},
+		{"Invalid entry value", "LIMITS__Max", "not-a-number"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, lc := initializeTest()
+
+			serviceConfig := struct {
+				Ports  []int
+				Limits map[string]int
+			}{
+				Ports:  []int{59880},
+				Limits: map[string]int{"Min": 1},
+			}
+
+			_ = os.Setenv(test.EnvVar, test.EnvValue)
+
+			env := NewVariables(lc)
+			_, err := env.OverrideConfiguration(&serviceConfig)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.EnvVar)
+		})
+	}
+}
+
 func TestOverrideConfigurationWithBlankValue(t *testing.T) {
 	_, lc := initializeTest()
 
@@ -644,3 +753,93 @@ func TestGetRequestTimeout(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConfigProviderTimeout(t *testing.T) {
+	_, lc := initializeTest()
+
+	testCases := []struct {
+		TestName        string
+		EnvValue        string
+		ExpectedTimeout time.Duration
+	}{
+		{"With Env Var", "500ms", 500 * time.Millisecond},
+		{"With No Env Var", "", DefaultConfigProviderTimeout},
+		{"With Invalid Env Var", "fast", DefaultConfigProviderTimeout},
+		{"With Zero Env Var", "0s", DefaultConfigProviderTimeout},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.TestName, func(t *testing.T) {
+			os.Clearenv()
+
+			if len(test.EnvValue) > 0 {
+				err := os.Setenv(envKeyConfigProviderTimeout, test.EnvValue)
+				require.NoError(t, err)
+			}
+
+			actual := GetConfigProviderTimeout(lc)
+			assert.Equal(t, test.ExpectedTimeout, actual)
+		})
+	}
+}
+
+func TestGetConfigPrefix(t *testing.T) {
+	_, lc := initializeTest()
+
+	testCases := []struct {
+		TestName       string
+		EnvValue       string
+		FlagValue      string
+		ExpectedPrefix string
+	}{
+		{"With Env Var", "site-b/edgex/v3", "site-a/edgex/v3", "site-b/edgex/v3"},
+		{"With Flag only", "", "site-a/edgex/v3", "site-a/edgex/v3"},
+		{"With Neither", "", "", ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.TestName, func(t *testing.T) {
+			os.Clearenv()
+
+			if len(test.EnvValue) > 0 {
+				err := os.Setenv(envKeyConfigPrefix, test.EnvValue)
+				require.NoError(t, err)
+			}
+
+			actual := GetConfigPrefix(lc, test.FlagValue)
+			assert.Equal(t, test.ExpectedPrefix, actual)
+		})
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	_, lc := initializeTest()
+
+	serviceConfig := struct {
+		Registry config.RegistryInfo
+		Hosts    []string
+	}{
+		Registry: config.RegistryInfo{Host: "localhost", Port: 8500},
+		Hosts:    []string{"host-a"},
+	}
+
+	_ = os.Setenv("REGISTRY_HOST", "edgex-core-consul")
+	_ = os.Setenv("HOSTS_1", "host-b")
+
+	env := NewVariables(lc)
+	assert.Empty(t, env.Overrides())
+
+	_, err := env.OverrideConfiguration(&serviceConfig)
+	require.NoError(t, err)
+
+	expected := map[string]Override{
+		"Registry/Host": {EnvVar: "REGISTRY_HOST", Value: "edgex-core-consul"},
+		"Hosts/1":       {EnvVar: "HOSTS_1", Value: "host-b"},
+	}
+	actual := env.Overrides()
+	assert.Equal(t, expected, actual)
+
+	// A copy is returned so the recorded overrides can't be modified
+	delete(actual, "Registry/Host")
+	assert.Equal(t, expected, env.Overrides())
+}
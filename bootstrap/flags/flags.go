@@ -25,6 +25,9 @@ import (
 const (
 	DefaultConfigProvider = "consul.http://localhost:8500"
 	DefaultConfigFile     = "configuration.yaml"
+
+	argsFilePrefix  = "@"
+	argsFileComment = "#"
 )
 
 // Common is an interface that defines AP for the common command-line flags used by most EdgeX services
@@ -37,8 +40,12 @@ type Common interface {
 	ConfigDirectory() string
 	ConfigFileName() string
 	CommonConfig() string
+	OverrideConfigFile() string
 	Parse([]string)
 	RemoteServiceHosts() []string
+	Validate() bool
+	ServiceKey() string
+	ConfigPrefix() string
 	Help()
 }
 
@@ -51,10 +58,14 @@ type Default struct {
 	devMode            bool
 	configProviderUrl  string
 	commonConfig       string
+	overrideConfigFile string
 	profile            string
 	configDir          string
 	configFileName     string
 	remoteServiceHosts string
+	validate           bool
+	serviceKey         string
+	configPrefix       string
 }
 
 // NewWithUsage returns a Default struct.
@@ -72,6 +83,12 @@ func New() *Default {
 
 // Parse parses the passed in command-lie arguments looking to the default set of common flags
 func (d *Default) Parse(arguments []string) {
+	arguments, err := expandArgsFiles(arguments)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
 	// The flags package doesn't allow for String flags to be specified without a value, so to support
 	// -cp/-configProvider without value to indicate using default host value we must detect use of this option with
 	// out value and insert the default value before parsing the command line options.
@@ -96,6 +113,8 @@ func (d *Default) Parse(arguments []string) {
 	d.FlagSet.BoolVar(&d.overwriteConfig, "o", false, "")
 	d.FlagSet.StringVar(&d.configFileName, "cf", DefaultConfigFile, "")
 	d.FlagSet.StringVar(&d.configFileName, "configFile", DefaultConfigFile, "")
+	d.FlagSet.StringVar(&d.overrideConfigFile, "overrideConfigFile", "", "")
+	d.FlagSet.StringVar(&d.overrideConfigFile, "ocf", "", "")
 	d.FlagSet.StringVar(&d.profile, "profile", "", "")
 	d.FlagSet.StringVar(&d.profile, "p", "", ".")
 	d.FlagSet.StringVar(&d.configDir, "configDir", "", "")
@@ -106,16 +125,70 @@ func (d *Default) Parse(arguments []string) {
 	d.FlagSet.BoolVar(&d.useRegistry, "r", false, "")
 	d.FlagSet.BoolVar(&d.devMode, "dev", false, "")
 	d.FlagSet.BoolVar(&d.devMode, "d", false, "")
+	d.FlagSet.BoolVar(&d.validate, "validate", false, "")
+	d.FlagSet.StringVar(&d.serviceKey, "serviceKey", "", "")
+	d.FlagSet.StringVar(&d.serviceKey, "sk", "", "")
+	d.FlagSet.StringVar(&d.configPrefix, "configPrefix", "", "")
 
 	d.FlagSet.Usage = d.helpCallback
 
-	err := d.FlagSet.Parse(arguments)
+	err = d.FlagSet.Parse(arguments)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(0)
 	}
 }
 
+// expandArgsFiles replaces each @file argument with the arguments read from the file, one per line. Blank lines and
+// lines starting with '#' are ignored. A flag and its value may be on the same line separated by whitespace, i.e.
+// "-cf configuration.yaml". The arguments are inserted in place of the @file argument, so flags which follow it on
+// the command line take precedence over the flags in the file. The files aren't expanded recursively.
+func expandArgsFiles(arguments []string) ([]string, error) {
+	var expanded []string
+	for _, argument := range arguments {
+		if !strings.HasPrefix(argument, argsFilePrefix) || len(argument) == len(argsFilePrefix) {
+			expanded = append(expanded, argument)
+			continue
+		}
+
+		fileArguments, err := readArgsFile(strings.TrimPrefix(argument, argsFilePrefix))
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, fileArguments...)
+	}
+
+	return expanded, nil
+}
+
+// readArgsFile reads the arguments from the args file
+func readArgsFile(path string) ([]string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read flags file '%s': %s", path, err.Error())
+	}
+
+	var arguments []string
+	for _, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, argsFileComment) {
+			continue
+		}
+
+		// Only a flag can have its value on the same line, otherwise the line is a single argument
+		if strings.HasPrefix(line, "-") && !strings.Contains(line, "=") {
+			if index := strings.IndexAny(line, " \t"); index > 0 {
+				arguments = append(arguments, line[:index], strings.TrimSpace(line[index:]))
+				continue
+			}
+		}
+
+		arguments = append(arguments, line)
+	}
+
+	return arguments, nil
+}
+
 // OverwriteConfig returns whether the local configuration should be pushed (overwrite) into the Configuration provider
 func (d *Default) OverwriteConfig() bool {
 	return d.overwriteConfig
@@ -156,6 +229,11 @@ func (d *Default) CommonConfig() string {
 	return d.commonConfig
 }
 
+// OverrideConfigFile returns the location of the configuration file whose settings override the loaded configuration
+func (d *Default) OverrideConfigFile() string {
+	return d.overrideConfigFile
+}
+
 func (d *Default) RemoteServiceHosts() []string {
 	if len(d.remoteServiceHosts) == 0 {
 		return nil
@@ -164,6 +242,23 @@ func (d *Default) RemoteServiceHosts() []string {
 	return strings.Split(d.remoteServiceHosts, ",")
 }
 
+// Validate returns whether the service should only validate its configuration and dependencies and then exit,
+// rather than start
+func (d *Default) Validate() bool {
+	return d.validate
+}
+
+// ServiceKey returns the service key which overrides the service's compiled-in key, if one was specified
+func (d *Default) ServiceKey() string {
+	return d.serviceKey
+}
+
+// ConfigPrefix returns the key prefix which overrides the default namespace of the service's keys in the
+// Configuration Provider, if one was specified
+func (d *Default) ConfigPrefix() string {
+	return d.configPrefix
+}
+
 // Help displays the usage help message and exit.
 func (d *Default) Help() {
 	d.helpCallback()
@@ -176,12 +271,17 @@ func (d *Default) helpCallback() {
 			"Server Options:\n"+
 			"    -cp, --configProvider        Indicates to use Configuration Provider service at specified URL.\n"+
 			"                                 URL Format: {type}.{protocol}://{host}:{port} ex: consul.http://localhost:8500\n"+
+			"                                 Supported types are consul, keeper and etcd, ex: etcd://localhost:2379\n"+
 			"    -cc, --commonConfig          Takes the location where the common configuration is loaded from when\n"+
 			"                                 not using the Configuration Provider\n"+
 			"    -o, --overwrite              Overwrite configuration in provider with local configuration\n"+
 			"                                 *** Use with cation *** Use will clobber existing settings in provider,\n"+
 			"                                 problematic if those settings were edited by hand intentionally\n"+
 			"    -cf, --configFile <name>     Indicates name of the local configuration file. Defaults to configuration.yaml\n"+
+			"    -ocf, \n"+
+			"     --overrideConfigFile <path> Indicates the location of a partial configuration file whose settings override\n"+
+			"                                 the loaded configuration. Settings not in the file are left as is. Environment\n"+
+			"                                 variable overrides still take precedence\n"+
 			"    -p, --profile <name>         Indicate configuration profile other than default\n"+
 			"    -cd, --configDir             Specify local configuration directory\n"+
 			"    -r, --registry               Indicates service should use Registry.\n"+
@@ -193,8 +293,19 @@ func (d *Default) helpCallback() {
 			"                                 example: -rsh=192.0.1.20,192.0.1.5,localhost\n"+
 			"    -d, --dev                    Indicates service to run in developer mode which causes Host configuration values to be overridden.\n"+
 			"                                 with `localhost`. This is so that it will run with other services running in Docker (aka hybrid mode)\n"+
+			"    -sk, --serviceKey <key>      Overrides the service's key, i.e. to run multiple instances of the same service.\n"+
+			"                                 The key is used to register with the Registry, for the service's path in the\n"+
+			"                                 Configuration Provider and for the service name of the reported metrics\n"+
+			"    --configPrefix <prefix>      Overrides the key prefix, edgex/v3 by default, the configuration is read from and\n"+
+			"                                 written to in the Configuration Provider, i.e. to isolate multiple deployments\n"+
+			"                                 sharing a Configuration Provider\n"+
+			"    --validate                   Validates the configuration and the service's dependencies, i.e. secrets and Registry,\n"+
+			"                                 then exits with a report of the checks, without starting the service. Exits non-zero\n"+
+			"                                 when any check fails\n"+
 			"%s\n"+
 			"Common Options:\n"+
+			"    @<file>                      Reads additional options from the file, one per line. Blank lines and lines\n"+
+			"                                 starting with '#' are ignored. Options after @<file> take precedence\n"+
 			"    -h, --help                   Show this message\n",
 		os.Args[0], d.additionalUsage,
 	)
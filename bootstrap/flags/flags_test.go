@@ -15,9 +15,12 @@
 package flags
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // newSUT creates and returns a new "system under test" instance.
@@ -41,6 +44,9 @@ func TestNewAllFlags(t *testing.T) {
 			"-cd=" + expectedConfigDirectory,
 			"-cf=" + expectedFileName,
 			"-cc=" + expectedCommonConfig,
+			"--validate",
+			"-sk=core-data-2",
+			"--configPrefix=site-a/edgex/v3",
 		},
 	)
 
@@ -50,6 +56,9 @@ func TestNewAllFlags(t *testing.T) {
 	assert.Equal(t, expectedConfigDirectory, actual.ConfigDirectory())
 	assert.Equal(t, expectedFileName, actual.ConfigFileName())
 	assert.Equal(t, expectedCommonConfig, actual.CommonConfig())
+	assert.True(t, actual.Validate())
+	assert.Equal(t, "core-data-2", actual.ServiceKey())
+	assert.Equal(t, "site-a/edgex/v3", actual.ConfigPrefix())
 }
 
 func TestNewDefaultsNoFlags(t *testing.T) {
@@ -62,6 +71,9 @@ func TestNewDefaultsNoFlags(t *testing.T) {
 	assert.Equal(t, "", actual.ConfigDirectory())
 	assert.Equal(t, DefaultConfigFile, actual.ConfigFileName())
 	assert.Equal(t, "", actual.CommonConfig())
+	assert.False(t, actual.Validate())
+	assert.Equal(t, "", actual.ServiceKey())
+	assert.Equal(t, "", actual.ConfigPrefix())
 }
 
 func TestNewDefaultForCP(t *testing.T) {
@@ -123,3 +135,52 @@ func TestDefault_RemoteServiceHosts(t *testing.T) {
 
 	assert.Equal(t, expected, actual.RemoteServiceHosts())
 }
+
+func TestOverrideConfigFile(t *testing.T) {
+	expectedOverrideConfigFile := "./res/override.yaml"
+
+	assert.Equal(t, "", newSUT([]string{}).OverrideConfigFile())
+	assert.Equal(t, expectedOverrideConfigFile, newSUT([]string{"-ocf=" + expectedOverrideConfigFile}).OverrideConfigFile())
+	assert.Equal(t, expectedOverrideConfigFile, newSUT([]string{"--overrideConfigFile", expectedOverrideConfigFile}).OverrideConfigFile())
+}
+
+func TestArgsFile(t *testing.T) {
+	argsFile := filepath.Join(t.TempDir(), "service.args")
+	contents := `
+# Flags for the service's container
+-cp=consul.http://edgex-core-consul:8500
+--registry
+
+-cd /res
+  -cf   docker.yaml
+-p=docker
+`
+	require.NoError(t, os.WriteFile(argsFile, []byte(contents), 0600))
+
+	actual := newSUT([]string{"-o", "@" + argsFile, "-p=sharded"})
+
+	assert.True(t, actual.OverwriteConfig())
+	assert.Equal(t, "consul.http://edgex-core-consul:8500", actual.ConfigProviderUrl())
+	assert.True(t, actual.UseRegistry())
+	assert.Equal(t, "/res", actual.ConfigDirectory())
+	assert.Equal(t, "docker.yaml", actual.ConfigFileName())
+	// Flags following the args file take precedence over the file's flags
+	assert.Equal(t, "sharded", actual.Profile())
+
+	// The file's flags take precedence over the flags preceding the args file
+	actual = newSUT([]string{"-cf=configuration.toml", "@" + argsFile})
+	assert.Equal(t, "docker.yaml", actual.ConfigFileName())
+	assert.Equal(t, "docker", actual.Profile())
+}
+
+func TestExpandArgsFiles(t *testing.T) {
+	argsFile := filepath.Join(t.TempDir(), "service.args")
+	require.NoError(t, os.WriteFile(argsFile, []byte("-cp\n# -r\n\n--configDir /res\n"), 0600))
+
+	actual, err := expandArgsFiles([]string{"-o", "@" + argsFile, "@"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-o", "-cp", "--configDir", "/res", "@"}, actual)
+
+	_, err = expandArgsFiles([]string{"@" + filepath.Join(t.TempDir(), "missing.args")})
+	assert.Error(t, err)
+}
@@ -18,6 +18,7 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,14 +30,26 @@ import (
 	"github.com/edgexfoundry/go-mod-registry/v3/pkg/types"
 	"github.com/edgexfoundry/go-mod-registry/v3/registry"
 
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/circuitbreaker"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/loadbalance"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/tracing"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/zerotrust"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 )
 
+const (
+	// CircuitBreakerStateMetricName is the name the state Gauges of the clients' circuit breakers are reported under,
+	// with the value 0 when closed, 1 when half-open and 2 when open. Each client's Gauge is registered with the name
+	// suffixed with the client's service key and is distinguished by its tag.
+	CircuitBreakerStateMetricName = "CircuitBreakerState"
+	circuitBreakerClientTagKey    = "client"
+)
+
 // ClientsBootstrap contains data to boostrap the configured clients
 type ClientsBootstrap struct {
 	registry registry.Client
@@ -52,8 +65,8 @@ func NewClientsBootstrap() *ClientsBootstrap {
 // If the registry is enabled it will be used to get the URL for client otherwise it will use configuration for the url.
 // This handler will fail if an unknown client is specified.
 func (cb *ClientsBootstrap) BootstrapHandler(
-	_ context.Context,
-	_ *sync.WaitGroup,
+	ctx context.Context,
+	wg *sync.WaitGroup,
 	startupTimer startup.Timer,
 	dic *di.Container) bool {
 
@@ -62,21 +75,34 @@ func (cb *ClientsBootstrap) BootstrapHandler(
 	cb.registry = container.RegistryFrom(dic.Get)
 
 	if cfg.GetBootstrap().Clients != nil {
+		// The URLs the clients resolved to are kept for the startup summary
+		clientUrls := make(map[string]string)
+		defer dic.Update(di.ServiceConstructorMap{
+			container.ClientUrlsName: func(get di.Get) interface{} {
+				return clientUrls
+			},
+		})
+
 		for serviceKey, serviceInfo := range *cfg.GetBootstrap().Clients {
 			var url string
 			var err error
 
 			sp := container.SecretProviderExtFrom(dic.Get)
-			jwtSecretProvider := secret.NewJWTSecretProvider(sp)
 			if serviceInfo.SecurityOptions[config.SecurityModeKey] == zerotrust.ZeroTrustMode {
 				sp.EnableZeroTrust()
 			}
-			if rt, transpErr := zerotrust.HttpTransportFromClient(sp, serviceInfo, lc); transpErr != nil {
+			rt, transpErr := zerotrust.HttpTransportFromClientService(sp, serviceKey, serviceInfo, lc)
+			if transpErr != nil {
 				lc.Errorf("could not obtain an http client for use with zero trust provider: %v", transpErr)
 				return false
-			} else {
-				sp.SetHttpTransport(rt) //only need to set the transport when using SecretProviderExt
 			}
+			rt, err = newClientTransport(rt, serviceKey, serviceInfo)
+			if err != nil {
+				lc.Error(err.Error())
+				return false
+			}
+			// Each client keeps its own transport as with zero trust it dials its own service
+			jwtSecretProvider := secret.NewJWTSecretProviderWithRT(sp, rt)
 
 			if !serviceInfo.UseMessageBus {
 				url, err = cb.getClientUrl(serviceKey, serviceInfo.Url(), startupTimer, dic, lc)
@@ -84,6 +110,28 @@ func (cb *ClientsBootstrap) BootstrapHandler(
 					lc.Error(err.Error())
 					return false
 				}
+				clientUrls[serviceKey] = url
+
+				if len(serviceInfo.LoadBalancePolicy) > 0 {
+					balancer, err := cb.startLoadBalancer(ctx, wg, serviceKey, serviceInfo.LoadBalancePolicy, serviceInfo.InstanceRefreshInterval, dic, lc)
+					if err != nil {
+						lc.Error(err.Error())
+						return false
+					}
+					if balancer != nil {
+						jwtSecretProvider = loadbalance.NewAuthenticationInjector(jwtSecretProvider, balancer)
+					}
+				}
+
+				breaker, err := cb.newCircuitBreaker(serviceKey, serviceInfo.CircuitBreaker, dic, lc)
+				if err != nil {
+					lc.Error(err.Error())
+					return false
+				}
+				if breaker != nil {
+					jwtSecretProvider = circuitbreaker.NewAuthenticationInjector(jwtSecretProvider, breaker)
+				}
+				jwtSecretProvider = tracing.NewAuthenticationInjector(jwtSecretProvider)
 			}
 
 			switch serviceKey {
@@ -207,3 +255,105 @@ func (cb *ClientsBootstrap) getClientUrl(serviceKey string, defaultUrl string, s
 
 	return url, nil
 }
+
+// startLoadBalancer starts the load balancing of the client's requests across the service's healthy instances in the
+// Registry. Returns nil when the Registry isn't used, in which case the requests all go to the configured URL.
+func (cb *ClientsBootstrap) startLoadBalancer(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	serviceKey string,
+	policy string,
+	instanceRefreshInterval string,
+	dic *di.Container,
+	lc logger.LoggingClient) (*loadbalance.Balancer, error) {
+	mode := container.DevRemoteModeFrom(dic.Get)
+	if cb.registry == nil || mode.InDevMode || mode.InRemoteMode {
+		return nil, nil
+	}
+
+	refreshInterval := loadbalance.DefaultRefreshInterval
+	if len(instanceRefreshInterval) > 0 {
+		var err error
+		refreshInterval, err = time.ParseDuration(instanceRefreshInterval)
+		if err != nil || refreshInterval <= 0 {
+			return nil, fmt.Errorf("InstanceRefreshInterval '%s' for '%s' is invalid", instanceRefreshInterval, serviceKey)
+		}
+	}
+
+	// Registries which can't list the instances, i.e. Kubernetes, resolve the one endpoint which balances itself
+	getInstances := func() ([]types.ServiceEndpoint, error) {
+		endpoint, err := cb.registry.GetServiceEndpoint(serviceKey)
+		if err != nil {
+			return nil, err
+		}
+		return []types.ServiceEndpoint{endpoint}, nil
+	}
+	if registryInstances := container.RegistryInstancesFrom(dic.Get); registryInstances != nil {
+		getInstances = func() ([]types.ServiceEndpoint, error) {
+			return registryInstances.HealthyInstances(serviceKey)
+		}
+	}
+
+	balancer, err := loadbalance.NewBalancer(serviceKey, policy, getInstances, lc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := balancer.Refresh(); err != nil {
+		return nil, err
+	}
+
+	balancer.Start(ctx, wg, refreshInterval)
+
+	lc.Infof("Using '%s' load balancing for '%s' clients", policy, serviceKey)
+	return balancer, nil
+}
+
+// newCircuitBreaker creates the client's circuit breaker and registers the metric reporting its state, so the state is
+// reported with the service's other metrics when the Metrics Manager has been bootstrapped. Returns nil when neither
+// the circuit breaker nor the retries are configured.
+func (cb *ClientsBootstrap) newCircuitBreaker(
+	serviceKey string,
+	breakerInfo bootstrapConfig.CircuitBreakerInfo,
+	dic *di.Container,
+	lc logger.LoggingClient) (*circuitbreaker.Breaker, error) {
+	if breakerInfo.FailureThreshold == 0 && breakerInfo.Retries == 0 {
+		return nil, nil
+	}
+
+	var cooldown, retryInterval time.Duration
+	var err error
+	if len(breakerInfo.Cooldown) > 0 {
+		cooldown, err = time.ParseDuration(breakerInfo.Cooldown)
+		if err != nil || cooldown <= 0 {
+			return nil, fmt.Errorf("CircuitBreaker Cooldown '%s' for '%s' is invalid", breakerInfo.Cooldown, serviceKey)
+		}
+	}
+	if len(breakerInfo.RetryInterval) > 0 {
+		retryInterval, err = time.ParseDuration(breakerInfo.RetryInterval)
+		if err != nil || retryInterval < 0 {
+			return nil, fmt.Errorf("CircuitBreaker RetryInterval '%s' for '%s' is invalid", breakerInfo.RetryInterval, serviceKey)
+		}
+	}
+
+	breaker, err := circuitbreaker.NewBreaker(serviceKey, breakerInfo.FailureThreshold, cooldown, breakerInfo.Retries, retryInterval, lc)
+	if err != nil {
+		return nil, err
+	}
+
+	manager := container.MetricsManagerFrom(dic.Get)
+	if manager == nil {
+		lc.Debugf("Metrics Manager not available, the state of the circuit breaker for '%s' isn't reported", serviceKey)
+		return breaker, nil
+	}
+
+	name := CircuitBreakerStateMetricName + "_" + strings.Trim(unsafeMetricNameRegex.ReplaceAllString(serviceKey, "_"), "_")
+	if err = manager.RegisterGaugeFunc(name, func() int64 { return int64(breaker.State()) },
+		map[string]string{circuitBreakerClientTagKey: serviceKey}); err != nil {
+		lc.Warnf("unable to register circuit breaker state metric '%s': %s", name, err.Error())
+		return breaker, nil
+	}
+	manager.SetMetricEnabled(CircuitBreakerStateMetricName, true)
+
+	return breaker, nil
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	loggerMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger/mocks"
@@ -30,8 +31,10 @@ import (
 	registryMocks "github.com/edgexfoundry/go-mod-registry/v3/registry/mocks"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/circuitbreaker"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/metrics"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -365,3 +368,57 @@ func TestCommandMessagingClientErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestClientsBootstrap_NewCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		Name            string
+		BreakerInfo     config.CircuitBreakerInfo
+		WithManager     bool
+		ExpectedBreaker bool
+		ExpectError     bool
+	}{
+		{"Not configured", config.CircuitBreakerInfo{}, true, false, false},
+		{"Circuit breaker", config.CircuitBreakerInfo{FailureThreshold: 3, Cooldown: "10s"}, true, true, false},
+		{"Retries only", config.CircuitBreakerInfo{Retries: 2, RetryInterval: "100ms"}, true, true, false},
+		{"No Metrics Manager", config.CircuitBreakerInfo{FailureThreshold: 3}, false, true, false},
+		{"Invalid Cooldown", config.CircuitBreakerInfo{FailureThreshold: 3, Cooldown: "ten seconds"}, true, false, true},
+		{"Invalid RetryInterval", config.CircuitBreakerInfo{Retries: 2, RetryInterval: "-1s"}, true, false, true},
+		{"Invalid FailureThreshold", config.CircuitBreakerInfo{FailureThreshold: -1}, true, false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			lc := logger.NewMockClient()
+			manager := metrics.NewManager(lc, time.Second, metrics.NewNullReporter())
+			dic := di.NewContainer(di.ServiceConstructorMap{})
+			if test.WithManager {
+				dic.Update(di.ServiceConstructorMap{
+					container.MetricsManagerInterfaceName: func(get di.Get) interface{} {
+						return manager
+					},
+				})
+			}
+
+			breaker, err := NewClientsBootstrap().newCircuitBreaker(common.CoreDataServiceKey, test.BreakerInfo, dic, lc)
+			if test.ExpectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if !test.ExpectedBreaker {
+				assert.Nil(t, breaker)
+				return
+			}
+
+			require.NotNil(t, breaker)
+			name := CircuitBreakerStateMetricName + "_core_data"
+			assert.Equal(t, test.WithManager, manager.IsRegistered(name))
+			if test.WithManager {
+				gauge := manager.GetGauge(name)
+				require.NotNil(t, gauge)
+				assert.Equal(t, int64(circuitbreaker.StateClosed), gauge.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+const (
+	// DefaultClientConnectTimeout is how long the clients wait for the connection to the service when the client's
+	// ConnectTimeout isn't set
+	DefaultClientConnectTimeout = time.Second * 5
+	// DefaultClientResponseHeaderTimeout is how long the clients wait for the service's response headers when the
+	// client's ResponseHeaderTimeout isn't set
+	DefaultClientResponseHeaderTimeout = time.Second * 30
+	// DefaultClientTimeout is the overall time limit of the clients' requests when the client's Timeout isn't set
+	DefaultClientTimeout = time.Second * 60
+)
+
+// clientTimeouts are the parsed timeouts of a client's requests
+type clientTimeouts struct {
+	connect        time.Duration
+	responseHeader time.Duration
+	overall        time.Duration
+}
+
+func parseClientTimeouts(serviceKey string, clientInfo *config.ClientInfo) (clientTimeouts, error) {
+	timeouts := clientTimeouts{
+		connect:        DefaultClientConnectTimeout,
+		responseHeader: DefaultClientResponseHeaderTimeout,
+		overall:        DefaultClientTimeout,
+	}
+
+	for _, setting := range []struct {
+		name   string
+		value  string
+		target *time.Duration
+	}{
+		{"ConnectTimeout", clientInfo.ConnectTimeout, &timeouts.connect},
+		{"ResponseHeaderTimeout", clientInfo.ResponseHeaderTimeout, &timeouts.responseHeader},
+		{"Timeout", clientInfo.Timeout, &timeouts.overall},
+	} {
+		if len(setting.value) == 0 {
+			continue
+		}
+
+		timeout, err := time.ParseDuration(setting.value)
+		if err != nil || timeout <= 0 {
+			return timeouts, fmt.Errorf("%s '%s' for '%s' is invalid", setting.name, setting.value, serviceKey)
+		}
+		*setting.target = timeout
+	}
+
+	return timeouts, nil
+}
+
+// newClientTransport returns the transport which limits the time taken by the client's requests to the service. The
+// connect and response header timeouts only apply when the transport is a http.Transport, and the connect timeout
+// only when its dialer respects the context, which the zero trust dialer doesn't. The overall timeout always applies.
+func newClientTransport(transport http.RoundTripper, serviceKey string, clientInfo *config.ClientInfo) (http.RoundTripper, error) {
+	timeouts, err := parseClientTimeouts(serviceKey, clientInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpTransport, ok := transport.(*http.Transport); ok {
+		// Cloned so the timeouts don't change the transport shared with the other clients, i.e. http.DefaultTransport
+		httpTransport = httpTransport.Clone()
+
+		dial := httpTransport.DialContext
+		if dial == nil {
+			dial = (&net.Dialer{}).DialContext
+		}
+		httpTransport.DialContext = func(ctx context.Context, network string, addr string) (net.Conn, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeouts.connect)
+			defer cancel()
+			return dial(ctx, network, addr)
+		}
+		httpTransport.ResponseHeaderTimeout = timeouts.responseHeader
+
+		transport = httpTransport
+	}
+
+	return &timeoutTransport{next: transport, timeout: timeouts.overall}, nil
+}
+
+// timeoutTransport cancels the request once the timeout has elapsed, including while the response body is read
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *timeoutTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(request.Context(), t.timeout)
+
+	response, err := t.next.RoundTrip(request.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The context is cancelled once the body has been read and closed, so the body isn't cut off
+	response.Body = &cancelOnCloseBody{ReadCloser: response.Body, cancel: cancel}
+	return response, nil
+}
+
+// cancelOnCloseBody is a response body which cancels the request's context once closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,182 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	clients "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+func TestParseClientTimeouts(t *testing.T) {
+	tests := []struct {
+		Name        string
+		ClientInfo  config.ClientInfo
+		Expected    clientTimeouts
+		ExpectError bool
+	}{
+		{"Defaults", config.ClientInfo{},
+			clientTimeouts{DefaultClientConnectTimeout, DefaultClientResponseHeaderTimeout, DefaultClientTimeout}, false},
+		{"Set", config.ClientInfo{ConnectTimeout: "1s", ResponseHeaderTimeout: "2s", Timeout: "3s"},
+			clientTimeouts{time.Second, time.Second * 2, time.Second * 3}, false},
+		{"Partially set", config.ClientInfo{Timeout: "10s"},
+			clientTimeouts{DefaultClientConnectTimeout, DefaultClientResponseHeaderTimeout, time.Second * 10}, false},
+		{"Invalid", config.ClientInfo{ConnectTimeout: "five seconds"}, clientTimeouts{}, true},
+		{"Zero", config.ClientInfo{ResponseHeaderTimeout: "0s"}, clientTimeouts{}, true},
+		{"Negative", config.ClientInfo{Timeout: "-1s"}, clientTimeouts{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			actual, err := parseClientTimeouts(common.CoreDataServiceKey, &test.ClientInfo)
+			if test.ExpectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.Expected, actual)
+		})
+	}
+}
+
+func TestNewClientTransport(t *testing.T) {
+	// The server stalls until the client gives up, so the test doesn't wait for it to respond
+	stall := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second * 5):
+		}
+	}
+
+	tests := []struct {
+		Name        string
+		ClientInfo  config.ClientInfo
+		Handler     http.HandlerFunc
+		ExpectError bool
+	}{
+		{"Responds in time", config.ClientInfo{Timeout: "1s"}, nil, false},
+		{"Overall timeout", config.ClientInfo{Timeout: "100ms"}, stall, true},
+		{"Overall timeout reading body", config.ClientInfo{Timeout: "100ms"}, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(common.ContentType, common.ContentTypeJSON)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("{"))
+			w.(http.Flusher).Flush()
+			stall(w, r)
+		}, true},
+		{"Response header timeout", config.ClientInfo{ResponseHeaderTimeout: "100ms", Timeout: "10s"}, stall, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			handler := test.Handler
+			if handler == nil {
+				handler = func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set(common.ContentType, common.ContentTypeJSON)
+					_ = json.NewEncoder(w).Encode(commonDTO.NewVersionResponse("3.1.0", common.CoreDataServiceKey))
+				}
+			}
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			transport, err := newClientTransport(http.DefaultTransport, common.CoreDataServiceKey, &test.ClientInfo)
+			require.NoError(t, err)
+
+			secretProviderMock := &mocks.SecretProviderExt{}
+			secretProviderMock.On("SetHttpTransport", mock.Anything).Return()
+			secretProviderMock.On("GetSelfJWT").Return("", nil)
+			client := clients.NewCommonClient(server.URL, secret.NewJWTSecretProviderWithRT(secretProviderMock, transport))
+
+			start := time.Now()
+			response, edgexErr := client.Version(context.Background())
+			elapsed := time.Since(start)
+
+			if !test.ExpectError {
+				require.NoError(t, edgexErr)
+				assert.Equal(t, "3.1.0", response.Version)
+				return
+			}
+
+			require.Error(t, edgexErr)
+			assert.GreaterOrEqual(t, elapsed, time.Millisecond*100)
+			assert.Less(t, elapsed, time.Second*2, "client did not abort at the configured timeout")
+		})
+	}
+}
+
+func TestNewClientTransport_DefaultTransportUnchanged(t *testing.T) {
+	defaultTransport := http.DefaultTransport.(*http.Transport)
+	responseHeaderTimeout := defaultTransport.ResponseHeaderTimeout
+
+	transport, err := newClientTransport(defaultTransport, common.CoreDataServiceKey, &config.ClientInfo{ResponseHeaderTimeout: "1s"})
+	require.NoError(t, err)
+	assert.Equal(t, responseHeaderTimeout, defaultTransport.ResponseHeaderTimeout)
+
+	timeoutTransport, ok := transport.(*timeoutTransport)
+	require.True(t, ok)
+	httpTransport, ok := timeoutTransport.next.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, time.Second, httpTransport.ResponseHeaderTimeout)
+}
+
+func TestTimeoutTransport_CancelledOnClose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("pong"))
+	}))
+	defer server.Close()
+
+	var requestCtx context.Context
+	transport := &timeoutTransport{
+		next: roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+			requestCtx = request.Context()
+			return http.DefaultTransport.RoundTrip(request)
+		}),
+		timeout: time.Minute,
+	}
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	response, err := transport.RoundTrip(request)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "pong", string(body))
+	assert.NoError(t, requestCtx.Err())
+
+	require.NoError(t, response.Body.Close())
+	assert.ErrorIs(t, requestCtx.Err(), context.Canceled)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
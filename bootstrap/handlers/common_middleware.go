@@ -15,26 +15,28 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/correlation"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/tracing"
 )
 
 func ManageHeader(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		r := c.Request()
-		correlationID := r.Header.Get(common.CorrelationHeader)
-		if correlationID == "" {
-			correlationID = uuid.New().String()
-		}
-		// lint:ignore SA1029 legacy
-		// nolint:staticcheck // See golangci-lint #741
-		ctx := context.WithValue(r.Context(), common.CorrelationHeader, correlationID)
+		ctx := correlation.NewContext(r.Context(), correlation.FromRequest(r))
 
 		contentType := r.Header.Get(common.ContentType)
 		// lint:ignore SA1029 legacy
 		// nolint:staticcheck // See golangci-lint #741
 		ctx = context.WithValue(ctx, common.ContentType, contentType)
 
+		if tc, ok := tracing.FromRequest(r); ok {
+			ctx = tracing.NewContext(ctx, tc)
+			// The EdgeX clients only carry the correlation id of the requests they send on behalf of this one
+			defer tracing.Track(correlation.FromContext(ctx), tc)()
+		}
+
 		c.SetRequest(r.WithContext(ctx))
 
 		return next(c)
@@ -47,14 +49,14 @@ func LoggingMiddleware(lc logger.LoggingClient) echo.MiddlewareFunc {
 			if lc.LogLevel() == models.TraceLog {
 				r := c.Request()
 				begin := time.Now()
-				correlationId := FromContext(r.Context())
-				lc.Trace("Begin request", common.CorrelationHeader, correlationId, "path", r.URL.Path)
+				requestLc := correlation.LoggingClient(r.Context(), lc)
+				requestLc.Trace("Begin request", "path", r.URL.Path)
 				err := next(c)
 				if err != nil {
-					lc.Errorf("failed to add the middleware: %v", err)
+					requestLc.Errorf("failed to add the middleware: %v", err)
 					return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 				}
-				lc.Trace("Response complete", common.CorrelationHeader, correlationId, "duration", time.Since(begin).String())
+				requestLc.Trace("Response complete", "duration", time.Since(begin).String())
 				return nil
 			}
 			return next(c)
@@ -86,10 +88,7 @@ func UrlDecodeMiddleware(lc logger.LoggingClient) echo.MiddlewareFunc {
 	}
 }
 
+// FromContext returns the correlation id held by the context, see correlation.FromContext
 func FromContext(ctx context.Context) string {
-	hdr, ok := ctx.Value(common.CorrelationHeader).(string)
-	if !ok {
-		hdr = ""
-	}
-	return hdr
+	return correlation.FromContext(ctx)
 }
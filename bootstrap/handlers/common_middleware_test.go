@@ -11,6 +11,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	clients "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger/mocks"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -18,6 +19,11 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/tracing"
 )
 
 var expectedCorrelationId = "927e91d3-864c-4c26-852d-b68c39492d14"
@@ -48,6 +54,65 @@ func TestManageHeader(t *testing.T) {
 	assert.Equal(t, expectedContentType, res.Header().Get(common.ContentType))
 }
 
+func TestManageHeader_TraceContext(t *testing.T) {
+	expectedTraceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	expectedTracestate := "congo=t61rcWkgMzE"
+
+	tests := []struct {
+		name        string
+		traceparent string
+		tracestate  string
+	}{
+		{"with trace context", expectedTraceparent, expectedTracestate},
+		{"without trace context", "", ""},
+		{"invalid traceparent", "00-00000000000000000000000000000000-00f067aa0ba902b7-01", expectedTracestate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var outbound http.Header
+			downstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				outbound = r.Header.Clone()
+				w.Header().Set(common.ContentType, common.ContentTypeJSON)
+				_, _ = w.Write([]byte(`{"apiVersion":"v3","statusCode":200}`))
+			}))
+			defer downstream.Close()
+
+			secretProviderMock := &bootstrapMocks.SecretProviderExt{}
+			secretProviderMock.On("SetHttpTransport", mock.Anything).Return()
+			secretProviderMock.On("GetSelfJWT").Return("", nil)
+			injector := tracing.NewAuthenticationInjector(secret.NewJWTSecretProviderWithRT(secretProviderMock, &http.Transport{}))
+			client := clients.NewCommonClient(downstream.URL, injector)
+
+			e := echo.New()
+			e.GET("/", func(c echo.Context) error {
+				if _, err := client.Ping(c.Request().Context()); err != nil {
+					return err
+				}
+				return c.NoContent(http.StatusOK)
+			})
+			e.Use(ManageHeader)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if len(tt.traceparent) > 0 {
+				req.Header.Set(tracing.TraceparentHeader, tt.traceparent)
+				req.Header.Set(tracing.TracestateHeader, tt.tracestate)
+			}
+			res := httptest.NewRecorder()
+			e.ServeHTTP(res, req)
+
+			require.Equal(t, http.StatusOK, res.Code)
+			require.NotNil(t, outbound)
+			if tt.traceparent == expectedTraceparent {
+				assert.Equal(t, expectedTraceparent, outbound.Get(tracing.TraceparentHeader))
+				assert.Equal(t, expectedTracestate, outbound.Get(tracing.TracestateHeader))
+			} else {
+				assert.Empty(t, outbound.Get(tracing.TraceparentHeader))
+				assert.Empty(t, outbound.Get(tracing.TracestateHeader))
+			}
+		})
+	}
+}
+
 func TestLoggingMiddleware(t *testing.T) {
 	e := echo.New()
 	e.GET("/", handler)
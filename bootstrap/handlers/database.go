@@ -0,0 +1,76 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// DatabasesBootstrap contains data to boostrap the credentials for the configured named databases
+type DatabasesBootstrap struct {
+	credentialsProvider interfaces.CredentialsProvider
+}
+
+// NewDatabasesBootstrap is a factory method that returns the initialized "DatabasesBootstrap" receiver struct.
+func NewDatabasesBootstrap(credentialsProvider interfaces.CredentialsProvider) *DatabasesBootstrap {
+	return &DatabasesBootstrap{
+		credentialsProvider: credentialsProvider,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract.
+// It resolves the credentials for each of the named databases in the service's configuration and places them in the
+// DIC under the name derived from the database's key, which are retrieved using container.CredentialsFromName.
+// This handler will fail if the credentials for any of the databases can not be resolved.
+func (db *DatabasesBootstrap) BootstrapHandler(
+	_ context.Context,
+	_ *sync.WaitGroup,
+	startupTimer startup.Timer,
+	dic *di.Container) bool {
+
+	lc := container.LoggingClientFrom(dic.Get)
+	cfg := container.ConfigurationFrom(dic.Get)
+
+	for name, database := range cfg.GetBootstrap().Databases {
+		for startupTimer.HasNotElapsed() {
+			credentials, err := db.credentialsProvider.GetDatabaseCredentials(database)
+			if err == nil {
+				dic.Update(di.ServiceConstructorMap{
+					container.CredentialsName(name): func(get di.Get) interface{} {
+						return &credentials
+					},
+				})
+				lc.Infof("Credentials for '%s' database resolved", name)
+				break
+			}
+
+			lc.Warnf("couldn't resolve credentials for '%s' database: %s", name, err.Error())
+			startupTimer.SleepForInterval()
+		}
+
+		if container.CredentialsFromName(dic.Get, name) == nil {
+			lc.Errorf("unable to resolve credentials for '%s' database in allotted time", name)
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,92 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+func TestDatabasesBootstrapHandler(t *testing.T) {
+	primary := config.Database{Type: "postgres", Host: "primary", Port: 5432, Name: "edgex/primary"}
+	replica := config.Database{Type: "postgres", Host: "replica", Port: 5432, Name: "edgex/replica"}
+	primaryCredentials := config.Credentials{Username: "primary-user", Password: "primary-password"}
+	replicaCredentials := config.Credentials{Username: "replica-user", Password: "replica-password"}
+
+	tests := []struct {
+		Name           string
+		ReplicaError   error
+		ExpectedResult bool
+	}{
+		{"Valid", nil, true},
+		{"Replica credentials error", errors.New("secret not found"), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			bootstrapConfig := config.BootstrapConfiguration{
+				Databases: map[string]config.Database{
+					"primary": primary,
+					"replica": replica,
+				},
+			}
+
+			configMock := &mocks.Configuration{}
+			configMock.On("GetBootstrap").Return(bootstrapConfig)
+
+			providerMock := &mocks.CredentialsProvider{}
+			providerMock.On("GetDatabaseCredentials", primary).Return(primaryCredentials, nil)
+			providerMock.On("GetDatabaseCredentials", replica).Return(replicaCredentials, test.ReplicaError)
+
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+				container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+					return configMock
+				},
+			})
+
+			startupTimer := startup.NewTimer(1, 1)
+			actualResult := NewDatabasesBootstrap(providerMock).BootstrapHandler(context.Background(), &sync.WaitGroup{}, startupTimer, dic)
+			require.Equal(t, test.ExpectedResult, actualResult)
+			if !test.ExpectedResult {
+				return
+			}
+
+			actualPrimary := container.CredentialsFromName(dic.Get, "primary")
+			require.NotNil(t, actualPrimary)
+			assert.Equal(t, primaryCredentials, *actualPrimary)
+
+			actualReplica := container.CredentialsFromName(dic.Get, "replica")
+			require.NotNil(t, actualReplica)
+			assert.Equal(t, replicaCredentials, *actualReplica)
+
+			assert.Nil(t, container.CredentialsFromName(dic.Get, "unknown"))
+		})
+	}
+}
@@ -0,0 +1,197 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	clients "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/zerotrust"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// developmentVersion is the version reported by services built without a version, which is never rejected
+const developmentVersion = "0.0.0"
+
+// DependencyVersions contains data to check the versions of the services the service depends on
+type DependencyVersions struct {
+}
+
+// NewDependencyVersions is a factory method that returns the initialized DependencyVersions receiver struct.
+func NewDependencyVersions() *DependencyVersions {
+	return &DependencyVersions{}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract.
+// It queries the version endpoint of each of the clients in the service's configuration which has a MinimumVersion
+// or VersionConstraint set, and fails if the service's version is older or doesn't satisfy the constraint. The check is retried until the startup timer elapses, so the
+// service waits for its dependencies to be upgraded. Clients which use the MessageBus are not checked.
+func (d *DependencyVersions) BootstrapHandler(
+	ctx context.Context,
+	_ *sync.WaitGroup,
+	startupTimer startup.Timer,
+	dic *di.Container) bool {
+
+	lc := container.LoggingClientFrom(dic.Get)
+	cfg := container.ConfigurationFrom(dic.Get)
+
+	if cfg.GetBootstrap().Clients == nil {
+		return true
+	}
+
+	serviceKeys := make([]string, 0, len(*cfg.GetBootstrap().Clients))
+	for serviceKey, serviceInfo := range *cfg.GetBootstrap().Clients {
+		if len(serviceInfo.MinimumVersion) > 0 || len(serviceInfo.VersionConstraint) > 0 {
+			serviceKeys = append(serviceKeys, serviceKey)
+		}
+	}
+	sort.Strings(serviceKeys)
+
+	for _, serviceKey := range serviceKeys {
+		serviceInfo := (*cfg.GetBootstrap().Clients)[serviceKey]
+		if serviceInfo.UseMessageBus {
+			lc.Warnf("unable to check the version of '%s' as its client uses the MessageBus", serviceKey)
+			continue
+		}
+
+		constraint, err := dependencyVersionConstraint(serviceInfo)
+		if err != nil {
+			lc.Errorf("version constraint for '%s' is invalid: %s", serviceKey, err.Error())
+			return false
+		}
+
+		client, err := d.commonClient(serviceKey, serviceInfo, startupTimer, dic, lc)
+		if err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+
+		if err = checkDependencyVersion(ctx, client, serviceKey, constraint, startupTimer, lc); err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d *DependencyVersions) commonClient(
+	serviceKey string,
+	serviceInfo *config.ClientInfo,
+	startupTimer startup.Timer,
+	dic *di.Container,
+	lc logger.LoggingClient) (interfaces.CommonClient, error) {
+	sp := container.SecretProviderExtFrom(dic.Get)
+	rt, err := zerotrust.HttpTransportFromClientService(sp, serviceKey, serviceInfo, lc)
+	if err != nil {
+		return nil, fmt.Errorf("could not obtain an http client for use with zero trust provider: %v", err)
+	}
+	rt, err = newClientTransport(rt, serviceKey, serviceInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	cb := &ClientsBootstrap{registry: container.RegistryFrom(dic.Get)}
+	url, err := cb.getClientUrl(serviceKey, serviceInfo.Url(), startupTimer, dic, lc)
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.NewCommonClient(url, secret.NewJWTSecretProviderWithRT(sp, rt)), nil
+}
+
+// dependencyVersionConstraint returns the constraint the service's version must satisfy, which is the client's
+// VersionConstraint further limited to the MinimumVersion when both are set.
+func dependencyVersionConstraint(serviceInfo *config.ClientInfo) (versionConstraint, error) {
+	expression := serviceInfo.VersionConstraint
+	if len(serviceInfo.MinimumVersion) > 0 {
+		if _, err := parseVersion(serviceInfo.MinimumVersion); err != nil {
+			return nil, fmt.Errorf("MinimumVersion is invalid: %s", err.Error())
+		}
+
+		if len(expression) == 0 {
+			expression = ">=" + serviceInfo.MinimumVersion
+		} else {
+			// The minimum version applies to each of the alternatives of the constraint
+			alternatives := strings.Split(expression, "||")
+			for index, alternative := range alternatives {
+				alternatives[index] = strings.TrimSpace(alternative) + " >=" + serviceInfo.MinimumVersion
+			}
+			expression = strings.Join(alternatives, " || ")
+		}
+	}
+
+	return parseVersionConstraint(expression)
+}
+
+// checkDependencyVersion queries the version of the service until it satisfies the constraint or the startup timer
+// elapses, as the service may be unavailable or still be running its older version during an upgrade.
+func checkDependencyVersion(
+	ctx context.Context,
+	client interfaces.CommonClient,
+	serviceKey string,
+	constraint versionConstraint,
+	startupTimer startup.Timer,
+	lc logger.LoggingClient) error {
+	var err error
+
+	for startupTimer.HasNotElapsed() {
+		if err = validateDependencyVersion(ctx, client, constraint); err == nil {
+			lc.Infof("Version of '%s' satisfies the version constraint '%s'", serviceKey, constraint)
+			return nil
+		}
+
+		lc.Warnf("unable to validate the version of '%s': %s. retrying...", serviceKey, err.Error())
+		startupTimer.SleepForInterval()
+	}
+
+	if err == nil {
+		err = fmt.Errorf("startup timer elapsed")
+	}
+
+	return fmt.Errorf("unable to validate the version of '%s': %s. Giving up", serviceKey, err.Error())
+}
+
+func validateDependencyVersion(ctx context.Context, client interfaces.CommonClient, constraint versionConstraint) error {
+	response, edgexErr := client.Version(ctx)
+	if edgexErr != nil {
+		return edgexErr
+	}
+
+	if response.Version == developmentVersion {
+		return nil
+	}
+
+	version, err := parseVersion(response.Version)
+	if err != nil {
+		return err
+	}
+	if !constraint.isSatisfiedBy(version) {
+		return fmt.Errorf("version %s does not satisfy the version constraint '%s'", response.Version, constraint)
+	}
+
+	return nil
+}
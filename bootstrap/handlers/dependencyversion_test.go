@@ -0,0 +1,135 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+func TestDependencyVersions_BootstrapHandler(t *testing.T) {
+	tests := []struct {
+		Name              string
+		Version           string
+		MinimumVersion    string
+		VersionConstraint string
+		UseMessageBus     bool
+		ExpectedResult    bool
+	}{
+		{"Compatible", "3.1.0", "3.0.0", "", false, true},
+		{"Compatible same version", "3.1.0", "3.1.0", "", false, true},
+		{"Compatible development build", "0.0.0", "3.1.0", "", false, true},
+		{"Compatible with minimum version not set", "2.3.0", "", "", false, true},
+		{"Compatible in range", "2.5.1", "", ">=2.3.0 <3.0.0", false, true},
+		{"Compatible in range and above minimum", "2.5.1", "2.4.0", ">=2.3.0 <3.0.0", false, true},
+		{"Compatible pre-release in range", "3.1.0-dev.20", "", ">=3.1.0-dev.1 <3.1.0", false, true},
+		{"Not checked using MessageBus", "2.3.0", "3.1.0", "", true, true},
+		{"Incompatible", "2.3.0", "3.0.0", "", false, false},
+		{"Incompatible pre-release", "3.1.0-dev.20", "3.1.0", "", false, false},
+		{"Incompatible below range", "2.2.9", "", ">=2.3.0 <3.0.0", false, false},
+		{"Incompatible above range", "3.0.0", "", ">=2.3.0 <3.0.0", false, false},
+		{"Incompatible in range below minimum", "2.3.5", "2.4.0", ">=2.3.0 <3.0.0", false, false},
+		{"Incompatible pre-release below range", "3.1.0-dev.20", "", ">=3.1.0", false, false},
+		{"Invalid minimum version", "3.1.0", "three", "", false, false},
+		{"Invalid version constraint", "3.1.0", "", ">=2.3.0 <three", false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != common.ApiVersionRoute {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Set(common.ContentType, common.ContentTypeJSON)
+				_ = json.NewEncoder(w).Encode(commonDTO.NewVersionResponse(test.Version, common.CoreMetaDataServiceKey))
+			}))
+			defer server.Close()
+
+			dic := newDependencyVersionsContainer(t, server.URL, config.ClientInfo{
+				MinimumVersion:    test.MinimumVersion,
+				VersionConstraint: test.VersionConstraint,
+				UseMessageBus:     test.UseMessageBus,
+			})
+
+			actual := NewDependencyVersions().BootstrapHandler(context.Background(), &sync.WaitGroup{}, startup.NewTimer(1, 1), dic)
+			assert.Equal(t, test.ExpectedResult, actual)
+		})
+	}
+}
+
+func TestDependencyVersions_BootstrapHandler_Unavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	dic := newDependencyVersionsContainer(t, server.URL, config.ClientInfo{MinimumVersion: "3.0.0"})
+	assert.False(t, NewDependencyVersions().BootstrapHandler(context.Background(), &sync.WaitGroup{}, startup.NewTimer(1, 1), dic))
+}
+
+func newDependencyVersionsContainer(t *testing.T, serverUrl string, clientInfo config.ClientInfo) *di.Container {
+	parsed, err := url.Parse(serverUrl)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(parsed.Port())
+	require.NoError(t, err)
+
+	clientInfo.Host = parsed.Hostname()
+	clientInfo.Port = port
+	clientInfo.Protocol = parsed.Scheme
+
+	configMock := &mocks.Configuration{}
+	configMock.On("GetBootstrap").Return(config.BootstrapConfiguration{
+		Clients: &config.ClientsCollection{
+			common.CoreMetaDataServiceKey: &clientInfo,
+		},
+	})
+
+	secretProviderMock := &mocks.SecretProviderExt{}
+	secretProviderMock.On("IsZeroTrustEnabled").Return(false)
+	secretProviderMock.On("SetHttpTransport", mock.Anything).Return()
+	secretProviderMock.On("GetSelfJWT").Return("", nil)
+
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return configMock
+		},
+		container.SecretProviderExtName: func(get di.Get) interface{} {
+			return secretProviderMock
+		},
+	})
+}
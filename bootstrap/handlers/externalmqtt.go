@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -23,6 +24,10 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 )
 
+// ExternalMQTTReadinessCheckName is the name of the readiness check which fails while the external MQTT client is
+// not connected to the broker
+const ExternalMQTTReadinessCheckName = "ExternalMQTT"
+
 type ExternalMQTT struct {
 	onConnectHandler mqtt.OnConnectHandler
 }
@@ -148,6 +153,15 @@ func (e *ExternalMQTT) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup,
 				},
 			})
 
+			if readiness := container.ReadinessFrom(dic.Get); readiness != nil {
+				readiness.RegisterCheck(ExternalMQTTReadinessCheckName, func() error {
+					if !mqttClient.IsConnectionOpen() {
+						return errors.New("not connected to the external MQTT broker")
+					}
+					return nil
+				})
+			}
+
 			lc.Infof(
 				"Connected to external MQTT broker @ %s with AuthMode='%s'",
 				brokerConfig.Url,
@@ -0,0 +1,184 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/shutdown"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+const (
+	// GrpcTLSCertSecretKey is the key of the PEM encoded certificate in the gRPC server's TLS secret
+	GrpcTLSCertSecretKey = "cert"
+	// GrpcTLSKeySecretKey is the key of the PEM encoded private key in the gRPC server's TLS secret
+	GrpcTLSKeySecretKey = "key"
+)
+
+// GrpcServiceRegistration is called with the gRPC server before it starts serving so the service can register its
+// gRPC service implementations, i.e. pb.RegisterGreeterServer(server, &greeter{}).
+type GrpcServiceRegistration func(server *grpc.Server, dic *di.Container) error
+
+// GrpcServer contains references to dependencies required by the gRPC server implementation.
+type GrpcServer struct {
+	register  GrpcServiceRegistration
+	isRunning atomic.Bool
+}
+
+// NewGrpcServer is a factory method that returns an initialized GrpcServer receiver struct. The register callback
+// may be nil when the services are instead registered on the server from the DIC by a later bootstrap handler.
+func NewGrpcServer(register GrpcServiceRegistration) *GrpcServer {
+	return &GrpcServer{
+		register: register,
+	}
+}
+
+// IsRunning returns whether or not the gRPC server is running.
+func (b *GrpcServer) IsRunning() bool {
+	return b.isRunning.Load()
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It creates the gRPC server, using the TLS certificate from
+// the secret store when Service.GrpcServer.TLSSecretName is set, and adds it to the DIC. Once the services have been
+// registered it creates two go routines -- one that serves the gRPC requests and another that waits on closure of a
+// context's done channel before calling GracefulStop() to cleanly shut down the gRPC server.
+func (b *GrpcServer) BootstrapHandler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	_ startup.Timer,
+	dic *di.Container) bool {
+
+	lc := container.LoggingClientFrom(dic.Get)
+	serviceConfig := container.ConfigurationFrom(dic.Get).GetBootstrap().Service
+	grpcConfig := serviceConfig.GrpcServer
+
+	if grpcConfig.Port == 0 {
+		lc.Error("Service.GrpcServer.Port is missing from service's configuration")
+		return false
+	}
+
+	// The gRPC server binds to the same address as the HTTP server
+	host := serviceConfig.ServerBindAddr
+	if host == "" {
+		host = serviceConfig.Host
+	}
+	addr := net.JoinHostPort(host, strconv.Itoa(grpcConfig.Port))
+
+	var options []grpc.ServerOption
+	if len(grpcConfig.TLSSecretName) > 0 {
+		tlsConfig, err := serverTLSConfig(grpcConfig.TLSSecretName, container.SecretProviderFrom(dic.Get))
+		if err != nil {
+			lc.Errorf("unable to configure TLS for the gRPC server: %s", err.Error())
+			return false
+		}
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	server := grpc.NewServer(options...)
+
+	dic.Update(di.ServiceConstructorMap{
+		container.GrpcServerName: func(get di.Get) interface{} {
+			return server
+		},
+	})
+
+	if b.register != nil {
+		if err := b.register(server, dic); err != nil {
+			lc.Errorf("failed to register the gRPC services: %s", err.Error())
+			return false
+		}
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		lc.Errorf("gRPC server unable to listen at %s: %s", addr, err.Error())
+		return false
+	}
+
+	stopServer := func() {
+		server.GracefulStop()
+		lc.Info("gRPC server shut down")
+	}
+
+	// The server is stopped in priority order with the other resources when the shutdown registry is available
+	if shutdownRegistry := container.ShutdownRegistryFrom(dic.Get); shutdownRegistry != nil {
+		shutdownRegistry.Register("gRPC server", shutdown.PriorityServers, stopServer)
+	} else {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			<-ctx.Done()
+			stopServer()
+		}()
+	}
+
+	lc.Infof("gRPC server starting (%s)", addr)
+
+	wg.Add(1)
+	go func() {
+		defer func() {
+			b.isRunning.Store(false)
+			wg.Done()
+		}()
+
+		b.isRunning.Store(true)
+		if err := server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			lc.Errorf("gRPC server failed: %s", err.Error())
+			return
+		}
+
+		lc.Info("gRPC server stopped")
+	}()
+
+	return true
+}
+
+// serverTLSConfig creates the TLS configuration for the gRPC and HTTP servers from the certificate and private key
+// held in the secret store at secretName
+func serverTLSConfig(secretName string, secretProvider interfaces.SecretProvider) (*tls.Config, error) {
+	if secretProvider == nil {
+		return nil, errors.New("secret provider is missing from the DIC")
+	}
+
+	secrets, err := secretProvider.GetSecret(secretName, GrpcTLSCertSecretKey, GrpcTLSKeySecretKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the TLS secret '%s': %w", secretName, err)
+	}
+
+	cert, err := tls.X509KeyPair([]byte(secrets[GrpcTLSCertSecretKey]), []byte(secrets[GrpcTLSKeySecretKey]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the TLS certificate and key from secret '%s': %w", secretName, err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -0,0 +1,189 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	mocks2 "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+const testGrpcTLSSecretName = "grpc-tls"
+
+func TestGrpcServerBootstrapHandler(t *testing.T) {
+	certPEM, keyPEM := newTestCertificate(t)
+
+	tests := []struct {
+		Name          string
+		TLSSecretName string
+	}{
+		{"Insecure", ""},
+		{"TLS", testGrpcTLSSecretName},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			port := freePort(t)
+			dic := newGrpcServerTestContainer(port, test.TLSSecretName, map[string]map[string]string{
+				testGrpcTLSSecretName: {GrpcTLSCertSecretKey: string(certPEM), GrpcTLSKeySecretKey: string(keyPEM)},
+			})
+
+			target := NewGrpcServer(func(server *grpc.Server, _ *di.Container) error {
+				healthpb.RegisterHealthServer(server, health.NewServer())
+				return nil
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			wg := &sync.WaitGroup{}
+			require.True(t, target.BootstrapHandler(ctx, wg, startup.NewTimer(5, 1), dic))
+			require.NotNil(t, container.GrpcServerFrom(dic.Get))
+
+			transportCredentials := insecure.NewCredentials()
+			if len(test.TLSSecretName) > 0 {
+				rootCAs := x509.NewCertPool()
+				require.True(t, rootCAs.AppendCertsFromPEM(certPEM))
+				transportCredentials = credentials.NewTLS(&tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12})
+			}
+
+			conn, err := grpc.Dial(net.JoinHostPort("localhost", strconv.Itoa(port)), grpc.WithTransportCredentials(transportCredentials))
+			require.NoError(t, err)
+			defer conn.Close()
+
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer requestCancel()
+			response, err := healthpb.NewHealthClient(conn).Check(requestCtx, &healthpb.HealthCheckRequest{})
+			require.NoError(t, err)
+			assert.Equal(t, healthpb.HealthCheckResponse_SERVING, response.Status)
+			assert.True(t, target.IsRunning())
+
+			// The server shuts down and is waited for along with the other bootstrap handlers
+			cancel()
+			wg.Wait()
+			assert.False(t, target.IsRunning())
+		})
+	}
+}
+
+func TestGrpcServerBootstrapHandler_Errors(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Port          int
+		TLSSecretName string
+	}{
+		{"Missing port", 0, ""},
+		{"Missing TLS secret", 1, "missing"},
+		{"Invalid TLS secret", 1, "invalid"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			dic := newGrpcServerTestContainer(test.Port, test.TLSSecretName, map[string]map[string]string{
+				"invalid": {GrpcTLSCertSecretKey: "bogus", GrpcTLSKeySecretKey: "bogus"},
+			})
+
+			target := NewGrpcServer(nil)
+			assert.False(t, target.BootstrapHandler(context.Background(), &sync.WaitGroup{}, startup.NewTimer(5, 1), dic))
+			assert.False(t, target.IsRunning())
+		})
+	}
+}
+
+func newGrpcServerTestContainer(port int, tlsSecretName string, secrets map[string]map[string]string) *di.Container {
+	mockConfiguration := &mocks2.Configuration{}
+	mockConfiguration.On("GetBootstrap").Return(config.BootstrapConfiguration{
+		Service: &config.ServiceInfo{
+			Host: "localhost",
+			GrpcServer: config.GrpcServerInfo{
+				Port:          port,
+				TLSSecretName: tlsSecretName,
+			},
+		},
+	})
+
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return mockConfiguration
+		},
+		container.SecretProviderName: func(get di.Get) interface{} {
+			return secret.NewInMemorySecretProvider(secrets)
+		},
+	})
+}
+
+// freePort returns a port which is currently free to listen on
+func freePort(t *testing.T) int {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+// newTestCertificate creates a self-signed certificate for localhost, returning the PEM encoded certificate and key
+func newTestCertificate(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
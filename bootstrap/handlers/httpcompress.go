@@ -0,0 +1,222 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+const (
+	AcceptEncoding  = "Accept-Encoding"
+	ContentEncoding = "Content-Encoding"
+	ContentLength   = "Content-Length"
+
+	EncodingGzip    = "gzip"
+	EncodingDeflate = "deflate"
+
+	// defaultCompressionMinSize is the size in bytes below which responses aren't compressed when MinSize isn't set
+	defaultCompressionMinSize = 1024
+)
+
+// CompressionMiddleware is a middleware function that compresses the responses with gzip or deflate, as accepted by the
+// caller's Accept-Encoding header, when Service.Compression is enabled. Responses smaller than the MinSize, or already
+// encoded by the handler, are sent as is.
+func CompressionMiddleware(compression config.CompressionInfo, lc logger.LoggingClient) echo.MiddlewareFunc {
+	minSize := compression.MinSize
+	if minSize <= 0 {
+		minSize = defaultCompressionMinSize
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if !compression.Enabled {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			r := c.Request()
+			response := c.Response()
+
+			// The response depends on the encodings the caller accepts, whether or not it is compressed
+			response.Header().Add(Vary, AcceptEncoding)
+
+			encoding := negotiateEncoding(r.Header.Get(AcceptEncoding))
+			if len(encoding) == 0 || r.Method == http.MethodHead {
+				return next(c)
+			}
+
+			writer := &compressResponseWriter{
+				ResponseWriter: response.Writer,
+				encoding:       encoding,
+				minSize:        minSize,
+			}
+			response.Writer = writer
+
+			err := next(c)
+			if finishErr := writer.finish(); finishErr != nil {
+				lc.Errorf("unable to finish the %s compressed response to %s: %v", encoding, r.URL.Path, finishErr)
+			}
+			response.Writer = writer.ResponseWriter
+			return err
+		}
+	}
+}
+
+// negotiateEncoding returns the preferred compression accepted by the Accept-Encoding header, gzip over deflate, or
+// empty when neither is accepted
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := make(map[string]bool)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		coding := strings.ToLower(strings.TrimSpace(fields[0]))
+
+		// An encoding with q=0 is explicitly not accepted
+		acceptable := true
+		for _, param := range fields[1:] {
+			name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if found && strings.EqualFold(name, "q") {
+				if q, err := strconv.ParseFloat(value, 64); err == nil && q <= 0 {
+					acceptable = false
+				}
+			}
+		}
+		accepted[coding] = acceptable
+	}
+
+	for _, encoding := range []string{EncodingGzip, EncodingDeflate} {
+		if acceptable, found := accepted[encoding]; found {
+			if acceptable {
+				return encoding
+			}
+			continue
+		}
+		if accepted["*"] {
+			return encoding
+		}
+	}
+	return ""
+}
+
+// compressResponseWriter buffers the response until it reaches the minimum size, from when it is compressed
+type compressResponseWriter struct {
+	http.ResponseWriter
+	encoding   string
+	minSize    int
+	statusCode int
+	buffer     []byte
+	compressor io.WriteCloser
+	started    bool
+}
+
+func (w *compressResponseWriter) WriteHeader(statusCode int) {
+	if w.started || w.statusCode != 0 {
+		return
+	}
+	w.statusCode = statusCode
+}
+
+func (w *compressResponseWriter) Write(p []byte) (int, error) {
+	if w.compressor != nil {
+		return w.compressor.Write(p)
+	}
+	if w.started {
+		return w.ResponseWriter.Write(p)
+	}
+
+	w.buffer = append(w.buffer, p...)
+	if len(w.buffer) >= w.minSize {
+		if err := w.startCompression(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush starts sending the response, compressed as the size of a streamed response isn't known
+func (w *compressResponseWriter) Flush() {
+	if !w.started {
+		if err := w.startCompression(); err != nil {
+			return
+		}
+	}
+
+	if flusher, ok := w.compressor.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *compressResponseWriter) startCompression() error {
+	header := w.Header()
+	if len(header.Get(ContentEncoding)) > 0 {
+		// Already encoded by the handler
+		return w.sendUncompressed()
+	}
+
+	header.Set(ContentEncoding, w.encoding)
+	header.Del(ContentLength)
+	w.writeHeader()
+
+	if w.encoding == EncodingGzip {
+		w.compressor = gzip.NewWriter(w.ResponseWriter)
+	} else {
+		w.compressor = zlib.NewWriter(w.ResponseWriter)
+	}
+
+	_, err := w.compressor.Write(w.buffer)
+	w.buffer = nil
+	return err
+}
+
+func (w *compressResponseWriter) sendUncompressed() error {
+	w.writeHeader()
+	_, err := w.ResponseWriter.Write(w.buffer)
+	w.buffer = nil
+	return err
+}
+
+func (w *compressResponseWriter) writeHeader() {
+	w.started = true
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	w.ResponseWriter.WriteHeader(w.statusCode)
+}
+
+// finish sends the response when it was below the minimum size, otherwise completes the compressed response
+func (w *compressResponseWriter) finish() error {
+	switch {
+	case w.compressor != nil:
+		return w.compressor.Close()
+	case w.started:
+		return nil
+	case w.statusCode == 0 && len(w.buffer) == 0:
+		// Nothing written, the error handler writes the response
+		return nil
+	default:
+		return w.sendUncompressed()
+	}
+}
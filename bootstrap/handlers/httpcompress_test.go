@@ -0,0 +1,115 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+func TestCompressionMiddleware(t *testing.T) {
+	e := echo.New()
+	lc := logger.NewMockClient()
+	largeBody := "{\"readings\": \"" + strings.Repeat("reading", 512) + "\"}"
+	smallBody := "{\"apiVersion\": \"v3\"}"
+
+	tests := []struct {
+		name             string
+		enabled          bool
+		acceptEncoding   string
+		body             string
+		expectedEncoding string
+	}{
+		{"gzip", true, "gzip, deflate, br", largeBody, EncodingGzip},
+		{"deflate", true, "deflate", largeBody, EncodingDeflate},
+		{"gzip not acceptable", true, "gzip;q=0, deflate;q=0.5", largeBody, EncodingDeflate},
+		{"any encoding", true, "*", largeBody, EncodingGzip},
+		{"no Accept-Encoding", true, "", largeBody, ""},
+		{"unsupported encoding", true, "br", largeBody, ""},
+		{"below threshold", true, "gzip", smallBody, ""},
+		{"disabled", false, "gzip", largeBody, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			middleware := CompressionMiddleware(config.CompressionInfo{Enabled: test.enabled}, lc)
+			handler := middleware(func(c echo.Context) error {
+				return c.Blob(http.StatusCreated, "application/json", []byte(test.body))
+			})
+
+			req, err := http.NewRequest(http.MethodGet, "/", http.NoBody)
+			require.NoError(t, err)
+			if len(test.acceptEncoding) > 0 {
+				req.Header.Set(AcceptEncoding, test.acceptEncoding)
+			}
+
+			recorder := httptest.NewRecorder()
+			require.NoError(t, handler(e.NewContext(req, recorder)))
+
+			assert.Equal(t, http.StatusCreated, recorder.Code)
+			assert.Equal(t, test.expectedEncoding, recorder.Header().Get(ContentEncoding))
+
+			var reader io.Reader = recorder.Body
+			switch test.expectedEncoding {
+			case EncodingGzip:
+				reader, err = gzip.NewReader(recorder.Body)
+				require.NoError(t, err)
+			case EncodingDeflate:
+				reader, err = zlib.NewReader(recorder.Body)
+				require.NoError(t, err)
+			}
+
+			if len(test.expectedEncoding) > 0 {
+				assert.Less(t, recorder.Body.Len(), len(test.body))
+			}
+
+			body, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, test.body, string(body))
+		})
+	}
+}
+
+func TestCompressionMiddlewareHandlerError(t *testing.T) {
+	e := echo.New()
+	middleware := CompressionMiddleware(config.CompressionInfo{Enabled: true}, logger.NewMockClient())
+	handler := middleware(func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusNotFound, "not found")
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "/", http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set(AcceptEncoding, EncodingGzip)
+
+	// Nothing is written so the error handler can respond
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	err = handler(c)
+	require.Error(t, err)
+	assert.False(t, c.Response().Committed)
+	assert.Equal(t, 0, recorder.Body.Len())
+}
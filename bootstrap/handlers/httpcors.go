@@ -8,6 +8,7 @@ package handlers
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 
@@ -26,6 +27,23 @@ const (
 	AccessControlMaxAge           = "Access-Control-Max-Age"
 )
 
+// allowedOrigin returns the value of Access-Control-Allow-Origin for the request's origin, which is the origin itself
+// when it is one of the comma separated CORSAllowedOrigin, or "*" when any origin is allowed. The origin is never
+// echoed back for "*", since that would let any site make credentialed requests. Returns false when the origin isn't allowed, in which case no CORS headers are set and the browser blocks the
+// response.
+func allowedOrigin(corsInfo config.CORSConfigurationInfo, origin string) (string, bool) {
+	for _, allowed := range strings.Split(corsInfo.CORSAllowedOrigin, ",") {
+		allowed = strings.TrimSpace(allowed)
+		switch {
+		case allowed == "*":
+			return allowed, true
+		case len(allowed) > 0 && strings.EqualFold(allowed, origin):
+			return origin, true
+		}
+	}
+	return "", false
+}
+
 // ProcessCORS is a middleware function that enables CORS responses and sets CORS headers.
 func ProcessCORS(corsInfo config.CORSConfigurationInfo) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -33,6 +51,14 @@ func ProcessCORS(corsInfo config.CORSConfigurationInfo) echo.MiddlewareFunc {
 			r := c.Request()
 			w := c.Response()
 			if corsInfo.EnableCORS && r.Header.Get(Origin) != "" {
+				// The response depends on the origin, whether or not it is allowed
+				w.Header().Set(Vary, Origin)
+
+				allowOrigin, allowed := allowedOrigin(corsInfo, r.Header.Get(Origin))
+				if !allowed {
+					return next(c)
+				}
+
 				// Set Access-Control-Expose-Headers only if it's not a preflight request
 				// If the http method is OPTIONS with Access-Control-Request-Methods headers, it means a preflight request
 				if !(r.Method == http.MethodOptions && r.Header.Get(AccessControlRequestMethod) != "") {
@@ -41,14 +67,11 @@ func ProcessCORS(corsInfo config.CORSConfigurationInfo) echo.MiddlewareFunc {
 					}
 				}
 
-				if len(corsInfo.CORSAllowedOrigin) > 0 {
-					w.Header().Set(AccessControlAllowOrigin, corsInfo.CORSAllowedOrigin)
-				}
-				if corsInfo.CORSAllowCredentials {
+				w.Header().Set(AccessControlAllowOrigin, allowOrigin)
+				// Browsers reject credentials for the wildcard, which must stay that way for an origin allowed by "*"
+				if corsInfo.CORSAllowCredentials && allowOrigin != "*" {
 					w.Header().Set(AccessControlAllowCredentials, "true")
 				}
-
-				w.Header().Set(Vary, Origin)
 			}
 			return next(c)
 		}
@@ -66,7 +89,8 @@ func HandlePreflight(corsInfo config.CORSConfigurationInfo) echo.MiddlewareFunc
 			}
 
 			w := c.Response()
-			if corsInfo.EnableCORS && r.Header.Get(Origin) != "" {
+			_, allowed := allowedOrigin(corsInfo, r.Header.Get(Origin))
+			if corsInfo.EnableCORS && r.Header.Get(Origin) != "" && allowed {
 				if len(corsInfo.CORSAllowedMethods) > 0 {
 					w.Header().Set(AccessControlAllowMethods, corsInfo.CORSAllowedMethods)
 				}
@@ -50,8 +50,10 @@ func TestProcessCORS(t *testing.T) {
 	}{
 		{"not enable CORS", false, http.MethodGet, "http://test.com", http.MethodGet, "", "", "", ""},
 		{"enable CORS without Origin header", true, http.MethodGet, "", http.MethodGet, "", "", "", ""},
-		{"enable CORS and receive a preflight request", true, http.MethodOptions, "http://test.com", http.MethodGet, "", defaultCORSAllowedOrigin, defaultCORSAllowCredentials, Origin},
-		{"enable CORS and receive an actual request", true, http.MethodGet, "http://test.com", "", defaultCORSExposeHeaders, defaultCORSAllowedOrigin, defaultCORSAllowCredentials, Origin},
+		{"enable CORS and receive a preflight request", true, http.MethodOptions, defaultCORSAllowedOrigin, http.MethodGet, "", defaultCORSAllowedOrigin, defaultCORSAllowCredentials, Origin},
+		{"enable CORS and receive an actual request", true, http.MethodGet, defaultCORSAllowedOrigin, "", defaultCORSExposeHeaders, defaultCORSAllowedOrigin, defaultCORSAllowCredentials, Origin},
+		{"enable CORS and receive a request from a disallowed origin", true, http.MethodGet, "http://test.com", "", "", "", "", Origin},
+		{"enable CORS and receive a preflight request from a disallowed origin", true, http.MethodOptions, "http://test.com", http.MethodGet, "", "", "", Origin},
 	}
 
 	for _, testCase := range tests {
@@ -86,6 +88,26 @@ func TestProcessCORS(t *testing.T) {
 	}
 }
 
+func TestProcessCORSAnyOriginWithCredentials(t *testing.T) {
+	e := echo.New()
+	corsInfo := defaultCORSInfo
+	corsInfo.EnableCORS = true
+	corsInfo.CORSAllowedOrigin = "*"
+	handler := ProcessCORS(corsInfo)(simpleHandler)
+
+	req, err := http.NewRequest(http.MethodGet, "/", http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set(Origin, "https://evil.example")
+
+	recorder := httptest.NewRecorder()
+	err = handler(e.NewContext(req, recorder))
+	require.NoError(t, err)
+
+	resp := recorder.Result()
+	assert.Equal(t, "*", resp.Header.Get(AccessControlAllowOrigin), "arbitrary origin must not be echoed back")
+	assert.Empty(t, resp.Header.Get(AccessControlAllowCredentials), "credentials must not be allowed for any origin")
+}
+
 func TestHandlePreflight(t *testing.T) {
 	e := echo.New()
 	corsInfo := defaultCORSInfo
@@ -101,7 +123,8 @@ func TestHandlePreflight(t *testing.T) {
 	}{
 		{"not enable CORS", false, "http://test.com", "", "", "", ""},
 		{"enable CORS without Origin header", true, "", "", "", "", ""},
-		{"enable CORS and receive a preflight request", true, "http://test.com", "GET", defaultCORSAllowedMethods, defaultCORSAllowedHeaders, defaultCORSMaxAge},
+		{"enable CORS and receive a preflight request", true, defaultCORSAllowedOrigin, "GET", defaultCORSAllowedMethods, defaultCORSAllowedHeaders, defaultCORSMaxAge},
+		{"enable CORS and receive a preflight request from a disallowed origin", true, "http://test.com", "GET", "", "", ""},
 	}
 
 	for _, testCase := range tests {
@@ -134,3 +157,30 @@ func TestHandlePreflight(t *testing.T) {
 		})
 	}
 }
+
+func TestAllowedOrigin(t *testing.T) {
+	tests := []struct {
+		Name                string
+		AllowedOrigin       string
+		AllowCredentials    bool
+		Origin              string
+		ExpectedAllowed     bool
+		ExpectedAllowOrigin string
+	}{
+		{"single allowed origin", "https://localhost", false, "https://localhost", true, "https://localhost"},
+		{"one of multiple allowed origins", "https://localhost, https://ui.edgex.local", false, "https://ui.edgex.local", true, "https://ui.edgex.local"},
+		{"disallowed origin", "https://localhost, https://ui.edgex.local", false, "https://evil.example", false, ""},
+		{"no allowed origins", "", false, "https://localhost", false, ""},
+		{"any origin", "*", false, "https://ui.edgex.local", true, "*"},
+		{"any origin with credentials", "*", true, "https://evil.example", true, "*"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.Name, func(t *testing.T) {
+			corsInfo := config.CORSConfigurationInfo{CORSAllowedOrigin: testCase.AllowedOrigin, CORSAllowCredentials: testCase.AllowCredentials}
+			allowOrigin, allowed := allowedOrigin(corsInfo, testCase.Origin)
+			assert.Equal(t, testCase.ExpectedAllowed, allowed)
+			assert.Equal(t, testCase.ExpectedAllowOrigin, allowOrigin)
+		})
+	}
+}
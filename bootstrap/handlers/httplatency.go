@@ -0,0 +1,101 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/labstack/echo/v4"
+	gometrics "github.com/rcrowley/go-metrics"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+const (
+	// HttpRequestLatencyMetricName is the name the per-route request latency Timers are reported under. Each route's
+	// Timer is registered with a name derived from the method and route and is distinguished by its tags.
+	HttpRequestLatencyMetricName = "HttpRequestLatency"
+	httpLatencyMethodTagKey      = "method"
+	httpLatencyRouteTagKey       = "route"
+)
+
+// unsafeMetricNameRegex matches the runs of characters which aren't accepted in metric names by all the sinks
+var unsafeMetricNameRegex = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// RequestLatencyMiddleware is a middleware function that records the latency of each request in a Timer per method
+// and route, which is registered with the service's Metrics Manager on the route's first request. The route is the
+// registered route pattern, i.e. /api/v3/device/name/:name, so the number of Timers is bounded by the routes.
+// Requests which don't match a route aren't recorded. Requests are served without being recorded while the Metrics
+// Manager isn't available.
+func RequestLatencyMiddleware(dic *di.Container, lc logger.LoggingClient) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			route := c.Path()
+			if len(route) == 0 {
+				return next(c)
+			}
+
+			started := time.Now()
+			err := next(c)
+
+			if timer := requestLatencyTimer(dic, c.Request().Method, route, lc); timer != nil {
+				timer.UpdateSince(started)
+			}
+
+			return err
+		}
+	}
+}
+
+// requestLatencyTimer returns the Timer for the method and route, registering it when this is the route's first request
+func requestLatencyTimer(dic *di.Container, method string, route string, lc logger.LoggingClient) gometrics.Timer {
+	manager := container.MetricsManagerFrom(dic.Get)
+	if manager == nil {
+		return nil
+	}
+
+	name := requestLatencyMetricName(method, route)
+	if timer := manager.GetTimer(name); timer != nil {
+		return timer
+	}
+
+	timer := gometrics.NewTimer()
+	tags := map[string]string{
+		httpLatencyMethodTagKey: method,
+		httpLatencyRouteTagKey:  route,
+	}
+	if err := manager.Register(name, timer, tags); err != nil {
+		// The Timer has been registered by a concurrent request for the same route
+		if existing := manager.GetTimer(name); existing != nil {
+			return existing
+		}
+
+		lc.Warnf("unable to register request latency metric '%s': %s", name, err.Error())
+		return nil
+	}
+
+	return timer
+}
+
+// requestLatencyMetricName derives the Timer's name from the method and route, i.e. GET /api/v3/ping is
+// HttpRequestLatency_GET_api_v3_ping, using only the characters accepted in metric names by all the sinks
+func requestLatencyMetricName(method string, route string) string {
+	name := unsafeMetricNameRegex.ReplaceAllString(method+"_"+route, "_")
+	return HttpRequestLatencyMetricName + "_" + strings.Trim(name, "_")
+}
@@ -0,0 +1,119 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/metrics"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+func TestRequestLatencyMiddleware(t *testing.T) {
+	handlerDelay := time.Millisecond * 10
+	manager := metrics.NewManager(logger.NewMockClient(), time.Second, metrics.NewNullReporter())
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.MetricsManagerInterfaceName: func(get di.Get) interface{} {
+			return manager
+		},
+	})
+
+	e := echo.New()
+	e.Use(RequestLatencyMiddleware(dic, logger.NewMockClient()))
+	e.GET("/api/v3/device/name/:name", func(c echo.Context) error {
+		time.Sleep(handlerDelay)
+		return c.String(http.StatusOK, "OK")
+	})
+	e.PUT("/api/v3/device/name/:name", handler)
+
+	doRequest := func(method string, path string) {
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, httptest.NewRequest(method, path, nil))
+	}
+
+	getName := "HttpRequestLatency_GET_api_v3_device_name_name"
+	putName := "HttpRequestLatency_PUT_api_v3_device_name_name"
+	require.Nil(t, manager.GetTimer(getName), "Timer registered before the route's first request")
+
+	doRequest(http.MethodGet, "/api/v3/device/name/device-1")
+	timer := manager.GetTimer(getName)
+	require.NotNil(t, timer)
+	assert.Equal(t, int64(1), timer.Count())
+	firstSum := timer.Sum()
+	assert.GreaterOrEqual(t, firstSum, int64(handlerDelay))
+
+	// All the requests to the route are recorded in the same Timer regardless of the path parameters
+	doRequest(http.MethodGet, "/api/v3/device/name/device-2")
+	assert.Equal(t, int64(2), timer.Count())
+	assert.GreaterOrEqual(t, timer.Sum(), firstSum+int64(handlerDelay))
+	assert.GreaterOrEqual(t, timer.Min(), int64(handlerDelay))
+
+	doRequest(http.MethodPut, "/api/v3/device/name/device-1")
+	putTimer := manager.GetTimer(putName)
+	require.NotNil(t, putTimer)
+	assert.Equal(t, int64(1), putTimer.Count())
+	assert.Equal(t, int64(2), timer.Count())
+
+	// The Timers are reported under the common name, with the method and route as tags
+	snapshot, err := manager.Snapshot(&config.TelemetryInfo{Metrics: map[string]bool{HttpRequestLatencyMetricName: true}})
+	require.NoError(t, err)
+	require.Len(t, snapshot, 2)
+	for _, metric := range snapshot {
+		assert.Equal(t, HttpRequestLatencyMetricName, metric.Name)
+		assert.Contains(t, metric.Tags, dtos.MetricTag{Name: httpLatencyRouteTagKey, Value: "/api/v3/device/name/:name"})
+	}
+}
+
+func TestRequestLatencyMiddlewareNoManager(t *testing.T) {
+	e := echo.New()
+	e.Use(RequestLatencyMiddleware(di.NewContainer(nil), logger.NewMockClient()))
+	e.GET("/", handler)
+
+	res := httptest.NewRecorder()
+	e.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, res.Code)
+}
+
+func TestRequestLatencyMetricName(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Method   string
+		Route    string
+		Expected string
+	}{
+		{"Root", http.MethodGet, "/", "HttpRequestLatency_GET"},
+		{"Static route", http.MethodGet, "/api/v3/ping", "HttpRequestLatency_GET_api_v3_ping"},
+		{"Path parameters", http.MethodDelete, "/api/v3/device/name/:name", "HttpRequestLatency_DELETE_api_v3_device_name_name"},
+		{"Wildcard", http.MethodGet, "/debug/pprof/*", "HttpRequestLatency_GET_debug_pprof"},
+		{"Punctuation", http.MethodPost, "/api/v3/device-profile/upload.file", "HttpRequestLatency_POST_api_v3_device_profile_upload_file"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert.Equal(t, test.Expected, requestLatencyMetricName(test.Method, test.Route))
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package handlers
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/shutdown"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// ProfilingRoute is the route the net/http/pprof profiling handlers are served at when Service.Profiling is enabled
+const ProfilingRoute = "/debug/pprof/"
+
+// profilingHandlers returns the net/http/pprof handlers keyed by their route. The index also serves the named
+// profiles, i.e. /debug/pprof/heap.
+func profilingHandlers() map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		ProfilingRoute:             pprof.Index,
+		ProfilingRoute + "cmdline": pprof.Cmdline,
+		ProfilingRoute + "profile": pprof.Profile,
+		ProfilingRoute + "symbol":  pprof.Symbol,
+		ProfilingRoute + "trace":   pprof.Trace,
+	}
+}
+
+// validateProfiling checks the Service.Profiling settings can be served with the HTTP server's settings. When client
+// certificates are required the handlers must be served on the admin port, so they are only reachable by mTLS callers.
+func validateProfiling(serviceInfo config.ServiceInfo, isUnixSocket bool, bindAddr string, lc logger.LoggingClient) bool {
+	profiling := serviceInfo.Profiling
+	switch {
+	case profiling.Port == 0 && serviceInfo.RequireClientCert:
+		lc.Error("Service.Profiling.Port must be set when Service.RequireClientCert is set, so profiling is only served on the admin port")
+		return false
+	case profiling.Port == 0:
+		return true
+	case isUnixSocket:
+		lc.Errorf("Service.Profiling.Port can't be used when listening on a Unix domain socket (%s)", bindAddr)
+		return false
+	case profiling.Port == serviceInfo.Port:
+		lc.Errorf("Service.Profiling.Port must not be the same as Service.Port (%d)", profiling.Port)
+		return false
+	}
+
+	return true
+}
+
+// addProfilingRoutes mounts the profiling handlers on the router behind the authenticationHook. Note the router's
+// RequestTimeout also applies to the CPU profile and trace, so their duration must be shorter unless served on the
+// admin port.
+func addProfilingRoutes(router *echo.Echo, authenticationHook echo.MiddlewareFunc) {
+	for route, handler := range profilingHandlers() {
+		if route == ProfilingRoute {
+			route += "*"
+		}
+		router.Any(route, echo.WrapHandler(handler), authenticationHook)
+	}
+}
+
+// newProfilingServer creates the admin server which serves the profiling handlers on addr
+func newProfilingServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	for route, handler := range profilingHandlers() {
+		mux.HandleFunc(route, handler)
+	}
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
+
+// startProfilingServer starts the admin server serving the profiling handlers on the port. The admin server uses the
+// HTTP server's TLS configuration, so it also requires the callers' client certificates when mTLS is required.
+func startProfilingServer(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	host string,
+	port int,
+	tlsConfig *tls.Config,
+	shutdownTimeout time.Duration,
+	shutdownRegistry interfaces.ShutdownRegistry,
+	lc logger.LoggingClient) error {
+
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	server := newProfilingServer(addr)
+
+	// Listen before returning so the failure to bind the port fails the bootstrap
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	shutdownServer := newShutdownServerFunc(server, shutdownTimeout, lc)
+	if shutdownRegistry != nil {
+		shutdownRegistry.Register("Profiling server", shutdown.PriorityServers, shutdownServer)
+	} else {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			<-ctx.Done()
+			shutdownServer()
+		}()
+	}
+
+	lc.Warnf("Profiling enabled, serving the pprof handlers at %s on the admin port (%s)", ProfilingRoute, addr)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			lc.Errorf("Profiling server failed: %v", err)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,134 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+func TestAddProfilingRoutes(t *testing.T) {
+	routes := []string{
+		"/debug/pprof/",
+		"/debug/pprof/cmdline",
+		"/debug/pprof/symbol",
+		"/debug/pprof/heap?debug=1",
+		"/debug/pprof/goroutine?debug=1",
+	}
+
+	unauthenticated := func(echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return c.NoContent(http.StatusUnauthorized)
+		}
+	}
+
+	tests := []struct {
+		name               string
+		enabled            bool
+		authenticationHook echo.MiddlewareFunc
+		expectedStatus     int
+	}{
+		{"enabled", true, NilAuthenticationHandlerFunc(), http.StatusOK},
+		{"enabled without authentication", true, unauthenticated, http.StatusUnauthorized},
+		{"disabled", false, nil, http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			router := echo.New()
+			if test.enabled {
+				addProfilingRoutes(router, test.authenticationHook)
+			}
+
+			for _, route := range routes {
+				recorder := httptest.NewRecorder()
+				router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, route, nil))
+				assert.Equal(t, test.expectedStatus, recorder.Code, route)
+			}
+		})
+	}
+}
+
+func TestValidateProfiling(t *testing.T) {
+	tests := []struct {
+		name         string
+		serviceInfo  config.ServiceInfo
+		isUnixSocket bool
+		expected     bool
+	}{
+		{"main port", config.ServiceInfo{Port: 59880}, false, true},
+		{"admin port", config.ServiceInfo{Port: 59880, Profiling: config.ProfilingInfo{Port: 6060}}, false, true},
+		{"main port with mTLS", config.ServiceInfo{Port: 59880, RequireClientCert: true}, false, false},
+		{"admin port with mTLS", config.ServiceInfo{Port: 59880, RequireClientCert: true, Profiling: config.ProfilingInfo{Port: 6060}}, false, true},
+		{"admin port on Unix socket", config.ServiceInfo{Profiling: config.ProfilingInfo{Port: 6060}}, true, false},
+		{"admin port same as main port", config.ServiceInfo{Port: 59880, Profiling: config.ProfilingInfo{Port: 59880}}, false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.serviceInfo.Profiling.Enabled = true
+			assert.Equal(t, test.expected, validateProfiling(test.serviceInfo, test.isUnixSocket, "unix:///tmp/edgex.sock", logger.NewMockClient()))
+		})
+	}
+}
+
+func TestStartProfilingServer(t *testing.T) {
+	// Find a free port for the admin server
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+
+	err = startProfilingServer(ctx, wg, "127.0.0.1", port, nil, time.Second, nil, logger.NewMockClient())
+	require.NoError(t, err)
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	get := func(route string) int {
+		resp, err := client.Get(fmt.Sprintf("http://127.0.0.1:%d%s", port, route))
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, get("/debug/pprof/"))
+	assert.Equal(t, http.StatusOK, get("/debug/pprof/cmdline"))
+	assert.Equal(t, http.StatusOK, get("/debug/pprof/heap?debug=1"))
+	assert.Equal(t, http.StatusNotFound, get("/api/v3/ping"))
+
+	// The port is in use by the running admin server
+	err = startProfilingServer(ctx, wg, "127.0.0.1", port, nil, time.Second, nil, logger.NewMockClient())
+	assert.Error(t, err)
+
+	cancel()
+	wg.Wait()
+
+	_, err = client.Get(fmt.Sprintf("http://127.0.0.1:%d/debug/pprof/", port))
+	assert.Error(t, err)
+}
@@ -0,0 +1,195 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+const (
+	RetryAfter = "Retry-After"
+
+	// rateLimitPruneInterval is how often the buckets of the callers which are no longer limited are removed
+	rateLimitPruneInterval = time.Minute
+)
+
+// tokenBucket allows requests at the sustained rate, plus a burst of requests up to its size
+type tokenBucket struct {
+	rate   float64
+	size   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	size := float64(burst)
+	if size < 1 {
+		size = math.Max(1, math.Ceil(rate))
+	}
+
+	return &tokenBucket{rate: rate, size: size, tokens: size, last: now}
+}
+
+// refill adds the tokens accrued since the last refill, up to the bucket's size
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens = math.Min(b.size, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
+
+// wait returns how long until the bucket has a token, which is 0 when it has one now
+func (b *tokenBucket) wait() time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// rateLimiter limits the rate of requests across all callers and per caller
+type rateLimiter struct {
+	info      config.RateLimitInfo
+	global    *tokenBucket
+	clients   map[string]*tokenBucket
+	lastPrune time.Time
+	now       func() time.Time
+	mutex     sync.Mutex
+}
+
+func newRateLimiter(info config.RateLimitInfo, now func() time.Time) *rateLimiter {
+	limiter := &rateLimiter{
+		info:      info,
+		clients:   make(map[string]*tokenBucket),
+		lastPrune: now(),
+		now:       now,
+	}
+
+	if info.RequestsPerSecond > 0 {
+		limiter.global = newTokenBucket(info.RequestsPerSecond, info.Burst, now())
+	}
+
+	return limiter
+}
+
+// allow takes a token for the request from the caller's and the global buckets when both have one, otherwise returns
+// how long until the request would be allowed
+func (l *rateLimiter) allow(client string) (bool, time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	buckets := make([]*tokenBucket, 0, 2)
+
+	if l.info.PerClientRequestsPerSecond > 0 {
+		l.prune(now)
+
+		bucket, ok := l.clients[client]
+		if !ok {
+			bucket = newTokenBucket(l.info.PerClientRequestsPerSecond, l.info.PerClientBurst, now)
+			l.clients[client] = bucket
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	if l.global != nil {
+		buckets = append(buckets, l.global)
+	}
+
+	var retryAfter time.Duration
+	for _, bucket := range buckets {
+		bucket.refill(now)
+		if wait := bucket.wait(); wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+
+	if retryAfter > 0 {
+		return false, retryAfter
+	}
+
+	for _, bucket := range buckets {
+		bucket.tokens--
+	}
+	return true, 0
+}
+
+// prune removes the buckets of the callers which have since refilled, as they're the same as new buckets
+func (l *rateLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < rateLimitPruneInterval {
+		return
+	}
+
+	for client, bucket := range l.clients {
+		bucket.refill(now)
+		if bucket.tokens >= bucket.size {
+			delete(l.clients, client)
+		}
+	}
+	l.lastPrune = now
+}
+
+// RateLimitMiddleware is a middleware function that limits the rate of requests, across all callers and per caller IP
+// address, to the Service.RateLimit. Requests over the limits are rejected with 429 Too Many Requests and the
+// Retry-After header set to the number of seconds until they'd be allowed. Requests are unlimited when not configured.
+func RateLimitMiddleware(rateLimit config.RateLimitInfo, lc logger.LoggingClient) echo.MiddlewareFunc {
+	if rateLimit.RequestsPerSecond <= 0 && rateLimit.PerClientRequestsPerSecond <= 0 {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
+
+	limiter := newRateLimiter(rateLimit, time.Now)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r := c.Request()
+
+			// The connection's address is used as the forwarded headers can be set by the callers themselves
+			client, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				client = r.RemoteAddr
+			}
+
+			allowed, retryAfter := limiter.allow(client)
+			if allowed {
+				return next(c)
+			}
+
+			response := commonDTO.NewBaseResponse("", "request rate exceeds Service.RateLimit", http.StatusTooManyRequests)
+			lc.Debugf("%s, rejected request from %s to %s", response.Message, client, r.URL.Path)
+
+			w := c.Response()
+			w.Header().Set(RetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			w.Header().Set(common.ContentType, common.ContentTypeJSON)
+			w.WriteHeader(response.StatusCode)
+			if err := json.NewEncoder(w).Encode(response); err != nil {
+				lc.Errorf("Error encoding the data:  %v", err)
+				return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+			}
+			return nil
+		}
+	}
+}
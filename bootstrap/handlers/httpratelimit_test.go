@@ -0,0 +1,116 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// sendRateLimited sends the requests from the caller through the middleware, returning the responses' status codes
+func sendRateLimited(t *testing.T, handler echo.HandlerFunc, remoteAddr string, count int) ([]int, *httptest.ResponseRecorder) {
+	e := echo.New()
+	var statusCodes []int
+	var recorder *httptest.ResponseRecorder
+	for index := 0; index < count; index++ {
+		req, err := http.NewRequest(http.MethodGet, "/", http.NoBody)
+		require.NoError(t, err)
+		req.RemoteAddr = remoteAddr
+
+		recorder = httptest.NewRecorder()
+		require.NoError(t, handler(e.NewContext(req, recorder)))
+		statusCodes = append(statusCodes, recorder.Code)
+	}
+	return statusCodes, recorder
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	lc := logger.NewMockClient()
+
+	tests := []struct {
+		name       string
+		rateLimit  config.RateLimitInfo
+		callers    []string
+		count      int
+		expected   [][]int
+		retryAfter string
+	}{
+		{"unlimited", config.RateLimitInfo{}, []string{"10.0.0.1:1000"}, 50, nil, ""},
+		{"per client under limit", config.RateLimitInfo{PerClientRequestsPerSecond: 1, PerClientBurst: 3},
+			[]string{"10.0.0.1:1000", "10.0.0.2:1000"}, 3, [][]int{{200, 200, 200}, {200, 200, 200}}, ""},
+		{"per client over limit", config.RateLimitInfo{PerClientRequestsPerSecond: 1, PerClientBurst: 3},
+			[]string{"10.0.0.2:1000", "10.0.0.1:1000", "10.0.0.1:2000"}, 2, [][]int{{200, 200}, {200, 200}, {200, 429}}, "1"},
+		{"global over limit", config.RateLimitInfo{RequestsPerSecond: 0.5, Burst: 2},
+			[]string{"10.0.0.1:1000", "10.0.0.2:1000"}, 2, [][]int{{200, 200}, {429, 429}}, "2"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := RateLimitMiddleware(test.rateLimit, lc)(simpleHandler)
+
+			var recorder *httptest.ResponseRecorder
+			for index, caller := range test.callers {
+				var statusCodes []int
+				statusCodes, recorder = sendRateLimited(t, handler, caller, test.count)
+				if test.expected == nil {
+					for _, statusCode := range statusCodes {
+						assert.Equal(t, http.StatusOK, statusCode)
+					}
+					continue
+				}
+				assert.Equal(t, test.expected[index], statusCodes, "caller %s", caller)
+			}
+
+			if len(test.retryAfter) > 0 {
+				assert.Equal(t, test.retryAfter, recorder.Header().Get(RetryAfter))
+				assert.Contains(t, recorder.Body.String(), "Service.RateLimit")
+			}
+		})
+	}
+}
+
+func TestRateLimiterRefills(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(config.RateLimitInfo{PerClientRequestsPerSecond: 2}, func() time.Time { return now })
+
+	// The burst defaults to the rate
+	for count := 0; count < 2; count++ {
+		allowed, _ := limiter.allow("10.0.0.1")
+		assert.True(t, allowed)
+	}
+
+	allowed, retryAfter := limiter.allow("10.0.0.1")
+	assert.False(t, allowed)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	now = now.Add(500 * time.Millisecond)
+	allowed, _ = limiter.allow("10.0.0.1")
+	assert.True(t, allowed)
+
+	// The caller's bucket is removed once it has refilled
+	now = now.Add(rateLimitPruneInterval)
+	allowed, _ = limiter.allow("10.0.0.2")
+	assert.True(t, allowed)
+	assert.NotContains(t, limiter.clients, "10.0.0.1")
+}
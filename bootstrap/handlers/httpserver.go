@@ -18,11 +18,15 @@ package handlers
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"net/netip"
 	"os"
 	"strconv"
 	"strings"
@@ -35,6 +39,8 @@ import (
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/shutdown"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 
@@ -46,6 +52,20 @@ import (
 	"github.com/openziti/sdk-golang/ziti/edge"
 )
 
+// defaultShutdownTimeout is how long the in-flight requests are drained on shutdown when Service.ShutdownTimeout isn't set
+const defaultShutdownTimeout = 30 * time.Second
+
+// DefaultMaxRequestSize is the request body size limit, in kilobytes, used when Service.MaxRequestSize isn't set
+const DefaultMaxRequestSize = 32 * 1024
+
+var errResponseTooLarge = errors.New("response size exceeds Service.MaxResponseSize")
+
+const (
+	// HttpTLSCACertSecretKey is the key of the PEM encoded CA certificate in the HTTP server's TLS secret, which the
+	// callers' client certificates are verified against when client certificates are required
+	HttpTLSCACertSecretKey = "cacert"
+)
+
 // HttpServer contains references to dependencies required by the http server implementation.
 type HttpServer struct {
 	router           *echo.Echo
@@ -103,20 +123,41 @@ func (b *HttpServer) BootstrapHandler(
 
 	bootstrapConfig := container.ConfigurationFrom(dic.Get).GetBootstrap()
 
-	if bootstrapConfig.Service.Port == 0 {
+	// this allows env override to explicitly set the value used
+	// for ListenAndServe as needed for different deployments
+	bindAddr := bootstrapConfig.Service.ServerBindAddr
+	// for backwards compatibility, the Host value is the default value if
+	// the ServerBindAddr value is not specified
+	if bindAddr == "" {
+		bindAddr = bootstrapConfig.Service.Host
+	}
+
+	socketPath, isUnixSocket := unixSocketPath(bindAddr)
+	var socketFileMode os.FileMode
+	if isUnixSocket {
+		var err error
+		socketFileMode, err = parseUnixSocketFileMode(bootstrapConfig.Service.UnixSocketFileMode)
+		if err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+	}
+
+	if bootstrapConfig.Service.Port == 0 && !isUnixSocket {
 		// should not be 0 as if it were set in local config
 		lc.Error("Service.Port is missing from service's configuration or should not be 0 in local private config")
 		return false
 	}
 
-	// this allows env override to explicitly set the value used
-	// for ListenAndServe as needed for different deployments
-	port := strconv.Itoa(bootstrapConfig.Service.Port)
-	addr := bootstrapConfig.Service.ServerBindAddr + ":" + port
-	// for backwards compatibility, the Host value is the default value if
-	// the ServerBindAddr value is not specified
-	if bootstrapConfig.Service.ServerBindAddr == "" {
-		addr = bootstrapConfig.Service.Host + ":" + port
+	addr := bindAddr
+	if !isUnixSocket {
+		var err error
+		bindAddr, err = httpBindHost(bindAddr)
+		if err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+		addr = net.JoinHostPort(bindAddr, strconv.Itoa(bootstrapConfig.Service.Port))
 	}
 
 	if len(bootstrapConfig.Service.RequestTimeout) == 0 {
@@ -127,6 +168,10 @@ func (b *HttpServer) BootstrapHandler(
 	// Use the common middlewares
 	b.router.Use(ManageHeader)
 	b.router.Use(LoggingMiddleware(lc))
+	if telemetryConfig := container.ConfigurationFrom(dic.Get).GetTelemetryInfo(); telemetryConfig != nil && telemetryConfig.RequestLatencyMetrics {
+		b.router.Use(RequestLatencyMiddleware(dic, lc))
+	}
+	b.router.Use(RateLimitMiddleware(bootstrapConfig.Service.RateLimit, lc))
 	b.router.Use(UrlDecodeMiddleware(lc))
 
 	timeout, err := time.ParseDuration(bootstrapConfig.Service.RequestTimeout)
@@ -142,6 +187,8 @@ func (b *HttpServer) BootstrapHandler(
 	zc := &ZitiContext{}
 
 	b.router.Use(RequestLimitMiddleware(bootstrapConfig.Service.MaxRequestSize, lc))
+	b.router.Use(ResponseLimitMiddleware(bootstrapConfig.Service.MaxResponseSize, lc))
+	b.router.Use(CompressionMiddleware(bootstrapConfig.Service.Compression, lc))
 
 	b.router.Use(ProcessCORS(bootstrapConfig.Service.CORSConfiguration))
 
@@ -155,14 +202,59 @@ func (b *HttpServer) BootstrapHandler(
 	}
 	server.ConnContext = mutator
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
+	var tlsConfig *tls.Config
+	if httpTLSEnabled(bootstrapConfig.Service) {
+		serverTLS, err := newHttpServerTLS(bootstrapConfig.Service, container.SecretProviderFrom(dic.Get), lc)
+		if err != nil {
+			lc.Errorf("unable to configure TLS for the Web server: %s", err.Error())
+			return false
+		}
+		tlsConfig = serverTLS.tlsConfig()
+	}
 
-		<-ctx.Done()
-		_ = server.Shutdown(context.Background())
-		lc.Info("Web server shut down")
-	}()
+	shutdownTimeout := defaultShutdownTimeout
+	if len(bootstrapConfig.Service.ShutdownTimeout) > 0 {
+		shutdownTimeout, err = time.ParseDuration(bootstrapConfig.Service.ShutdownTimeout)
+		if err != nil || shutdownTimeout <= 0 {
+			lc.Errorf("Service.ShutdownTimeout '%s' is invalid", bootstrapConfig.Service.ShutdownTimeout)
+			return false
+		}
+	}
+
+	shutdownServer := newShutdownServerFunc(server, shutdownTimeout, lc)
+	shutdownRegistry := container.ShutdownRegistryFrom(dic.Get)
+
+	profiling := bootstrapConfig.Service.Profiling
+	if profiling.Enabled {
+		if !validateProfiling(bootstrapConfig.Service, isUnixSocket, bindAddr, lc) {
+			return false
+		}
+
+		if profiling.Port == 0 {
+			// Secured the same as the common admin routes since anyone able to call the service could otherwise profile it
+			addProfilingRoutes(b.router, AutoConfigAuthenticationFunc(container.SecretProviderExtFrom(dic.Get), lc))
+			lc.Warnf("Profiling enabled, serving the pprof handlers at %s", ProfilingRoute)
+		} else {
+			err = startProfilingServer(ctx, wg, bindAddr, profiling.Port, tlsConfig, shutdownTimeout, shutdownRegistry, lc)
+			if err != nil {
+				lc.Errorf("unable to start the Profiling server: %s", err.Error())
+				return false
+			}
+		}
+	}
+
+	// The server is stopped in priority order with the other resources when the shutdown registry is available
+	if shutdownRegistry != nil {
+		shutdownRegistry.Register("HTTP server", shutdown.PriorityServers, shutdownServer)
+	} else {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			<-ctx.Done()
+			shutdownServer()
+		}()
+	}
 
 	lc.Info("Web server starting (" + addr + ")")
 
@@ -231,11 +323,21 @@ func (b *HttpServer) BootstrapHandler(
 			fallthrough
 		default:
 			lc.Infof("listening on underlay network. ListenMode '%s' at %s", listenMode, addr)
-			ln, listenErr := net.Listen("tcp", addr)
+			var ln net.Listener
+			var listenErr error
+			if isUnixSocket {
+				ln, listenErr = listenUnixSocket(socketPath, socketFileMode)
+			} else {
+				ln, listenErr = net.Listen("tcp", addr)
+			}
 			if listenErr != nil {
 				err = listenErr
 				break
 			}
+			if tlsConfig != nil {
+				lc.Infof("serving TLS, client certificates required: %t", bootstrapConfig.Service.RequireClientCert)
+				ln = tls.NewListener(ln, tlsConfig)
+			}
 			err = server.Serve(ln)
 		}
 
@@ -260,33 +362,200 @@ func (b *HttpServer) BootstrapHandler(
 	return true
 }
 
-// RequestLimitMiddleware is a middleware function that limits the request body size to Service.MaxRequestSize in kilobytes
+// httpBindHost validates the bind address of the HTTP server and returns the host to listen on, with the brackets of
+// an IPv6 literal removed. An IPv6 literal must be a valid address, so a port included in the bind address, which is
+// set by Service.Port instead, is rejected. Binding "::" listens on both IPv6 and IPv4 (dual-stack) where the platform
+// supports it, while an empty bind address listens on all the interfaces.
+func httpBindHost(bindAddr string) (string, error) {
+	host := bindAddr
+	if strings.HasPrefix(bindAddr, "[") || strings.HasSuffix(bindAddr, "]") {
+		if !strings.HasPrefix(bindAddr, "[") || !strings.HasSuffix(bindAddr, "]") {
+			return "", fmt.Errorf("bind address '%s' is malformed, unbalanced brackets", bindAddr)
+		}
+		host = bindAddr[1 : len(bindAddr)-1]
+		if addr, err := netip.ParseAddr(host); err != nil || !addr.Is6() {
+			return "", fmt.Errorf("bind address '%s' is malformed, brackets must enclose an IPv6 address", bindAddr)
+		}
+		return host, nil
+	}
+
+	if strings.Contains(host, ":") {
+		if _, err := netip.ParseAddr(host); err != nil {
+			return "", fmt.Errorf("bind address '%s' is malformed, it must be a hostname or an IP address without a port: %w", bindAddr, err)
+		}
+	}
+
+	return host, nil
+}
+
+// newShutdownServerFunc returns the function which gracefully shuts down the server. The server stops accepting new
+// connections and waits for the in-flight requests to finish, up to the timeout, before closing their connections.
+func newShutdownServerFunc(server *http.Server, timeout time.Duration, lc logger.LoggingClient) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		if err := server.Shutdown(ctx); err != nil {
+			lc.Warnf("In-flight requests not finished within %s, closing their connections: %v", timeout.String(), err)
+			_ = server.Close()
+		}
+		lc.Info("Web server shut down")
+	}
+}
+
+// httpTLSConfig creates the TLS configuration for the HTTP server from the certificate and private key held in the
+// secret store at secretName. When client certificates are required they are verified against the CA certificate
+// held in the same secret, rejecting the callers without a valid certificate.
+func httpTLSConfig(secretName string, requireClientCert bool, secretProvider interfaces.SecretProvider) (*tls.Config, error) {
+	if len(secretName) == 0 {
+		return nil, errors.New("TLSSecretName or TLSCertFile must be set when client certificates are required")
+	}
+
+	tlsConfig, err := serverTLSConfig(secretName, secretProvider)
+	if err != nil || !requireClientCert {
+		return tlsConfig, err
+	}
+
+	secrets, err := secretProvider.GetSecret(secretName, HttpTLSCACertSecretKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the CA certificate from the TLS secret '%s': %w", secretName, err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM([]byte(secrets[HttpTLSCACertSecretKey])) {
+		return nil, fmt.Errorf("failed to parse the CA certificate from secret '%s'", secretName)
+	}
+
+	tlsConfig.ClientCAs = caPool
+	tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	return tlsConfig, nil
+}
+
+// RequestLimitMiddleware is a middleware function that limits the request body size to Service.MaxRequestSize in
+// kilobytes, which defaults to DefaultMaxRequestSize when 0 and is unlimited when negative. Requests declaring a larger
+// body are rejected up front, while bodies of unknown length are rejected once they're read past the limit, unless the
+// handler has already responded.
 func RequestLimitMiddleware(sizeLimit int64, lc logger.LoggingClient) echo.MiddlewareFunc {
+	if sizeLimit == 0 {
+		sizeLimit = DefaultMaxRequestSize
+	}
+
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			r := c.Request()
 			w := c.Response()
+			if sizeLimit < 0 {
+				return next(c)
+			}
+
 			switch r.Method {
 			case http.MethodPost, http.MethodPut, http.MethodPatch:
-				if sizeLimit > 0 && r.ContentLength > sizeLimit*1024 {
-					response := commonDTO.NewBaseResponse("", fmt.Sprintf("request size exceed Service.MaxRequestSize(%d KB)", sizeLimit), http.StatusRequestEntityTooLarge)
-					lc.Errorf(response.Message)
-
-					w.Header().Set(common.ContentType, common.ContentTypeJSON)
-					w.WriteHeader(response.StatusCode)
-					if err := json.NewEncoder(w).Encode(response); err != nil {
-						lc.Errorf("Error encoding the data:  %v", err)
-						// set Response.Committed to true in order to rewrite the status code
-						w.Committed = false
-						return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-					}
+				if r.ContentLength > sizeLimit*1024 {
+					return writeRequestTooLarge(w, sizeLimit, lc)
+				}
+
+				body := &limitedBody{ReadCloser: http.MaxBytesReader(w, r.Body, sizeLimit*1024)}
+				r.Body = body
+
+				err := next(c)
+				if body.exceeded && !w.Committed {
+					return writeRequestTooLarge(w, sizeLimit, lc)
 				}
+				return err
 			}
 			return next(c)
 		}
 	}
 }
 
+func writeRequestTooLarge(w *echo.Response, sizeLimit int64, lc logger.LoggingClient) error {
+	response := commonDTO.NewBaseResponse("", fmt.Sprintf("request size exceed Service.MaxRequestSize(%d KB)", sizeLimit), http.StatusRequestEntityTooLarge)
+	lc.Errorf(response.Message)
+
+	w.Header().Set(common.ContentType, common.ContentTypeJSON)
+	w.WriteHeader(response.StatusCode)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		lc.Errorf("Error encoding the data:  %v", err)
+		// set Response.Committed to true in order to rewrite the status code
+		w.Committed = false
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
+
+// limitedBody records whether the request body was read past its limit
+type limitedBody struct {
+	io.ReadCloser
+	exceeded bool
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		b.exceeded = true
+	}
+	return n, err
+}
+
+// ResponseLimitMiddleware is a middleware function that limits the response body size to Service.MaxResponseSize in
+// kilobytes, guarding against handlers streaming unbounded responses. The response is cut short with an error once
+// the limit is reached, as its status has already been sent. Responses are unlimited when the limit is 0.
+func ResponseLimitMiddleware(sizeLimit int64, lc logger.LoggingClient) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if sizeLimit <= 0 {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			w := c.Response()
+			w.Writer = &limitedResponseWriter{
+				ResponseWriter: w.Writer,
+				remaining:      sizeLimit * 1024,
+				sizeLimit:      sizeLimit,
+				path:           c.Request().URL.Path,
+				lc:             lc,
+			}
+			return next(c)
+		}
+	}
+}
+
+// limitedResponseWriter fails the writes past the response size limit
+type limitedResponseWriter struct {
+	http.ResponseWriter
+	remaining int64
+	sizeLimit int64
+	path      string
+	exceeded  bool
+	lc        logger.LoggingClient
+}
+
+func (w *limitedResponseWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) <= w.remaining {
+		w.remaining -= int64(len(p))
+		return w.ResponseWriter.Write(p)
+	}
+
+	n, err := w.ResponseWriter.Write(p[:w.remaining])
+	w.remaining -= int64(n)
+	if err != nil {
+		return n, err
+	}
+
+	if !w.exceeded {
+		w.exceeded = true
+		w.lc.Errorf("response to %s exceeds Service.MaxResponseSize(%d KB), response cut short", w.path, w.sizeLimit)
+	}
+	return n, errResponseTooLarge
+}
+
+func (w *limitedResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
 func mutator(srcCtx context.Context, c net.Conn) context.Context {
 	if zitiConn, ok := c.(edge.Conn); ok {
 		return context.WithValue(srcCtx, OpenZitiIdentityKey{}, zitiConn)
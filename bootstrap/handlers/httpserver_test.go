@@ -6,11 +6,18 @@
 package handlers
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -19,6 +26,8 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
 )
 
 func TestRequestLimitMiddleware(t *testing.T) {
@@ -30,7 +39,7 @@ func TestRequestLimitMiddleware(t *testing.T) {
 		sizeLimit     int64
 		errorExpected bool
 	}{
-		{"Valid unlimited size", int64(0), false},
+		{"Valid default size", int64(0), false},
 		{"Valid size", int64(2), false},
 		{"Invalid size", int64(1), true},
 	}
@@ -62,3 +71,314 @@ func TestRequestLimitMiddleware(t *testing.T) {
 		}
 	}
 }
+
+func TestRequestLimitMiddlewareBoundary(t *testing.T) {
+	e := echo.New()
+	lc := logger.NewMockClient()
+
+	// Reads the whole body as the handlers do, failing when it is over the limit
+	readingHandler := func(c echo.Context) error {
+		if _, err := io.ReadAll(c.Request().Body); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return c.NoContent(http.StatusOK)
+	}
+
+	tests := []struct {
+		name           string
+		sizeLimit      int64
+		size           int
+		chunked        bool
+		expectedStatus int
+	}{
+		{"just under the limit", 2, 2047, false, http.StatusOK},
+		{"at the limit", 2, 2048, false, http.StatusOK},
+		{"just over the limit", 2, 2049, false, http.StatusRequestEntityTooLarge},
+		{"chunked just under the limit", 2, 2048, true, http.StatusOK},
+		{"chunked just over the limit", 2, 2049, true, http.StatusRequestEntityTooLarge},
+		{"default limit", 0, 2049, true, http.StatusOK},
+		{"unlimited", -1, 64 * 1024, true, http.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := RequestLimitMiddleware(test.sizeLimit, lc)(readingHandler)
+
+			req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(strings.Repeat("x", test.size)))
+			require.NoError(t, err)
+			if test.chunked {
+				// The length of the body isn't known up front
+				req.ContentLength = -1
+			}
+
+			recorder := httptest.NewRecorder()
+			require.NoError(t, handler(e.NewContext(req, recorder)))
+			assert.Equal(t, test.expectedStatus, recorder.Code)
+		})
+	}
+}
+
+func TestResponseLimitMiddleware(t *testing.T) {
+	e := echo.New()
+	lc := logger.NewMockClient()
+
+	tests := []struct {
+		name          string
+		sizeLimit     int64
+		size          int
+		expectedSize  int
+		errorExpected bool
+	}{
+		{"unlimited", 0, 4096, 4096, false},
+		{"under the limit", 2, 2048, 2048, false},
+		{"over the limit", 2, 4096, 2048, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var writeErr error
+			handler := ResponseLimitMiddleware(test.sizeLimit, lc)(func(c echo.Context) error {
+				c.Response().WriteHeader(http.StatusOK)
+				_, writeErr = c.Response().Write([]byte(strings.Repeat("x", test.size)))
+				return nil
+			})
+
+			req, err := http.NewRequest(http.MethodGet, "/", http.NoBody)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			require.NoError(t, handler(e.NewContext(req, recorder)))
+			assert.Equal(t, test.expectedSize, recorder.Body.Len())
+			assert.Equal(t, test.errorExpected, writeErr != nil)
+		})
+	}
+}
+
+func TestShutdownServerDrainsInFlightRequests(t *testing.T) {
+	tests := []struct {
+		name              string
+		handlerDelay      time.Duration
+		timeout           time.Duration
+		expectedCompleted bool
+	}{
+		{"in-flight request completes", 200 * time.Millisecond, 5 * time.Second, true},
+		{"in-flight request exceeds timeout", 5 * time.Second, 200 * time.Millisecond, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			started := make(chan struct{})
+			server := &http.Server{
+				Handler: http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+					close(started)
+					select {
+					case <-time.After(test.handlerDelay):
+					case <-request.Context().Done():
+					}
+					writer.WriteHeader(http.StatusOK)
+				}),
+				ReadHeaderTimeout: 5 * time.Second,
+			}
+
+			listener, err := net.Listen("tcp", "localhost:0")
+			require.NoError(t, err)
+			go func() { _ = server.Serve(listener) }()
+			url := "http://" + listener.Addr().String()
+
+			responses := make(chan error, 1)
+			go func() {
+				response, err := http.Get(url)
+				if err == nil {
+					_ = response.Body.Close()
+					if response.StatusCode != http.StatusOK {
+						err = fmt.Errorf("unexpected status %d", response.StatusCode)
+					}
+				}
+				responses <- err
+			}()
+			<-started
+
+			shutdownDone := make(chan struct{})
+			go func() {
+				newShutdownServerFunc(server, test.timeout, logger.NewMockClient())()
+				close(shutdownDone)
+			}()
+
+			// New connections are refused once shutting down
+			require.Eventually(t, func() bool {
+				conn, err := net.Dial("tcp", listener.Addr().String())
+				if err != nil {
+					return true
+				}
+				_ = conn.Close()
+				return false
+			}, time.Second, 10*time.Millisecond)
+
+			select {
+			case <-shutdownDone:
+			case <-time.After(3 * time.Second):
+				require.Fail(t, "shutdown didn't finish")
+			}
+
+			err = <-responses
+			if test.expectedCompleted {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestHttpTLSConfig(t *testing.T) {
+	serverCertPEM, serverKeyPEM := newTestCertificate(t)
+	clientCertPEM, clientKeyPEM := newTestCertificate(t)
+	otherCertPEM, otherKeyPEM := newTestCertificate(t)
+
+	secretProvider := secret.NewInMemorySecretProvider(map[string]map[string]string{
+		"http-tls": {
+			GrpcTLSCertSecretKey:   string(serverCertPEM),
+			GrpcTLSKeySecretKey:    string(serverKeyPEM),
+			HttpTLSCACertSecretKey: string(clientCertPEM),
+		},
+		"no-ca": {
+			GrpcTLSCertSecretKey: string(serverCertPEM),
+			GrpcTLSKeySecretKey:  string(serverKeyPEM),
+		},
+	})
+
+	rootCAs := x509.NewCertPool()
+	require.True(t, rootCAs.AppendCertsFromPEM(serverCertPEM))
+	clientCert, err := tls.X509KeyPair(clientCertPEM, clientKeyPEM)
+	require.NoError(t, err)
+	otherCert, err := tls.X509KeyPair(otherCertPEM, otherKeyPEM)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		requireClientCert bool
+		clientCerts       []tls.Certificate
+		expectAccepted    bool
+	}{
+		{"one-way TLS without client certificate", false, nil, true},
+		{"mTLS with valid client certificate", true, []tls.Certificate{clientCert}, true},
+		{"mTLS without client certificate", true, nil, false},
+		{"mTLS with untrusted client certificate", true, []tls.Certificate{otherCert}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tlsConfig, err := httpTLSConfig("http-tls", test.requireClientCert, secretProvider)
+			require.NoError(t, err)
+
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+				writer.WriteHeader(http.StatusOK)
+			}))
+			server.TLS = tlsConfig
+			server.StartTLS()
+			defer server.Close()
+
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+				RootCAs:      rootCAs,
+				Certificates: test.clientCerts,
+				ServerName:   "localhost",
+				MinVersion:   tls.VersionTLS12,
+			}}}
+
+			response, err := client.Get(server.URL)
+			if !test.expectAccepted {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			defer func() { _ = response.Body.Close() }()
+			assert.Equal(t, http.StatusOK, response.StatusCode)
+		})
+	}
+
+	_, err = httpTLSConfig("no-ca", true, secretProvider)
+	assert.Error(t, err)
+	_, err = httpTLSConfig("", true, secretProvider)
+	assert.Error(t, err)
+}
+
+func TestHttpBindHost(t *testing.T) {
+	tests := []struct {
+		bindAddr      string
+		expected      string
+		expectedError bool
+	}{
+		{"", "", false},
+		{"0.0.0.0", "0.0.0.0", false},
+		{"localhost", "localhost", false},
+		{"core-data", "core-data", false},
+		{"::", "::", false},
+		{"::1", "::1", false},
+		{"[::1]", "::1", false},
+		{"fe80::1%eth0", "fe80::1%eth0", false},
+		{"[::1", "", true},
+		{"::1]", "", true},
+		{"[127.0.0.1]", "", true},
+		{"[::1]:59880", "", true},
+		{"127.0.0.1:59880", "", true},
+		{":::1", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.bindAddr, func(t *testing.T) {
+			host, err := httpBindHost(test.bindAddr)
+			if test.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, host)
+		})
+	}
+}
+
+func TestHttpBindHostListen(t *testing.T) {
+	probe, err := net.Listen("tcp", "[::1]:0")
+	if err != nil {
+		t.Skipf("IPv6 loopback not available: %v", err)
+	}
+	_ = probe.Close()
+
+	tests := []struct {
+		name     string
+		bindAddr string
+		dialAddr []string
+	}{
+		{"IPv6 loopback", "::1", []string{"::1"}},
+		{"bracketed IPv6 loopback", "[::1]", []string{"::1"}},
+		{"dual-stack", "::", []string{"::1", "127.0.0.1"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			host, err := httpBindHost(test.bindAddr)
+			require.NoError(t, err)
+			listener, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+			require.NoError(t, err)
+
+			server := &http.Server{
+				Handler: http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+					writer.WriteHeader(http.StatusOK)
+				}),
+				ReadHeaderTimeout: 5 * time.Second,
+			}
+			go func() { _ = server.Serve(listener) }()
+			defer func() { _ = server.Close() }()
+
+			port := strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+			for _, dialAddr := range test.dialAddr {
+				response, err := http.Get("http://" + net.JoinHostPort(dialAddr, port) + "/")
+				if dialAddr == "127.0.0.1" && err != nil {
+					t.Skipf("dual-stack not supported: %v", err)
+				}
+				require.NoError(t, err)
+				_ = response.Body.Close()
+				assert.Equal(t, http.StatusOK, response.StatusCode)
+			}
+		})
+	}
+}
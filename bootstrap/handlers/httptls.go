@@ -0,0 +1,164 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// httpServerTLS holds the HTTP server's TLS configuration, which is reloaded when the secret holding the certificate
+// is updated. The configuration is swapped atomically, so new handshakes use the new certificate while the listener
+// and the established connections are kept.
+type httpServerTLS struct {
+	serviceInfo    bootstrapConfig.ServiceInfo
+	secretProvider interfaces.SecretProvider
+	lc             logger.LoggingClient
+	current        atomic.Pointer[tls.Config]
+}
+
+// httpTLSEnabled returns whether the HTTP server is configured to use TLS
+func httpTLSEnabled(serviceInfo *bootstrapConfig.ServiceInfo) bool {
+	return len(serviceInfo.TLSSecretName) > 0 || len(serviceInfo.TLSCertFile) > 0 || serviceInfo.RequireClientCert
+}
+
+// newHttpServerTLS loads the HTTP server's TLS configuration from the TLSSecretName secret, falling back to the
+// TLSCertFile and TLSKeyFile files when the secret name isn't set, and registers for the secret's updates.
+func newHttpServerTLS(
+	serviceInfo *bootstrapConfig.ServiceInfo,
+	secretProvider interfaces.SecretProvider,
+	lc logger.LoggingClient) (*httpServerTLS, error) {
+	s := &httpServerTLS{
+		serviceInfo:    *serviceInfo,
+		secretProvider: secretProvider,
+		lc:             lc,
+	}
+
+	current, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	s.current.Store(current)
+
+	if len(serviceInfo.TLSSecretName) == 0 {
+		lc.Infof("HTTP server TLS certificate loaded from file '%s'", serviceInfo.TLSCertFile)
+		return s, nil
+	}
+
+	// The secret providers only hold one callback per secret name, so rotation is skipped rather than failing when
+	// the service registered its own
+	if err := secretProvider.RegisterSecretUpdatedCallback(serviceInfo.TLSSecretName, s.reload); err != nil {
+		lc.Warnf("HTTP server TLS certificate won't be rotated when secret '%s' is updated: %s",
+			serviceInfo.TLSSecretName, err.Error())
+	}
+
+	return s, nil
+}
+
+// tlsConfig returns the TLS configuration for the HTTP server's listeners, which gets the current certificate, and
+// the current client CAs when client certificates are required, on each handshake.
+func (s *httpServerTLS) tlsConfig() *tls.Config {
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: s.getCertificate,
+	}
+	if !s.serviceInfo.RequireClientCert {
+		return tlsConfig
+	}
+
+	tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		clientConfig := &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: s.getCertificate,
+			ClientAuth:     tls.RequireAndVerifyClientCert,
+			ClientCAs:      s.current.Load().ClientCAs,
+		}
+		return clientConfig, nil
+	}
+	return tlsConfig
+}
+
+func (s *httpServerTLS) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return &s.current.Load().Certificates[0], nil
+}
+
+// reload is the secret updated callback which replaces the current configuration. The current configuration is kept
+// when the updated secret isn't valid.
+func (s *httpServerTLS) reload(secretName string) {
+	updated, err := s.load()
+	if err != nil {
+		s.lc.Errorf("failed to reload the HTTP server TLS certificate from secret '%s', keeping the current one: %s",
+			secretName, err.Error())
+		return
+	}
+
+	s.current.Store(updated)
+
+	s.lc.Infof("HTTP server TLS certificate reloaded from secret '%s'", secretName)
+}
+
+func (s *httpServerTLS) load() (*tls.Config, error) {
+	if len(s.serviceInfo.TLSSecretName) > 0 || len(s.serviceInfo.TLSCertFile) == 0 {
+		return httpTLSConfig(s.serviceInfo.TLSSecretName, s.serviceInfo.RequireClientCert, s.secretProvider)
+	}
+
+	return httpFileTLSConfig(s.serviceInfo.TLSCertFile, s.serviceInfo.TLSKeyFile, s.serviceInfo.TLSCACertFile,
+		s.serviceInfo.RequireClientCert)
+}
+
+// httpFileTLSConfig creates the TLS configuration for the HTTP server from the certificate and private key files.
+// When client certificates are required they are verified against the CA certificate file.
+func httpFileTLSConfig(certFile string, keyFile string, caCertFile string, requireClientCert bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS certificate and key from files '%s' and '%s': %w", certFile, keyFile, err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if !requireClientCert {
+		return tlsConfig, nil
+	}
+
+	if len(caCertFile) == 0 {
+		return nil, errors.New("TLSCACertFile must be set when client certificates are required")
+	}
+
+	caCert, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CA certificate file '%s': %w", caCertFile, err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("failed to parse the CA certificate from file '%s'", caCertFile)
+	}
+
+	tlsConfig.ClientCAs = caPool
+	tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	return tlsConfig, nil
+}
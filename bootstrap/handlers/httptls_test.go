@@ -0,0 +1,228 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// startTLSServer starts a test server using the TLS configuration
+func startTLSServer(t *testing.T, tlsConfig *tls.Config) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = tlsConfig
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+// getTLS sends a request on a new connection, trusting only the caCertPEM
+func getTLS(t *testing.T, url string, caCertPEM []byte, clientCerts ...tls.Certificate) error {
+	rootCAs := x509.NewCertPool()
+	require.True(t, rootCAs.AppendCertsFromPEM(caCertPEM))
+	client := &http.Client{Transport: &http.Transport{
+		DisableKeepAlives: true,
+		TLSClientConfig: &tls.Config{
+			RootCAs:      rootCAs,
+			Certificates: clientCerts,
+			ServerName:   "localhost",
+			MinVersion:   tls.VersionTLS12,
+		},
+	}}
+
+	response, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	return nil
+}
+
+func TestHttpServerTLS_SecretRotation(t *testing.T) {
+	certPEM, keyPEM := newTestCertificate(t)
+	rotatedCertPEM, rotatedKeyPEM := newTestCertificate(t)
+
+	secretProvider := secret.NewInMemorySecretProvider(map[string]map[string]string{
+		"http-tls": {
+			GrpcTLSCertSecretKey: string(certPEM),
+			GrpcTLSKeySecretKey:  string(keyPEM),
+		},
+	})
+	serviceInfo := &bootstrapConfig.ServiceInfo{TLSSecretName: "http-tls"}
+	require.True(t, httpTLSEnabled(serviceInfo))
+
+	serverTLS, err := newHttpServerTLS(serviceInfo, secretProvider, logger.NewMockClient())
+	require.NoError(t, err)
+	server := startTLSServer(t, serverTLS.tlsConfig())
+
+	require.NoError(t, getTLS(t, server.URL, certPEM))
+
+	// an invalid update keeps the current certificate
+	require.NoError(t, secretProvider.StoreSecret("http-tls", map[string]string{GrpcTLSKeySecretKey: string(rotatedKeyPEM)}))
+	require.NoError(t, getTLS(t, server.URL, certPEM))
+
+	require.NoError(t, secretProvider.StoreSecret("http-tls", map[string]string{GrpcTLSCertSecretKey: string(rotatedCertPEM)}))
+	require.NoError(t, getTLS(t, server.URL, rotatedCertPEM))
+	assert.Error(t, getTLS(t, server.URL, certPEM))
+}
+
+func TestHttpServerTLS_CallbackAlreadyRegistered(t *testing.T) {
+	certPEM, keyPEM := newTestCertificate(t)
+	secretProvider := secret.NewInMemorySecretProvider(map[string]map[string]string{
+		"http-tls": {
+			GrpcTLSCertSecretKey: string(certPEM),
+			GrpcTLSKeySecretKey:  string(keyPEM),
+		},
+	})
+	require.NoError(t, secretProvider.RegisterSecretUpdatedCallback("http-tls", func(string) {}))
+
+	serverTLS, err := newHttpServerTLS(&bootstrapConfig.ServiceInfo{TLSSecretName: "http-tls"}, secretProvider, logger.NewMockClient())
+	require.NoError(t, err)
+	server := startTLSServer(t, serverTLS.tlsConfig())
+
+	assert.NoError(t, getTLS(t, server.URL, certPEM))
+}
+
+func TestHttpServerTLS_Files(t *testing.T) {
+	serverCertPEM, serverKeyPEM := newTestCertificate(t)
+	clientCertPEM, clientKeyPEM := newTestCertificate(t)
+	clientCert, err := tls.X509KeyPair(clientCertPEM, clientKeyPEM)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeFile := func(name string, contents []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, contents, 0600))
+		return path
+	}
+	certFile := writeFile("server.crt", serverCertPEM)
+	keyFile := writeFile("server.key", serverKeyPEM)
+	caCertFile := writeFile("ca.crt", clientCertPEM)
+
+	tests := []struct {
+		name           string
+		serviceInfo    bootstrapConfig.ServiceInfo
+		clientCerts    []tls.Certificate
+		expectedError  bool
+		expectAccepted bool
+	}{
+		{"one-way TLS", bootstrapConfig.ServiceInfo{TLSCertFile: certFile, TLSKeyFile: keyFile}, nil, false, true},
+		{"mTLS with valid client certificate", bootstrapConfig.ServiceInfo{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSCACertFile: caCertFile, RequireClientCert: true}, []tls.Certificate{clientCert}, false, true},
+		{"mTLS without client certificate", bootstrapConfig.ServiceInfo{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSCACertFile: caCertFile, RequireClientCert: true}, nil, false, false},
+		{"mTLS without CA file", bootstrapConfig.ServiceInfo{TLSCertFile: certFile, TLSKeyFile: keyFile, RequireClientCert: true}, nil, true, false},
+		{"missing key file", bootstrapConfig.ServiceInfo{TLSCertFile: certFile, TLSKeyFile: filepath.Join(dir, "missing.key")}, nil, true, false},
+		{"client certificates required without certificate", bootstrapConfig.ServiceInfo{RequireClientCert: true}, nil, true, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.True(t, httpTLSEnabled(&test.serviceInfo))
+
+			serverTLS, err := newHttpServerTLS(&test.serviceInfo, nil, logger.NewMockClient())
+			if test.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			server := startTLSServer(t, serverTLS.tlsConfig())
+
+			err = getTLS(t, server.URL, serverCertPEM, test.clientCerts...)
+			if test.expectAccepted {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	assert.False(t, httpTLSEnabled(&bootstrapConfig.ServiceInfo{}))
+}
+
+func TestHttpServerTLS_HotReload(t *testing.T) {
+	certPEM, keyPEM := newTestCertificate(t)
+	rotatedCertPEM, rotatedKeyPEM := newTestCertificate(t)
+
+	secretProvider := secret.NewInMemorySecretProvider(map[string]map[string]string{
+		"http-tls": {
+			GrpcTLSCertSecretKey: string(certPEM),
+			GrpcTLSKeySecretKey:  string(keyPEM),
+		},
+	})
+	serverTLS, err := newHttpServerTLS(&bootstrapConfig.ServiceInfo{TLSSecretName: "http-tls"}, secretProvider, logger.NewMockClient())
+	require.NoError(t, err)
+	server := startTLSServer(t, serverTLS.tlsConfig())
+
+	rootCAs := x509.NewCertPool()
+	require.True(t, rootCAs.AppendCertsFromPEM(certPEM))
+	require.True(t, rootCAs.AppendCertsFromPEM(rotatedCertPEM))
+	newClient := func() *http.Client {
+		return &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			RootCAs:    rootCAs,
+			ServerName: "localhost",
+			MinVersion: tls.VersionTLS12,
+		}}}
+	}
+	// get returns the certificate the server presented and whether the connection was reused
+	get := func(client *http.Client) (string, bool) {
+		reused := false
+		trace := &httptrace.ClientTrace{GotConn: func(info httptrace.GotConnInfo) { reused = info.Reused }}
+		request, err := http.NewRequestWithContext(httptrace.WithClientTrace(context.Background(), trace), http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		response, err := client.Do(request)
+		require.NoError(t, err)
+		defer func() { _ = response.Body.Close() }()
+		_, _ = io.Copy(io.Discard, response.Body)
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: response.TLS.PeerCertificates[0].Raw})), reused
+	}
+
+	established := newClient()
+	presented, _ := get(established)
+	assert.Equal(t, string(certPEM), presented)
+
+	require.NoError(t, secretProvider.StoreSecret("http-tls", map[string]string{
+		GrpcTLSCertSecretKey: string(rotatedCertPEM),
+		GrpcTLSKeySecretKey:  string(rotatedKeyPEM),
+	}))
+
+	// the established connection is kept
+	presented, reused := get(established)
+	assert.True(t, reused)
+	assert.Equal(t, string(certPEM), presented)
+
+	// a new handshake on the same listener uses the new certificate
+	presented, reused = get(newClient())
+	assert.False(t, reused)
+	assert.Equal(t, string(rotatedCertPEM), presented)
+}
@@ -0,0 +1,82 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	unixSocketScheme = "unix://"
+	// DefaultUnixSocketFileMode is the file mode of the HTTP server's Unix domain socket used when
+	// Service.UnixSocketFileMode isn't set
+	DefaultUnixSocketFileMode os.FileMode = 0660
+)
+
+// unixSocketPath returns the socket path of a unix:// bind address and whether the bind address is one
+func unixSocketPath(bindAddr string) (string, bool) {
+	if !strings.HasPrefix(bindAddr, unixSocketScheme) {
+		return "", false
+	}
+	return strings.TrimPrefix(bindAddr, unixSocketScheme), true
+}
+
+// parseUnixSocketFileMode parses the octal file mode of the Unix domain socket, defaulting to
+// DefaultUnixSocketFileMode when not set
+func parseUnixSocketFileMode(mode string) (os.FileMode, error) {
+	if len(mode) == 0 {
+		return DefaultUnixSocketFileMode, nil
+	}
+
+	value, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || value > uint64(os.ModePerm) {
+		return 0, fmt.Errorf("Service.UnixSocketFileMode '%s' is not a valid octal file mode", mode)
+	}
+	return os.FileMode(value), nil
+}
+
+// listenUnixSocket listens on the Unix domain socket at path with the file mode. A socket left behind by a previous
+// run is removed, while any other file at path fails the listen. The socket file is removed when the listener is
+// closed, i.e. when the server is shut down.
+func listenUnixSocket(path string, mode os.FileMode) (net.Listener, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("the %s bind address is missing the socket path", unixSocketScheme)
+	}
+
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("unable to listen on '%s' which exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove the stale socket '%s': %w", path, err)
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, mode); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to set the file mode of socket '%s': %w", path, err)
+	}
+
+	return listener, nil
+}
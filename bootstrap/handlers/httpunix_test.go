@@ -0,0 +1,137 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSocketDir creates a short temporary directory as socket paths are limited to around 100 characters
+func newSocketDir(t *testing.T) string {
+	dir, err := os.MkdirTemp("", "uds")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
+
+func TestUnixSocketPath(t *testing.T) {
+	path, ok := unixSocketPath("unix:///run/edgex/core-data.sock")
+	assert.True(t, ok)
+	assert.Equal(t, "/run/edgex/core-data.sock", path)
+
+	_, ok = unixSocketPath("0.0.0.0")
+	assert.False(t, ok)
+	_, ok = unixSocketPath("")
+	assert.False(t, ok)
+}
+
+func TestParseUnixSocketFileMode(t *testing.T) {
+	tests := []struct {
+		mode          string
+		expected      os.FileMode
+		expectedError bool
+	}{
+		{"", DefaultUnixSocketFileMode, false},
+		{"0600", 0600, false},
+		{"777", 0777, false},
+		{"0800", 0, true},
+		{"01777", 0, true},
+		{"rw", 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.mode, func(t *testing.T) {
+			mode, err := parseUnixSocketFileMode(test.mode)
+			if test.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, mode)
+		})
+	}
+}
+
+func TestListenUnixSocket(t *testing.T) {
+	path := filepath.Join(newSocketDir(t), "service.sock")
+
+	listener, err := listenUnixSocket(path, 0600)
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.NotZero(t, info.Mode()&os.ModeSocket)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			writer.WriteHeader(http.StatusOK)
+		}),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	served := make(chan error, 1)
+	go func() { served <- server.Serve(listener) }()
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", path)
+		},
+	}}
+	response, err := client.Get("http://localhost/api/v3/ping")
+	require.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	newShutdownServerFunc(server, time.Second, logger.NewMockClient())()
+	assert.ErrorIs(t, <-served, http.ErrServerClosed)
+
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "the socket file must be removed on shutdown")
+}
+
+func TestListenUnixSocketExistingFile(t *testing.T) {
+	dir := newSocketDir(t)
+
+	// a socket left behind by a previous run is replaced
+	stalePath := filepath.Join(dir, "stale.sock")
+	stale, err := net.Listen("unix", stalePath)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+
+	listener, err := listenUnixSocket(stalePath, DefaultUnixSocketFileMode)
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	// any other file is kept
+	filePath := filepath.Join(dir, "file.sock")
+	require.NoError(t, os.WriteFile(filePath, []byte("data"), 0600))
+	_, err = listenUnixSocket(filePath, DefaultUnixSocketFileMode)
+	assert.Error(t, err)
+	_, err = os.Stat(filePath)
+	assert.NoError(t, err)
+
+	_, err = listenUnixSocket("", DefaultUnixSocketFileMode)
+	assert.Error(t, err)
+}
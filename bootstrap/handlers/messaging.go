@@ -16,63 +16,125 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
 	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	boostrapMessaging "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/shutdown"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 )
 
+// MessageBusReadinessCheckName is the name of the readiness check which fails once the Messaging client has been
+// disconnected from the MessageBus. The checks for the additional named MessageBuses are suffixed with the name.
+const MessageBusReadinessCheckName = "MessageBus"
+
 // MessagingBootstrapHandler fulfills the BootstrapHandler contract.  If creates and initializes the Messaging client
-// and adds it to the DIC
+// and adds it to the DIC. A Messaging client is also created for each of the additional named MessageBuses and added
+// to the DIC under the name derived from the MessageBus's key, which are retrieved using
+// container.MessageClientFromName.
 func MessagingBootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
 	lc := container.LoggingClientFrom(dic.Get)
-	configuration := container.ConfigurationFrom(dic.Get)
+	bootstrapConfig := container.ConfigurationFrom(dic.Get).GetBootstrap()
 
-	messageBus := configuration.GetBootstrap().MessageBus
+	messageBus := bootstrapConfig.MessageBus
 	if messageBus.Disabled {
 		lc.Info("MessageBus is disabled in configuration, skipping setup.")
-		return true
+	} else {
+		if len(messageBus.Host) == 0 || messageBus.Port == 0 || len(messageBus.Protocol) == 0 || len(messageBus.Type) == 0 {
+			lc.Error("MessageBus configuration is incomplete, missing common config? Use -cp or -cc flags for common config.")
+			return false
+		}
+
+		if !connectMessageBus(ctx, wg, startupTimer, dic, "", *messageBus) {
+			return false
+		}
 	}
 
-	if len(messageBus.Host) == 0 || messageBus.Port == 0 || len(messageBus.Protocol) == 0 || len(messageBus.Type) == 0 {
-		lc.Error("MessageBus configuration is incomplete, missing common config? Use -cp or -cc flags for common config.")
-		return false
+	for name, namedMessageBus := range bootstrapConfig.MessageBuses {
+		if namedMessageBus.Disabled {
+			lc.Infof("'%s' MessageBus is disabled in configuration, skipping setup.", name)
+			continue
+		}
+
+		if len(namedMessageBus.Host) == 0 || namedMessageBus.Port == 0 || len(namedMessageBus.Protocol) == 0 || len(namedMessageBus.Type) == 0 {
+			lc.Errorf("'%s' MessageBus configuration is incomplete", name)
+			return false
+		}
+
+		if !connectMessageBus(ctx, wg, startupTimer, dic, name, namedMessageBus) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// connectMessageBus creates and connects the Messaging client for the MessageBus and adds it to the DIC. The name is
+// empty for the default MessageBus.
+func connectMessageBus(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	startupTimer startup.Timer,
+	dic *di.Container,
+	name string,
+	messageBus config.MessageBusInfo) bool {
+	lc := container.LoggingClientFrom(dic.Get)
+
+	label := "MessageBus"
+	clientName := container.MessagingClientName
+	readinessCheckName := MessageBusReadinessCheckName
+	if len(name) > 0 {
+		label = fmt.Sprintf("'%s' MessageBus", name)
+		clientName = container.MessagingClientNameFor(name)
+		readinessCheckName = MessageBusReadinessCheckName + "-" + name
 	}
 
 	// Make sure the MessageBus password is not leaked into the Service Config that can be retrieved via the /config endpoint
-	messageBusInfo := deepCopy(*messageBus)
+	messageBusInfo := deepCopy(messageBus)
 
 	if len(messageBusInfo.AuthMode) > 0 &&
 		!strings.EqualFold(strings.TrimSpace(messageBusInfo.AuthMode), boostrapMessaging.AuthModeNone) {
 		if err := boostrapMessaging.SetOptionsAuthData(&messageBusInfo, lc, dic); err != nil {
-			lc.Errorf("setting the MessageBus auth options failed: %v", err)
+			lc.Errorf("setting the %s auth options failed: %v", label, err)
 			return false
 		}
 	}
 
-	msgClient, err := messaging.NewMessageClient(
-		types.MessageBusConfig{
-			Broker: types.HostInfo{
-				Host:     messageBusInfo.Host,
-				Port:     messageBusInfo.Port,
-				Protocol: messageBusInfo.Protocol,
-			},
-			Type:     messageBusInfo.Type,
-			Optional: messageBusInfo.Optional,
-		})
+	if err := boostrapMessaging.SetOptionsTLSData(&messageBusInfo, lc, dic); err != nil {
+		lc.Errorf("setting the %s TLS options failed: %v", label, err)
+		return false
+	}
 
+	messageBusConfig := types.MessageBusConfig{
+		Broker: types.HostInfo{
+			Host:     messageBusInfo.Host,
+			Port:     messageBusInfo.Port,
+			Protocol: messageBusInfo.Protocol,
+		},
+		Type:     messageBusInfo.Type,
+		Optional: messageBusInfo.Optional,
+	}
+
+	client, err := messaging.NewMessageClient(messageBusConfig)
 	if err != nil {
-		lc.Errorf("Failed to create MessageClient: %v", err)
+		lc.Errorf("Failed to create MessageClient for %s: %v", label, err)
 		return false
 	}
 
+	// The connection is re-established, with the topics re-subscribed, when lost so the service doesn't need to be
+	// restarted once the broker is available again
+	msgClient := boostrapMessaging.NewReconnectingMessageClient(client, func() (messaging.MessageClient, error) {
+		return messaging.NewMessageClient(messageBusConfig)
+	}, lc)
+
 	for startupTimer.HasNotElapsed() {
 		select {
 		case <-ctx.Done():
@@ -80,30 +142,53 @@ func MessagingBootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupT
 		default:
 			err = msgClient.Connect()
 			if err != nil {
-				lc.Warnf("Unable to connect MessageBus: %s", err.Error())
+				lc.Warnf("Unable to connect %s: %s", label, err.Error())
 				startupTimer.SleepForInterval()
 				continue
 			}
 
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				<-ctx.Done()
-				if msgClient != nil {
-					_ = msgClient.Disconnect()
-				}
-				lc.Infof("Disconnected from MessageBus")
-			}()
+			var disconnected atomic.Bool
+			disconnect := func() {
+				disconnected.Store(true)
+				_ = msgClient.Disconnect()
+				lc.Infof("Disconnected from %s", label)
+			}
+
+			// The client is disconnected in priority order with the other resources when the shutdown registry is
+			// available, so the servers stop accepting requests which publish to the MessageBus first
+			if shutdownRegistry := container.ShutdownRegistryFrom(dic.Get); shutdownRegistry != nil {
+				shutdownRegistry.Register(label+" client", shutdown.PriorityClients, disconnect)
+			} else {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					<-ctx.Done()
+					disconnect()
+				}()
+			}
 
 			dic.Update(di.ServiceConstructorMap{
-				container.MessagingClientName: func(get di.Get) interface{} {
+				clientName: func(get di.Get) interface{} {
 					return msgClient
 				},
 			})
 
+			if readiness := container.ReadinessFrom(dic.Get); readiness != nil {
+				readiness.RegisterCheck(readinessCheckName, func() error {
+					if disconnected.Load() {
+						return fmt.Errorf("disconnected from the %s", label)
+					}
+					if msgClient.IsReconnecting() {
+						return fmt.Errorf("reconnecting to the %s", label)
+					}
+					return nil
+				})
+			}
+
 			lc.Infof(
-				"Connected to %s Message Bus @ %s://%s:%d with AuthMode='%s'",
+				"Connected to %s %s @ %s://%s:%d with AuthMode='%s'",
 				messageBusInfo.Type,
+				label,
 				messageBusInfo.Protocol,
 				messageBusInfo.Host,
 				messageBusInfo.Port,
@@ -113,7 +198,7 @@ func MessagingBootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupT
 		}
 	}
 
-	lc.Error("Connecting to MessageBus time out")
+	lc.Errorf("Connecting to %s time out", label)
 	return false
 }
 
@@ -126,6 +211,7 @@ func deepCopy(target config.MessageBusInfo) config.MessageBusInfo {
 		Port:            target.Port,
 		AuthMode:        target.AuthMode,
 		SecretName:      target.SecretName,
+		TLSSecretName:   target.TLSSecretName,
 		BaseTopicPrefix: target.BaseTopicPrefix,
 	}
 
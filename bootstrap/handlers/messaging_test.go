@@ -10,6 +10,7 @@ import (
 	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
@@ -116,3 +117,73 @@ func TestBootstrapHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestBootstrapHandler_NamedMessageBuses(t *testing.T) {
+	defaultMessageBus := config.MessageBusInfo{
+		Type:     messaging.Redis,
+		Protocol: "redis",
+		Host:     "localhost",
+		Port:     6379,
+		AuthMode: boostrapMessaging.AuthModeNone,
+	}
+
+	ingest := defaultMessageBus
+	ingest.Host = "ingest"
+
+	publish := defaultMessageBus
+	publish.Host = "publish"
+
+	disabled := defaultMessageBus
+	disabled.Disabled = true
+
+	configMock := &mocks.Configuration{}
+	configMock.On("GetBootstrap").Return(config.BootstrapConfiguration{
+		MessageBus: &defaultMessageBus,
+		MessageBuses: map[string]config.MessageBusInfo{
+			"ingest":   ingest,
+			"publish":  publish,
+			"disabled": disabled,
+		},
+	})
+
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return configMock
+		},
+		container.MessagingClientName: func(get di.Get) interface{} {
+			return nil
+		},
+	})
+
+	actual := MessagingBootstrapHandler(context.Background(), &sync.WaitGroup{}, startup.NewTimer(1, 1), dic)
+	require.True(t, actual)
+
+	defaultClient := container.MessagingClientFrom(dic.Get)
+	ingestClient := container.MessageClientFromName(dic.Get, "ingest")
+	publishClient := container.MessageClientFromName(dic.Get, "publish")
+	require.NotNil(t, defaultClient)
+	require.NotNil(t, ingestClient)
+	require.NotNil(t, publishClient)
+	assert.NotSame(t, ingestClient, publishClient)
+	assert.NotSame(t, defaultClient, ingestClient)
+	assert.Nil(t, container.MessageClientFromName(dic.Get, "disabled"))
+	assert.Nil(t, container.MessageClientFromName(dic.Get, "unknown"))
+}
+
+func TestBootstrapHandler_NamedMessageBusIncomplete(t *testing.T) {
+	configMock := &mocks.Configuration{}
+	configMock.On("GetBootstrap").Return(config.BootstrapConfiguration{
+		MessageBus: &config.MessageBusInfo{Disabled: true},
+		MessageBuses: map[string]config.MessageBusInfo{
+			"ingest": {Type: messaging.Redis},
+		},
+	})
+
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return configMock
+		},
+	})
+
+	assert.False(t, MessagingBootstrapHandler(context.Background(), &sync.WaitGroup{}, startup.NewTimer(1, 1), dic))
+}
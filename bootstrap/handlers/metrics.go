@@ -26,15 +26,20 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/metrics"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/shutdown"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 )
 
+// newMessageBusReporter creates the MetricsReporter which publishes the metrics, replaced by the tests
+var newMessageBusReporter = metrics.NewMessageBusReporter
+
 type RegisterTelemetryFunc func(logger.LoggingClient, *config.TelemetryInfo, interfaces.MetricsManager)
 
 type ServiceMetrics struct {
-	serviceName string
+	serviceName        string
+	topicSubstitutions map[string]string
 }
 
 func NewServiceMetrics(serviceName string) *ServiceMetrics {
@@ -43,6 +48,13 @@ func NewServiceMetrics(serviceName string) *ServiceMetrics {
 	}
 }
 
+// WithTopicSubstitutions sets the values the {name} placeholders in the Telemetry PublishTopicPrefix are expanded to,
+// i.e. {"env": "production"}. The {service} placeholder is always expanded to the service name.
+func (s *ServiceMetrics) WithTopicSubstitutions(substitutions map[string]string) *ServiceMetrics {
+	s.topicSubstitutions = substitutions
+	return s
+}
+
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization of service metrics.
 func (s *ServiceMetrics) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	lc := container.LoggingClientFrom(dic.Get)
@@ -50,6 +62,13 @@ func (s *ServiceMetrics) BootstrapHandler(ctx context.Context, wg *sync.WaitGrou
 
 	telemetryConfig := serviceConfig.GetTelemetryInfo()
 
+	// The service's key from the DIC is used when set, so a key overridden with the -sk/--serviceKey flag
+	// distinguishes the metrics of the service's instances
+	serviceName := s.serviceName
+	if serviceKey := container.ServiceKeyFrom(dic.Get); len(serviceKey) > 0 {
+		serviceName = serviceKey
+	}
+
 	if telemetryConfig.Interval == "" {
 		telemetryConfig.Interval = "0s"
 	}
@@ -60,17 +79,79 @@ func (s *ServiceMetrics) BootstrapHandler(ctx context.Context, wg *sync.WaitGrou
 		return false
 	}
 
-	if interval == 0 {
+	telemetryDisabled := interval == 0
+	if telemetryDisabled {
 		lc.Infof("0 specified for metrics reporting interval. Setting to max duration to effectively disable reporting.")
 		interval = math.MaxInt64
 	}
 
+	metricIntervals, err := telemetryConfig.GetMetricIntervals()
+	if err != nil {
+		lc.Errorf("Telemetry metric intervals are invalid: %s", err.Error())
+		return false
+	}
+
+	if len(telemetryConfig.PublishTopicPrefix) > 0 {
+		substitutions := map[string]string{metrics.ServiceTopicPlaceholder: serviceName}
+		for name, value := range s.topicSubstitutions {
+			substitutions[name] = value
+		}
+
+		if _, err := metrics.ExpandTopicTemplate(telemetryConfig.PublishTopicPrefix, substitutions); err != nil {
+			lc.Errorf("Telemetry PublishTopicPrefix is invalid: %s", err.Error())
+			return false
+		}
+	}
+
 	baseTopic := serviceConfig.GetBootstrap().MessageBus.GetBaseTopicPrefix()
-	reporter := metrics.NewMessageBusReporter(lc, baseTopic, s.serviceName, dic, telemetryConfig)
-	manager := metrics.NewManager(lc, interval, reporter)
+	reporter := newMessageBusReporter(lc, baseTopic, serviceName, dic, telemetryConfig,
+		metrics.WithTopicSubstitutions(s.topicSubstitutions))
+	var options []metrics.ManagerOption
+	if telemetryDisabled {
+		// The Null reporter allows the metrics to still be registered and reported without publishing them. The
+		// MessageBus reporter replaces it if telemetry is enabled at runtime by setting the Writable interval.
+		options = append(options, metrics.WithEnabledReporter(reporter))
+		reporter = metrics.NewNullReporter()
+	}
+	if telemetryConfig.RuntimeMetrics {
+		options = append(options, metrics.WithRuntimeMetrics())
+	}
+
+	manager := metrics.NewManager(lc, interval, reporter, options...)
+	manager.ResetMetricIntervals(metricIntervals)
+
+	// The request latency Timers are registered by the HTTP server's middleware as the routes are first requested
+	if telemetryConfig.RequestLatencyMetrics {
+		manager.SetMetricEnabled(HttpRequestLatencyMetricName, true)
+	}
 
 	manager.Run(ctx, wg)
 
+	// The metrics recorded since the last report are flushed on shutdown, which the Null reporter discards while
+	// telemetry is disabled
+	flushTimeout := metricsFlushTimeout(serviceConfig.GetBootstrap().Service)
+	flush := func() {
+		flushCtx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+		defer cancel()
+
+		if err := manager.Flush(flushCtx); err != nil {
+			lc.Errorf("unable to flush the metrics on shutdown: %s", err.Error())
+		}
+	}
+
+	// The metrics are flushed in priority order with the other resources when the shutdown registry is available,
+	// so they are published before the MessageBus is disconnected
+	if shutdownRegistry := container.ShutdownRegistryFrom(dic.Get); shutdownRegistry != nil {
+		shutdownRegistry.Register("Metrics Manager", shutdown.PriorityMetrics, flush)
+	} else {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-ctx.Done()
+			flush()
+		}()
+	}
+
 	dic.Update(di.ServiceConstructorMap{
 		container.MetricsManagerInterfaceName: func(get di.Get) interface{} {
 			return manager
@@ -79,3 +160,18 @@ func (s *ServiceMetrics) BootstrapHandler(ctx context.Context, wg *sync.WaitGrou
 
 	return true
 }
+
+// metricsFlushTimeout returns the time allowed for flushing the metrics on shutdown, which is the Service's
+// ShutdownTimeout when set and valid, otherwise the defaultShutdownTimeout
+func metricsFlushTimeout(serviceInfo *config.ServiceInfo) time.Duration {
+	if serviceInfo == nil || len(serviceInfo.ShutdownTimeout) == 0 {
+		return defaultShutdownTimeout
+	}
+
+	timeout, err := time.ParseDuration(serviceInfo.ShutdownTimeout)
+	if err != nil || timeout <= 0 {
+		return defaultShutdownTimeout
+	}
+
+	return timeout
+}
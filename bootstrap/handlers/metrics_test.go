@@ -18,13 +18,19 @@ package handlers
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
 	mocks2 "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/metrics"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/shutdown"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -34,17 +40,25 @@ import (
 
 func TestServiceMetrics_BootstrapHandler(t *testing.T) {
 	tests := []struct {
-		Name           string
-		Interval       string
-		ExpectedResult bool
+		Name               string
+		Interval           string
+		MetricIntervals    map[string]string
+		PublishTopicPrefix string
+		ExpectedResult     bool
 	}{
-		{"Happy Path", "5s", true},
-		{"Invalid Interval", "five seconds", false},
+		{"Happy Path", "5s", nil, "", true},
+		{"Happy Path with telemetry disabled", "0s", nil, "", true},
+		{"Happy Path with metric intervals", "5s", map[string]string{"MyMetric": "1s"}, "", true},
+		{"Happy Path with plain topic prefix", "5s", nil, "edgex/metrics", true},
+		{"Happy Path with templated topic prefix", "5s", nil, "edgex/{env}/{service}", true},
+		{"Invalid Interval", "five seconds", nil, "", false},
+		{"Invalid metric interval", "5s", map[string]string{"MyMetric": "1ms"}, "", false},
+		{"Unresolved topic prefix placeholder", "5s", nil, "edgex/{tenant}/{service}", false},
 	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			target := NewServiceMetrics("unit-test")
+			target := NewServiceMetrics("unit-test").WithTopicSubstitutions(map[string]string{"env": "test"})
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
@@ -67,9 +81,11 @@ func TestServiceMetrics_BootstrapHandler(t *testing.T) {
 			})
 
 			expectedTelemetryInfo := config.TelemetryInfo{
-				Interval: test.Interval,
-				Metrics:  make(map[string]bool),
-				Tags:     make(map[string]string),
+				Interval:           test.Interval,
+				Metrics:            make(map[string]bool),
+				Tags:               make(map[string]string),
+				MetricIntervals:    test.MetricIntervals,
+				PublishTopicPrefix: test.PublishTopicPrefix,
 			}
 
 			mockConfiguration.On("GetTelemetryInfo").Return(&expectedTelemetryInfo)
@@ -86,3 +102,152 @@ func TestServiceMetrics_BootstrapHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceMetrics_BootstrapHandler_ServiceKeyOverride(t *testing.T) {
+	var reportedServiceName string
+	defaultNewMessageBusReporter := newMessageBusReporter
+	newMessageBusReporter = func(_ logger.LoggingClient, _ string, serviceName string, _ *di.Container,
+		_ *config.TelemetryInfo, _ ...metrics.ReporterOption) interfaces.MetricsReporter {
+		reportedServiceName = serviceName
+		return metrics.NewNullReporter()
+	}
+	defer func() { newMessageBusReporter = defaultNewMessageBusReporter }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockConfiguration := &mocks2.Configuration{}
+	mockConfiguration.On("GetBootstrap").Return(config.BootstrapConfiguration{
+		MessageBus: &config.MessageBusInfo{},
+	})
+	mockConfiguration.On("GetTelemetryInfo").Return(&config.TelemetryInfo{Interval: "5s"})
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return mockConfiguration
+		},
+		container.ServiceKeyName: func(get di.Get) interface{} {
+			return "core-data-2"
+		},
+	})
+
+	target := NewServiceMetrics("core-data")
+	require.True(t, target.BootstrapHandler(ctx, &sync.WaitGroup{}, startup.NewTimer(5, 1), dic))
+	require.Equal(t, "core-data-2", reportedServiceName)
+}
+
+func TestServiceMetrics_BootstrapHandler_FlushOnShutdown(t *testing.T) {
+	var reportCtxErr error
+	mockReporter := &mocks2.MetricsReporter{}
+	mockReporter.On("ReportWithContext", mock.Anything, mock.Anything, mock.Anything).Return(0, nil).
+		Run(func(args mock.Arguments) {
+			reportCtxErr = args.Get(0).(context.Context).Err()
+		})
+	defaultNewMessageBusReporter := newMessageBusReporter
+	newMessageBusReporter = func(_ logger.LoggingClient, _ string, _ string, _ *di.Container,
+		_ *config.TelemetryInfo, _ ...metrics.ReporterOption) interfaces.MetricsReporter {
+		return mockReporter
+	}
+	defer func() { newMessageBusReporter = defaultNewMessageBusReporter }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockConfiguration := &mocks2.Configuration{}
+	mockConfiguration.On("GetBootstrap").Return(config.BootstrapConfiguration{
+		Service:    &config.ServiceInfo{ShutdownTimeout: "5s"},
+		MessageBus: &config.MessageBusInfo{},
+	})
+	mockConfiguration.On("GetTelemetryInfo").Return(&config.TelemetryInfo{Interval: "1h"})
+
+	lc := logger.NewMockClient()
+	shutdownRegistry := shutdown.NewRegistry(lc)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return lc
+		},
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return mockConfiguration
+		},
+		container.ShutdownRegistryInterfaceName: func(get di.Get) interface{} {
+			return shutdownRegistry
+		},
+	})
+
+	wg := &sync.WaitGroup{}
+	require.True(t, NewServiceMetrics("unit-test").BootstrapHandler(ctx, wg, startup.NewTimer(5, 1), dic))
+	mockReporter.AssertNotCalled(t, "ReportWithContext", mock.Anything, mock.Anything, mock.Anything)
+
+	// The flush runs with the other shutdown cleanups once the context is cancelled
+	cancel()
+	wg.Wait()
+	shutdownRegistry.Run()
+	mockReporter.AssertCalled(t, "ReportWithContext", mock.Anything, mock.Anything, mock.Anything)
+
+	// The flush's context is not cancelled while reporting, so the report isn't abandoned
+	assert.NoError(t, reportCtxErr)
+}
+
+func TestServiceMetrics_BootstrapHandler_EnableTelemetryAtRuntime(t *testing.T) {
+	var published atomic.Bool
+	mockReporter := &mocks2.MetricsReporter{}
+	mockReporter.On("ReportWithContext", mock.Anything, mock.Anything, mock.Anything).Return(0, nil).
+		Run(func(_ mock.Arguments) {
+			published.Store(true)
+		})
+	defaultNewMessageBusReporter := newMessageBusReporter
+	newMessageBusReporter = func(_ logger.LoggingClient, _ string, _ string, _ *di.Container,
+		_ *config.TelemetryInfo, _ ...metrics.ReporterOption) interfaces.MetricsReporter {
+		return mockReporter
+	}
+	defer func() { newMessageBusReporter = defaultNewMessageBusReporter }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockConfiguration := &mocks2.Configuration{}
+	mockConfiguration.On("GetBootstrap").Return(config.BootstrapConfiguration{
+		MessageBus: &config.MessageBusInfo{},
+	})
+	mockConfiguration.On("GetTelemetryInfo").Return(&config.TelemetryInfo{Interval: "0s"})
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return mockConfiguration
+		},
+	})
+
+	require.True(t, NewServiceMetrics("unit-test").BootstrapHandler(ctx, &sync.WaitGroup{}, startup.NewTimer(5, 1), dic))
+	manager := container.MetricsManagerFrom(dic.Get)
+	require.NotNil(t, manager)
+
+	// Telemetry is enabled by the Writable interval being changed from 0
+	manager.ResetInterval(10 * time.Millisecond)
+
+	assert.Eventually(t, published.Load, time.Second, 10*time.Millisecond, "metrics not published once telemetry enabled at runtime")
+}
+
+func TestMetricsFlushTimeout(t *testing.T) {
+	tests := []struct {
+		Name        string
+		ServiceInfo *config.ServiceInfo
+		Expected    time.Duration
+	}{
+		{"No Service", nil, defaultShutdownTimeout},
+		{"Not set", &config.ServiceInfo{}, defaultShutdownTimeout},
+		{"Set", &config.ServiceInfo{ShutdownTimeout: "10s"}, time.Second * 10},
+		{"Invalid", &config.ServiceInfo{ShutdownTimeout: "ten seconds"}, defaultShutdownTimeout},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert.Equal(t, test.Expected, metricsFlushTimeout(test.ServiceInfo))
+		})
+	}
+}
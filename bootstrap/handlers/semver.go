@@ -0,0 +1,228 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// semanticVersion is a parsed semantic version, i.e. 3.1.0-dev.20, the build metadata of which is ignored
+type semanticVersion struct {
+	core       [3]int
+	preRelease []string
+}
+
+func parseVersion(version string) (semanticVersion, error) {
+	result := semanticVersion{}
+
+	value := strings.TrimPrefix(version, "v")
+	value, _, _ = strings.Cut(value, "+")
+	value, preRelease, hasPreRelease := strings.Cut(value, "-")
+	if hasPreRelease {
+		if len(preRelease) == 0 {
+			return result, fmt.Errorf("'%s' is not a semantic version", version)
+		}
+		result.preRelease = strings.Split(preRelease, ".")
+	}
+
+	parts := strings.Split(value, ".")
+	if len(parts) != len(result.core) {
+		return result, fmt.Errorf("'%s' is not a semantic version", version)
+	}
+
+	for index, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return result, fmt.Errorf("'%s' is not a semantic version", version)
+		}
+		result.core[index] = number
+	}
+
+	return result, nil
+}
+
+// compareVersions returns a negative number when the version precedes the other version, zero when they are equal and
+// a positive number otherwise, following the semantic versioning precedence rules, so 3.1.0-dev.20 precedes 3.1.0
+func compareVersions(version semanticVersion, other semanticVersion) int {
+	for index := range version.core {
+		if version.core[index] != other.core[index] {
+			return version.core[index] - other.core[index]
+		}
+	}
+
+	// A pre-release precedes the release of the same version
+	switch {
+	case len(version.preRelease) == 0 && len(other.preRelease) == 0:
+		return 0
+	case len(version.preRelease) == 0:
+		return 1
+	case len(other.preRelease) == 0:
+		return -1
+	}
+
+	for index := 0; index < len(version.preRelease) && index < len(other.preRelease); index++ {
+		if compared := comparePreRelease(version.preRelease[index], other.preRelease[index]); compared != 0 {
+			return compared
+		}
+	}
+
+	return len(version.preRelease) - len(other.preRelease)
+}
+
+// comparePreRelease compares pre-release identifiers, numeric identifiers numerically and precede alphanumeric ones
+func comparePreRelease(identifier string, other string) int {
+	number, numberErr := strconv.Atoi(identifier)
+	otherNumber, otherErr := strconv.Atoi(other)
+
+	switch {
+	case numberErr == nil && otherErr == nil:
+		return number - otherNumber
+	case numberErr == nil:
+		return -1
+	case otherErr == nil:
+		return 1
+	default:
+		return strings.Compare(identifier, other)
+	}
+}
+
+// versionOperators are the operators of the version comparators, longest first so '>=' isn't parsed as '>'
+var versionOperators = []string{">=", "<=", "!=", ">", "<", "="}
+
+// versionComparator compares versions to a version with an operator, i.e. >=2.3.0
+type versionComparator struct {
+	operator string
+	version  semanticVersion
+	text     string
+}
+
+func (c versionComparator) isSatisfiedBy(version semanticVersion) bool {
+	compared := compareVersions(version, c.version)
+
+	switch c.operator {
+	case ">=":
+		return compared >= 0
+	case "<=":
+		return compared <= 0
+	case "!=":
+		return compared != 0
+	case ">":
+		return compared > 0
+	case "<":
+		return compared < 0
+	default:
+		return compared == 0
+	}
+}
+
+// versionConstraint is a semantic version range, i.e. '>=2.3.0 <3.0.0 || >=3.1.0', which is the alternatives
+// separated by '||' of the space separated comparators which all must be satisfied. A version without an operator must
+// match exactly. Pre-releases are compared by their precedence, so 3.0.0-dev.1 satisfies '<3.0.0'.
+type versionConstraint [][]versionComparator
+
+func parseVersionConstraint(expression string) (versionConstraint, error) {
+	var result versionConstraint
+
+	for _, alternative := range strings.Split(expression, "||") {
+		var comparators []versionComparator
+
+		fields := strings.Fields(alternative)
+		for index := 0; index < len(fields); index++ {
+			text := fields[index]
+			// The operator may be separated from the version, i.e. '>= 2.3.0'
+			if isVersionOperator(text) && index+1 < len(fields) {
+				index++
+				text += fields[index]
+			}
+
+			comparator, err := parseVersionComparator(text)
+			if err != nil {
+				return nil, fmt.Errorf("version constraint '%s' is invalid: %s", expression, err.Error())
+			}
+			comparators = append(comparators, comparator)
+		}
+
+		if len(comparators) == 0 {
+			return nil, fmt.Errorf("version constraint '%s' is invalid: empty constraint", expression)
+		}
+		result = append(result, comparators)
+	}
+
+	return result, nil
+}
+
+func isVersionOperator(text string) bool {
+	for _, operator := range versionOperators {
+		if text == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func parseVersionComparator(text string) (versionComparator, error) {
+	comparator := versionComparator{operator: "=", text: text}
+
+	value := text
+	for _, operator := range versionOperators {
+		if strings.HasPrefix(text, operator) {
+			comparator.operator = operator
+			value = strings.TrimPrefix(text, operator)
+			break
+		}
+	}
+
+	version, err := parseVersion(value)
+	if err != nil {
+		return comparator, err
+	}
+	comparator.version = version
+
+	return comparator, nil
+}
+
+// isSatisfiedBy returns whether the version satisfies all the comparators of any of the constraint's alternatives
+func (c versionConstraint) isSatisfiedBy(version semanticVersion) bool {
+	for _, comparators := range c {
+		satisfied := true
+		for _, comparator := range comparators {
+			if !comparator.isSatisfiedBy(version) {
+				satisfied = false
+				break
+			}
+		}
+
+		if satisfied {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c versionConstraint) String() string {
+	alternatives := make([]string, len(c))
+	for index, comparators := range c {
+		texts := make([]string, len(comparators))
+		for comparatorIndex, comparator := range comparators {
+			texts[comparatorIndex] = comparator.text
+		}
+		alternatives[index] = strings.Join(texts, " ")
+	}
+
+	return strings.Join(alternatives, " || ")
+}
@@ -0,0 +1,125 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		Version  string
+		Other    string
+		Expected int
+	}{
+		{"3.1.0", "3.1.0", 0},
+		{"v3.1.0", "3.1.0", 0},
+		{"3.1.0+build.5", "3.1.0", 0},
+		{"3.1.1", "3.1.0", 1},
+		{"3.0.9", "3.1.0", -1},
+		{"2.9.0", "3.0.0", -1},
+		{"10.0.0", "9.0.0", 1},
+		{"3.1.0-dev.20", "3.1.0", -1},
+		{"3.1.0", "3.1.0-dev.20", 1},
+		{"3.1.0-dev.9", "3.1.0-dev.20", -1},
+		{"3.1.0-dev", "3.1.0-dev.1", -1},
+		{"3.1.0-alpha", "3.1.0-beta", -1},
+		{"3.1.0-1", "3.1.0-alpha", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Version+" vs "+test.Other, func(t *testing.T) {
+			version, err := parseVersion(test.Version)
+			require.NoError(t, err)
+			other, err := parseVersion(test.Other)
+			require.NoError(t, err)
+
+			actual := compareVersions(version, other)
+			switch {
+			case test.Expected < 0:
+				assert.Negative(t, actual)
+			case test.Expected > 0:
+				assert.Positive(t, actual)
+			default:
+				assert.Zero(t, actual)
+			}
+		})
+	}
+}
+
+func TestParseVersion_Invalid(t *testing.T) {
+	for _, version := range []string{"", "3.1", "3.1.0.0", "3.1.0-", "three", "3.x.0", "3.1.-1"} {
+		t.Run(version, func(t *testing.T) {
+			_, err := parseVersion(version)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestVersionConstraint(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Constraint string
+		Version    string
+		Expected   bool
+	}{
+		{"In range", ">=2.3.0 <3.0.0", "2.5.1", true},
+		{"In range lower bound", ">=2.3.0 <3.0.0", "2.3.0", true},
+		{"Below range", ">=2.3.0 <3.0.0", "2.2.9", false},
+		{"Above range", ">=2.3.0 <3.0.0", "3.0.0", false},
+		{"Pre-release below range", ">=2.3.0 <3.0.0", "2.3.0-dev.1", false},
+		{"Pre-release in range", ">=2.3.0 <3.0.0", "3.0.0-dev.1", true},
+		{"Pre-release excluded from range", ">=2.3.0 <3.0.0-0", "3.0.0-dev.1", false},
+		{"Pre-release lower bound", ">=3.1.0-dev.1", "3.1.0-dev.20", true},
+		{"Operator separated from version", ">= 2.3.0 < 3.0.0", "2.5.1", true},
+		{"Exact", "2.3.0", "2.3.0", true},
+		{"Exact with operator", "=2.3.0", "2.3.1", false},
+		{"Greater than", ">2.3.0", "2.3.0", false},
+		{"Less than or equal", "<=2.3.0", "2.3.0", true},
+		{"Not equal", "!=2.3.0", "2.3.0", false},
+		{"First alternative", "<2.0.0 || >=3.0.0", "1.5.0", true},
+		{"Second alternative", "<2.0.0 || >=3.0.0", "3.1.0", true},
+		{"No alternative", "<2.0.0 || >=3.0.0", "2.5.0", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			constraint, err := parseVersionConstraint(test.Constraint)
+			require.NoError(t, err)
+			version, err := parseVersion(test.Version)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.Expected, constraint.isSatisfiedBy(version))
+		})
+	}
+}
+
+func TestParseVersionConstraint_Invalid(t *testing.T) {
+	for _, constraint := range []string{"", ">=2.3.0 ||", ">=two", "~2.3.0", ">=2.3.0 <"} {
+		t.Run(constraint, func(t *testing.T) {
+			_, err := parseVersionConstraint(constraint)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestVersionConstraint_String(t *testing.T) {
+	constraint, err := parseVersionConstraint(">= 2.3.0   <3.0.0||>=3.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, ">=2.3.0 <3.0.0 || >=3.1.0", constraint.String())
+}
@@ -0,0 +1,150 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/correlation"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/shutdown"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+const (
+	// ServiceSystemEventType is the type of the system events published when a service starts and stops
+	ServiceSystemEventType = "service"
+	// ServiceSystemEventActionStart is the action of the system event published once a service has bootstrapped
+	ServiceSystemEventActionStart = "start"
+	// ServiceSystemEventActionStop is the action of the system event published when a service starts shutting down
+	ServiceSystemEventActionStop = "stop"
+)
+
+// ServiceEventDetails is the details of the service start and stop system events
+type ServiceEventDetails struct {
+	ServiceKey string `json:"serviceKey"`
+	Version    string `json:"version"`
+}
+
+// ServiceEvents publishes the system events announcing the service has started and is stopping, so the rolling
+// restarts of the services can be observed on the MessageBus
+type ServiceEvents struct {
+	serviceKey     string
+	serviceVersion string
+	publishTopic   string
+}
+
+// NewServiceEvents is a factory method that returns an initialized ServiceEvents receiver struct. By default, the
+// events are published to the system-events/<service key>/service/<action> topic under the MessageBus base topic.
+func NewServiceEvents(serviceKey string, serviceVersion string) *ServiceEvents {
+	return &ServiceEvents{
+		serviceKey:     serviceKey,
+		serviceVersion: serviceVersion,
+		publishTopic:   common.SystemEventPublishTopic,
+	}
+}
+
+// WithPublishTopic sets the topic the events are published to, under the MessageBus base topic, which has the service
+// key, event type and action appended, i.e. system-events/<service key>/service/start
+func (s *ServiceEvents) WithPublishTopic(topic string) *ServiceEvents {
+	s.publishTopic = topic
+	return s
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It publishes the start event and registers the publishing
+// of the stop event on shutdown. It must follow the MessageBus bootstrap handler, and should be the last of the
+// service's bootstrap handlers so the start event is published once the service has bootstrapped.
+func (s *ServiceEvents) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	lc := container.LoggingClientFrom(dic.Get)
+
+	messageClient := container.MessagingClientFrom(dic.Get)
+	if messageClient == nil {
+		lc.Error("MessageBus client not available, unable to publish the service's system events")
+		return false
+	}
+
+	// The service's key from the DIC is used when set, as the key may have been overridden with the -sk/--serviceKey flag
+	serviceKey := s.serviceKey
+	if key := container.ServiceKeyFrom(dic.Get); len(key) > 0 {
+		serviceKey = key
+	}
+
+	baseTopic := common.DefaultBaseTopic
+	if messageBus := container.ConfigurationFrom(dic.Get).GetBootstrap().MessageBus; messageBus != nil {
+		baseTopic = messageBus.GetBaseTopicPrefix()
+	}
+
+	details := ServiceEventDetails{
+		ServiceKey: serviceKey,
+		Version:    s.serviceVersion,
+	}
+
+	if err := s.publish(messageClient, baseTopic, ServiceSystemEventActionStart, details); err != nil {
+		lc.Errorf("unable to publish the service start event: %s", err.Error())
+		return false
+	}
+	lc.Debugf("Published the service start event for '%s'", serviceKey)
+
+	stop := func() {
+		if err := s.publish(messageClient, baseTopic, ServiceSystemEventActionStop, details); err != nil {
+			lc.Errorf("unable to publish the service stop event: %s", err.Error())
+			return
+		}
+		lc.Debugf("Published the service stop event for '%s'", serviceKey)
+	}
+
+	// The stop event is published when the service starts shutting down, ahead of the MessageBus being disconnected,
+	// when the shutdown registry is available
+	if shutdownRegistry := container.ShutdownRegistryFrom(dic.Get); shutdownRegistry != nil {
+		shutdownRegistry.Register("Service stop event", shutdown.PriorityRegistry, stop)
+	} else {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-ctx.Done()
+			stop()
+		}()
+	}
+
+	return true
+}
+
+func (s *ServiceEvents) publish(messageClient messaging.MessageClient, baseTopic string, action string, details ServiceEventDetails) error {
+	event := dtos.NewSystemEvent(ServiceSystemEventType, action, details.ServiceKey, details.ServiceKey, nil, details)
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the system event: %s", err.Error())
+	}
+
+	envelope := types.MessageEnvelope{
+		Versionable:   commonDTO.NewVersionable(),
+		CorrelationID: correlation.NewID(),
+		ContentType:   common.ContentTypeJSON,
+		Payload:       payload,
+	}
+
+	topic := common.BuildTopic(baseTopic, s.publishTopic, details.ServiceKey, ServiceSystemEventType, action)
+	return messageClient.Publish(envelope, topic)
+}
@@ -0,0 +1,154 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	mocks2 "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/shutdown"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+func TestServiceEvents_BootstrapHandler(t *testing.T) {
+	tests := []struct {
+		Name          string
+		PublishTopic  string
+		ServiceKey    string
+		ExpectedKey   string
+		ExpectedTopic string
+	}{
+		{"Default topic", "", "", "unit-test", "edgex/system-events/unit-test/service/start"},
+		{"Custom topic", "lifecycle", "", "unit-test", "edgex/lifecycle/unit-test/service/start"},
+		{"Overridden service key", "", "unit-test-2", "unit-test-2", "edgex/system-events/unit-test-2/service/start"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var published []types.MessageEnvelope
+			var topics []string
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				published = append(published, args.Get(0).(types.MessageEnvelope))
+				topics = append(topics, args.Get(1).(string))
+			}).Return(nil)
+
+			mockConfiguration := &mocks2.Configuration{}
+			mockConfiguration.On("GetBootstrap").Return(config.BootstrapConfiguration{
+				MessageBus: &config.MessageBusInfo{},
+			})
+
+			lc := logger.NewMockClient()
+			shutdownRegistry := shutdown.NewRegistry(lc)
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return lc
+				},
+				container.MessagingClientName: func(get di.Get) interface{} {
+					return mockClient
+				},
+				container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+					return mockConfiguration
+				},
+				container.ShutdownRegistryInterfaceName: func(get di.Get) interface{} {
+					return shutdownRegistry
+				},
+				container.ServiceKeyName: func(get di.Get) interface{} {
+					return test.ServiceKey
+				},
+			})
+
+			target := NewServiceEvents("unit-test", "3.2.0")
+			if len(test.PublishTopic) > 0 {
+				target = target.WithPublishTopic(test.PublishTopic)
+			}
+
+			wg := &sync.WaitGroup{}
+			require.True(t, target.BootstrapHandler(ctx, wg, startup.NewTimer(5, 1), dic))
+			require.Len(t, published, 1)
+			assert.Equal(t, test.ExpectedTopic, topics[0])
+			assert.Equal(t, common.ContentTypeJSON, published[0].ContentType)
+			assertServiceEvent(t, published[0], ServiceSystemEventActionStart, test.ExpectedKey)
+
+			// The stop event is published with the other shutdown cleanups once the context is cancelled
+			cancel()
+			wg.Wait()
+			shutdownRegistry.Run()
+			require.Len(t, published, 2)
+			assert.Equal(t, test.ExpectedTopic[:len(test.ExpectedTopic)-len("start")]+"stop", topics[1])
+			assertServiceEvent(t, published[1], ServiceSystemEventActionStop, test.ExpectedKey)
+		})
+	}
+}
+
+func TestServiceEvents_BootstrapHandler_Errors(t *testing.T) {
+	mockConfiguration := &mocks2.Configuration{}
+	mockConfiguration.On("GetBootstrap").Return(config.BootstrapConfiguration{})
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return mockConfiguration
+		},
+	})
+
+	target := NewServiceEvents("unit-test", "3.2.0")
+	assert.False(t, target.BootstrapHandler(context.Background(), &sync.WaitGroup{}, startup.NewTimer(5, 1), dic))
+
+	mockClient := &mocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(errors.New("publish failed"))
+	dic.Update(di.ServiceConstructorMap{
+		container.MessagingClientName: func(get di.Get) interface{} {
+			return mockClient
+		},
+	})
+
+	assert.False(t, target.BootstrapHandler(context.Background(), &sync.WaitGroup{}, startup.NewTimer(5, 1), dic))
+}
+
+func assertServiceEvent(t *testing.T, envelope types.MessageEnvelope, expectedAction string, expectedKey string) {
+	event := dtos.SystemEvent{}
+	require.NoError(t, json.Unmarshal(envelope.Payload, &event))
+	assert.Equal(t, ServiceSystemEventType, event.Type)
+	assert.Equal(t, expectedAction, event.Action)
+	assert.Equal(t, expectedKey, event.Source)
+	assert.NotZero(t, event.Timestamp)
+
+	details := ServiceEventDetails{}
+	require.NoError(t, event.DecodeDetails(&details))
+	assert.Equal(t, expectedKey, details.ServiceKey)
+	assert.Equal(t, "3.2.0", details.Version)
+}
@@ -0,0 +1,165 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// messageBusClientType is the type of the clients which use the MessageBus, the URL of which is the broker's URL
+const messageBusClientType = "messagebus"
+
+// StartupSummary contains data to log and serve the summary of the dependency endpoints the service resolved
+type StartupSummary struct {
+	serve bool
+}
+
+// NewStartupSummary is a factory method that returns the initialized StartupSummary receiver struct.
+func NewStartupSummary() *StartupSummary {
+	return &StartupSummary{}
+}
+
+// WithServing sets the summary to also be served at the common controller's StartupSummaryRoute
+func (s *StartupSummary) WithServing() *StartupSummary {
+	s.serve = true
+	return s
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It logs the summary of the endpoints of the clients,
+// MessageBuses, Databases, Registry and SecretStore the service resolved, without any credentials, and adds it to the
+// DIC when served. It should be the last of the service's bootstrap handlers so all the endpoints have been resolved.
+func (s *StartupSummary) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	lc := container.LoggingClientFrom(dic.Get)
+
+	summary := buildStartupSummary(dic)
+	data, err := json.Marshal(summary)
+	if err != nil {
+		lc.Errorf("unable to marshal the startup summary: %s", err.Error())
+		return false
+	}
+	lc.Infof("Startup summary: %s", string(data))
+
+	if s.serve {
+		dic.Update(di.ServiceConstructorMap{
+			container.StartupSummaryName: func(get di.Get) interface{} {
+				return summary
+			},
+		})
+	}
+
+	return true
+}
+
+func buildStartupSummary(dic *di.Container) *container.StartupSummary {
+	bootstrapConfig := container.ConfigurationFrom(dic.Get).GetBootstrap()
+
+	summary := &container.StartupSummary{
+		ServiceKey: container.ServiceKeyFrom(dic.Get),
+	}
+
+	var messageBusUrl string
+	if messageBus := bootstrapConfig.MessageBus; messageBus != nil && !messageBus.Disabled {
+		messageBusUrl = redactUrl(messageBus.URL())
+		summary.MessageBus = &container.EndpointSummary{Type: messageBus.Type, Url: messageBusUrl}
+	}
+
+	for name, messageBus := range bootstrapConfig.MessageBuses {
+		if messageBus.Disabled {
+			continue
+		}
+		if summary.MessageBuses == nil {
+			summary.MessageBuses = make(map[string]container.EndpointSummary)
+		}
+		summary.MessageBuses[name] = container.EndpointSummary{Type: messageBus.Type, Url: redactUrl(messageBus.URL())}
+	}
+
+	if bootstrapConfig.Clients != nil {
+		// The clients' URLs are the configured URLs unless they have been resolved from the Registry
+		clientUrls := container.ClientUrlsFrom(dic.Get)
+		summary.Clients = make(map[string]container.EndpointSummary, len(*bootstrapConfig.Clients))
+		for serviceKey, clientInfo := range *bootstrapConfig.Clients {
+			if clientInfo.UseMessageBus {
+				summary.Clients[serviceKey] = container.EndpointSummary{Type: messageBusClientType, Url: messageBusUrl}
+				continue
+			}
+
+			clientUrl, ok := clientUrls[serviceKey]
+			if !ok {
+				clientUrl = clientInfo.Url()
+			}
+			summary.Clients[serviceKey] = container.EndpointSummary{Url: redactUrl(clientUrl)}
+		}
+	}
+
+	if database := bootstrapConfig.Database; database != nil && *database != (config.Database{}) {
+		summary.Database = databaseEndpoint(*database)
+	}
+
+	for name, database := range bootstrapConfig.Databases {
+		if database == (config.Database{}) {
+			continue
+		}
+		if summary.Databases == nil {
+			summary.Databases = make(map[string]container.EndpointSummary)
+		}
+		summary.Databases[name] = *databaseEndpoint(database)
+	}
+
+	if registry := bootstrapConfig.Registry; registry != nil && container.RegistryFrom(dic.Get) != nil {
+		summary.Registry = &container.EndpointSummary{
+			Type: registry.Type,
+			Url:  fmt.Sprintf("%s://%s", config.DefaultHttpProtocol, net.JoinHostPort(registry.Host, strconv.Itoa(registry.Port))),
+		}
+	}
+
+	if secretStore := container.SecretStoreConfigFrom(dic.Get); secretStore != nil {
+		summary.SecretStore = &container.EndpointSummary{
+			Type: secretStore.Type,
+			Url:  fmt.Sprintf("%s://%s", secretStore.Protocol, net.JoinHostPort(secretStore.Host, strconv.Itoa(secretStore.Port))),
+		}
+	}
+
+	return summary
+}
+
+func databaseEndpoint(database config.Database) *container.EndpointSummary {
+	return &container.EndpointSummary{
+		Type: database.Type,
+		Url:  net.JoinHostPort(database.Host, strconv.Itoa(database.Port)),
+	}
+}
+
+// redactUrl removes any credentials from the URL, i.e. the password in the user info and the query parameters which
+// may hold a token
+func redactUrl(rawUrl string) string {
+	parsed, err := url.Parse(rawUrl)
+	if err != nil {
+		return rawUrl
+	}
+
+	parsed.RawQuery = ""
+	return parsed.Redacted()
+}
@@ -0,0 +1,116 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	boostrapMessaging "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// defaultSubscriberWaitDelay is how long to wait when the MessageBus can't report the subscribers to a topic
+const defaultSubscriberWaitDelay = time.Second * 5
+
+// WaitForSubscribersBootstrapHandler fulfills the BootstrapHandler contract. It waits until each of the topics in the
+// MessageBus's SubscriberWaitTopics has at least one subscriber, so the messages the service publishes on startup
+// aren't lost, and fails once the startup timer elapses. When the MessageBus can't report the subscribers to a topic
+// the SubscriberWaitDelay is waited instead. It must follow the MessageBus bootstrap handler and precede the handlers
+// which publish on startup.
+func WaitForSubscribersBootstrapHandler(ctx context.Context, _ *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	lc := container.LoggingClientFrom(dic.Get)
+	messageBus := container.ConfigurationFrom(dic.Get).GetBootstrap().MessageBus
+	if messageBus == nil || messageBus.Disabled || len(messageBus.SubscriberWaitTopics) == 0 {
+		return true
+	}
+
+	delay := defaultSubscriberWaitDelay
+	if len(messageBus.SubscriberWaitDelay) > 0 {
+		var err error
+		delay, err = time.ParseDuration(messageBus.SubscriberWaitDelay)
+		if err != nil || delay < 0 {
+			lc.Errorf("MessageBus SubscriberWaitDelay '%s' is invalid", messageBus.SubscriberWaitDelay)
+			return false
+		}
+	}
+
+	messageClient := container.MessagingClientFrom(dic.Get)
+	if messageClient == nil {
+		lc.Error("MessageBus client not available, unable to wait for the topics' subscribers")
+		return false
+	}
+
+	counter, ok := messageClient.(boostrapMessaging.SubscriberCounter)
+	if !ok {
+		return waitSubscriberDelay(ctx, delay, lc)
+	}
+
+	baseTopic := messageBus.GetBaseTopicPrefix()
+	for _, topic := range messageBus.SubscriberWaitTopics {
+		err := waitForSubscriber(counter, common.BuildTopic(baseTopic, topic), startupTimer, lc)
+		if errors.Is(err, boostrapMessaging.ErrSubscriberCountUnsupported) {
+			return waitSubscriberDelay(ctx, delay, lc)
+		}
+		if err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+	}
+
+	return true
+}
+
+// waitForSubscriber polls the subscribers to the topic until it has at least one subscriber or the startup timer
+// elapses
+func waitForSubscriber(counter boostrapMessaging.SubscriberCounter, topic string, startupTimer startup.Timer, lc logger.LoggingClient) error {
+	for startupTimer.HasNotElapsed() {
+		count, err := counter.SubscriberCount(topic)
+		switch {
+		case errors.Is(err, boostrapMessaging.ErrSubscriberCountUnsupported):
+			return err
+		case err != nil:
+			lc.Warnf("unable to get the subscribers to '%s': %s. retrying...", topic, err.Error())
+		case count > 0:
+			lc.Infof("Topic '%s' has %d subscriber(s)", topic, count)
+			return nil
+		default:
+			lc.Debugf("Topic '%s' has no subscribers yet. waiting...", topic)
+		}
+
+		startupTimer.SleepForInterval()
+	}
+
+	return fmt.Errorf("topic '%s' has no subscribers. Giving up", topic)
+}
+
+func waitSubscriberDelay(ctx context.Context, delay time.Duration, lc logger.LoggingClient) bool {
+	lc.Infof("MessageBus is unable to report the subscribers to a topic, waiting %s for the subscribers instead", delay.String())
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(delay):
+		return true
+	}
+}
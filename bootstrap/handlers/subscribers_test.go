@@ -0,0 +1,159 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package handlers
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	mocks2 "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	boostrapMessaging "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// subscriberCountingClient is a mock MessageClient which reports the subscribers to the topics
+type subscriberCountingClient struct {
+	*mocks.MessageClient
+	mutex  sync.Mutex
+	counts map[string][]int
+	err    error
+	polled map[string]int
+}
+
+// SubscriberCount returns the topic's next count, repeating the last count once they have all been returned
+func (s *subscriberCountingClient) SubscriberCount(topic string) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	counts := s.counts[topic]
+	if len(counts) == 0 {
+		return 0, nil
+	}
+
+	index := s.polled[topic]
+	s.polled[topic]++
+	if index >= len(counts) {
+		index = len(counts) - 1
+	}
+	return counts[index], nil
+}
+
+func TestWaitForSubscribersBootstrapHandler(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Topics         []string
+		Delay          string
+		Counts         map[string][]int
+		Err            error
+		NotCounting    bool
+		ExpectedResult bool
+		ExpectedPolls  map[string]int
+	}{
+		{"No topics", nil, "", nil, nil, false, true, nil},
+		{"Subscribers present", []string{"events/device", "events/app"}, "",
+			map[string][]int{"edgex/events/device": {1}, "edgex/events/app": {2}}, nil, false, true,
+			map[string]int{"edgex/events/device": 1, "edgex/events/app": 1}},
+		{"Subscriber connects", []string{"events/device"}, "",
+			map[string][]int{"edgex/events/device": {0, 0, 1}}, nil, false, true,
+			map[string]int{"edgex/events/device": 3}},
+		{"No subscribers", []string{"events/device"}, "",
+			map[string][]int{"edgex/events/device": {0}}, nil, false, false, nil},
+		{"Subscriber counts unsupported", []string{"events/device"}, "10ms",
+			nil, boostrapMessaging.ErrSubscriberCountUnsupported, false, true, nil},
+		{"Client unable to count subscribers", []string{"events/device"}, "10ms", nil, nil, true, true, nil},
+		{"Invalid delay", []string{"events/device"}, "ten seconds", nil, nil, true, false, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mockConfiguration := &mocks2.Configuration{}
+			mockConfiguration.On("GetBootstrap").Return(config.BootstrapConfiguration{
+				MessageBus: &config.MessageBusInfo{
+					SubscriberWaitTopics: test.Topics,
+					SubscriberWaitDelay:  test.Delay,
+				},
+			})
+
+			countingClient := &subscriberCountingClient{
+				MessageClient: &mocks.MessageClient{},
+				counts:        test.Counts,
+				err:           test.Err,
+				polled:        map[string]int{},
+			}
+			var messageClient messaging.MessageClient = countingClient
+			if test.NotCounting {
+				messageClient = &mocks.MessageClient{}
+			}
+
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+				container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+					return mockConfiguration
+				},
+				container.MessagingClientName: func(get di.Get) interface{} {
+					return messageClient
+				},
+			})
+
+			// A zero interval so waiting for the subscriber to connect doesn't slow the tests
+			actual := WaitForSubscribersBootstrapHandler(context.Background(), &sync.WaitGroup{}, startup.NewTimer(1, 0), dic)
+			assert.Equal(t, test.ExpectedResult, actual)
+			if test.ExpectedPolls != nil {
+				assert.Equal(t, test.ExpectedPolls, countingClient.polled)
+			}
+		})
+	}
+}
+
+func TestWaitForSubscribersBootstrapHandler_Cancelled(t *testing.T) {
+	mockConfiguration := &mocks2.Configuration{}
+	mockConfiguration.On("GetBootstrap").Return(config.BootstrapConfiguration{
+		MessageBus: &config.MessageBusInfo{
+			SubscriberWaitTopics: []string{"events/device"},
+			SubscriberWaitDelay:  "1h",
+		},
+	})
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return mockConfiguration
+		},
+		container.MessagingClientName: func(get di.Get) interface{} {
+			return &mocks.MessageClient{}
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, WaitForSubscribersBootstrapHandler(ctx, &sync.WaitGroup{}, startup.NewTimer(1, 0), dic))
+}
@@ -0,0 +1,61 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package health
+
+import (
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+)
+
+type readiness struct {
+	checks map[string]func() error
+	mutex  sync.RWMutex
+}
+
+// NewReadiness creates a new Readiness with no checks registered, so it is initially ready.
+func NewReadiness() interfaces.Readiness {
+	return &readiness{
+		checks: make(map[string]func() error),
+	}
+}
+
+// RegisterCheck registers the named check, replacing any check already registered with the name.
+func (r *readiness) RegisterCheck(name string, check func() error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.checks[name] = check
+}
+
+// Check runs all the registered checks and returns the errors of those which failed keyed by the check's name.
+func (r *readiness) Check() map[string]error {
+	// The checks are run outside the lock since they may be slow to fail, i.e. waiting for a connection to time out
+	r.mutex.RLock()
+	checks := make(map[string]func() error, len(r.checks))
+	for name, check := range r.checks {
+		checks[name] = check
+	}
+	r.mutex.RUnlock()
+
+	failures := make(map[string]error)
+	for name, check := range checks {
+		if err := check(); err != nil {
+			failures[name] = err
+		}
+	}
+
+	return failures
+}
@@ -0,0 +1,35 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package health
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadiness(t *testing.T) {
+	target := NewReadiness()
+	assert.Empty(t, target.Check())
+
+	target.RegisterCheck("SecretStore", func() error { return nil })
+	target.RegisterCheck("MessageBus", func() error { return errors.New("not connected") })
+	assert.Equal(t, map[string]error{"MessageBus": errors.New("not connected")}, target.Check())
+
+	// Registering a check with the same name replaces it
+	target.RegisterCheck("MessageBus", func() error { return nil })
+	assert.Empty(t, target.Check())
+}
@@ -65,3 +65,11 @@ type Configuration interface {
 	// GetWritablePtr gets the config.WritablePtr section from the ConfigurationStruct
 	GetWritablePtr() any
 }
+
+// Validator is implemented by configuration sections which can validate their settings. The configuration Processor
+// calls Validate on every section which implements it once the configuration has been loaded and merged, so that
+// invalid settings abort startup with a clear error.
+type Validator interface {
+	// Validate returns an error describing the invalid settings, if any.
+	Validate() error
+}
@@ -0,0 +1,25 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package interfaces
+
+// RegistryHeartbeat sends the heartbeats for the service's TTL health check in the Registry. It is only available
+// when the service is registered with the 'ttl' HealthCheckType, in which case the service must send a heartbeat
+// within the HealthCheckInterval on its own cadence for it to remain healthy.
+type RegistryHeartbeat interface {
+	// Pass marks the service's health check as passing, with the optional note.
+	Pass(note string) error
+	// Fail marks the service's health check as failing, with the optional note.
+	Fail(note string) error
+}
@@ -0,0 +1,24 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package interfaces
+
+import "github.com/edgexfoundry/go-mod-registry/v3/pkg/types"
+
+// RegistryInstances lists the instances of the client services registered in the Registry. It is only available
+// when the service is registered with a Registry which can have multiple instances of a service, i.e. Consul.
+type RegistryInstances interface {
+	// HealthyInstances returns the endpoints of the service's instances which are passing their health checks.
+	HealthyInstances(serviceKey string) ([]types.ServiceEndpoint, error)
+}
@@ -20,21 +20,48 @@ import (
 	"sync"
 	"time"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	gometrics "github.com/rcrowley/go-metrics"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 )
 
 // MetricsManager manages a services metrics
 type MetricsManager interface {
 	// ResetInterval resets the interval between reporting the current metrics
 	ResetInterval(interval time.Duration)
+	// ResetMetricIntervals resets the per-metric interval overrides between reporting the current metrics
+	ResetMetricIntervals(intervals map[string]time.Duration)
+	// ResetServiceTags atomically swaps the service level tags reported with every metric
+	ResetServiceTags(tags map[string]string)
 	// Register registers a go-metrics metric item such as a Counter
 	Register(name string, item interface{}, tags map[string]string) error
+	// RegisterWithUnit registers a go-metrics metric item along with the unit of its values, which is reported as a tag
+	RegisterWithUnit(name string, item interface{}, unit string, tags map[string]string) error
+	// RegisterTimerWithSample creates and registers a Timer which records its durations in the given sample
+	RegisterTimerWithSample(name string, sample gometrics.Sample, tags map[string]string) (gometrics.Timer, error)
+	// RegisterHistogramWithSample creates and registers a Histogram which records its values in the given sample
+	RegisterHistogramWithSample(name string, sample gometrics.Sample, tags map[string]string) (gometrics.Histogram, error)
+	// RegisterGaugeFunc registers a functional Gauge whose value is returned by the function at report time
+	RegisterGaugeFunc(name string, valueFunc func() int64, tags map[string]string) error
+	// RegisterGaugeFloat64Func registers a functional GaugeFloat64 whose value is returned by the function at report time
+	RegisterGaugeFloat64Func(name string, valueFunc func() float64, tags map[string]string) error
 	// IsRegistered checks whether a metric has been registered
 	IsRegistered(name string) bool
 	// Unregister unregisters a go-metrics metric item such as a Counter
 	Unregister(name string)
+	// StartTime returns the time the named metric was registered, which is the start of its cumulative values' interval
+	StartTime(name string) (time.Time, bool)
+	// SetMetricEnabled overrides whether the named metric is reported, taking precedence over the configured Metrics
+	SetMetricEnabled(name string, enabled bool)
+	// ClearMetricEnabled removes the override set by SetMetricEnabled for the named metric
+	ClearMetricEnabled(name string)
+	// Snapshot returns the current values of the metrics enabled in the Telemetry configuration
+	Snapshot(telemetryConfig *config.TelemetryInfo) ([]dtos.Metric, error)
 	// Run starts the collection of metrics
 	Run(ctx context.Context, wg *sync.WaitGroup)
+	// Flush reports the metrics one last time on shutdown, within the context's deadline
+	Flush(ctx context.Context) error
 	// GetCounter retrieves the specified registered Counter
 	// Returns nil if named item not registered or not a Counter
 	GetCounter(name string) gometrics.Counter
@@ -51,5 +78,13 @@ type MetricsManager interface {
 
 // MetricsReporter reports the metrics
 type MetricsReporter interface {
+	// Report reports all the enabled metrics in the registry
 	Report(registry gometrics.Registry, metricTags map[string]map[string]string) error
+	// ReportWithCount reports all the enabled metrics in the registry and returns the number of metrics
+	// successfully reported, which excludes those that failed to be reported and the Metrics Manager's own
+	// ReporterPublished and ReporterFailures metrics
+	ReportWithCount(registry gometrics.Registry, metricTags map[string]map[string]string) (int, error)
+	// ReportWithContext is the same as ReportWithCount, but stops reporting the remaining metrics when the context
+	// is cancelled, i.e. on shutdown, with the skipped metrics noted in the returned error
+	ReportWithContext(ctx context.Context, registry gometrics.Registry, metricTags map[string]map[string]string) (int, error)
 }
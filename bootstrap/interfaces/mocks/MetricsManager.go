@@ -3,8 +3,12 @@
 package mocks
 
 import (
+	config "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+
 	context "context"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+
 	metrics "github.com/rcrowley/go-metrics"
 
 	mock "github.com/stretchr/testify/mock"
@@ -19,6 +23,25 @@ type MetricsManager struct {
 	mock.Mock
 }
 
+// ClearMetricEnabled provides a mock function with given fields: name
+func (_m *MetricsManager) ClearMetricEnabled(name string) {
+	_m.Called(name)
+}
+
+// Flush provides a mock function with given fields: ctx
+func (_m *MetricsManager) Flush(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetCounter provides a mock function with given fields: name
 func (_m *MetricsManager) GetCounter(name string) metrics.Counter {
 	ret := _m.Called(name)
@@ -111,16 +134,175 @@ func (_m *MetricsManager) Register(name string, item interface{}, tags map[strin
 	return r0
 }
 
+// RegisterGaugeFloat64Func provides a mock function with given fields: name, valueFunc, tags
+func (_m *MetricsManager) RegisterGaugeFloat64Func(name string, valueFunc func() float64, tags map[string]string) error {
+	ret := _m.Called(name, valueFunc, tags)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func() float64, map[string]string) error); ok {
+		r0 = rf(name, valueFunc, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegisterGaugeFunc provides a mock function with given fields: name, valueFunc, tags
+func (_m *MetricsManager) RegisterGaugeFunc(name string, valueFunc func() int64, tags map[string]string) error {
+	ret := _m.Called(name, valueFunc, tags)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func() int64, map[string]string) error); ok {
+		r0 = rf(name, valueFunc, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegisterHistogramWithSample provides a mock function with given fields: name, sample, tags
+func (_m *MetricsManager) RegisterHistogramWithSample(name string, sample metrics.Sample, tags map[string]string) (metrics.Histogram, error) {
+	ret := _m.Called(name, sample, tags)
+
+	var r0 metrics.Histogram
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, metrics.Sample, map[string]string) (metrics.Histogram, error)); ok {
+		return rf(name, sample, tags)
+	}
+	if rf, ok := ret.Get(0).(func(string, metrics.Sample, map[string]string) metrics.Histogram); ok {
+		r0 = rf(name, sample, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(metrics.Histogram)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, metrics.Sample, map[string]string) error); ok {
+		r1 = rf(name, sample, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterTimerWithSample provides a mock function with given fields: name, sample, tags
+func (_m *MetricsManager) RegisterTimerWithSample(name string, sample metrics.Sample, tags map[string]string) (metrics.Timer, error) {
+	ret := _m.Called(name, sample, tags)
+
+	var r0 metrics.Timer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, metrics.Sample, map[string]string) (metrics.Timer, error)); ok {
+		return rf(name, sample, tags)
+	}
+	if rf, ok := ret.Get(0).(func(string, metrics.Sample, map[string]string) metrics.Timer); ok {
+		r0 = rf(name, sample, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(metrics.Timer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, metrics.Sample, map[string]string) error); ok {
+		r1 = rf(name, sample, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterWithUnit provides a mock function with given fields: name, item, unit, tags
+func (_m *MetricsManager) RegisterWithUnit(name string, item interface{}, unit string, tags map[string]string) error {
+	ret := _m.Called(name, item, unit, tags)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, interface{}, string, map[string]string) error); ok {
+		r0 = rf(name, item, unit, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResetInterval provides a mock function with given fields: interval
 func (_m *MetricsManager) ResetInterval(interval time.Duration) {
 	_m.Called(interval)
 }
 
+// ResetMetricIntervals provides a mock function with given fields: intervals
+func (_m *MetricsManager) ResetMetricIntervals(intervals map[string]time.Duration) {
+	_m.Called(intervals)
+}
+
+// ResetServiceTags provides a mock function with given fields: tags
+func (_m *MetricsManager) ResetServiceTags(tags map[string]string) {
+	_m.Called(tags)
+}
+
 // Run provides a mock function with given fields: ctx, wg
 func (_m *MetricsManager) Run(ctx context.Context, wg *sync.WaitGroup) {
 	_m.Called(ctx, wg)
 }
 
+// SetMetricEnabled provides a mock function with given fields: name, enabled
+func (_m *MetricsManager) SetMetricEnabled(name string, enabled bool) {
+	_m.Called(name, enabled)
+}
+
+// Snapshot provides a mock function with given fields: telemetryConfig
+func (_m *MetricsManager) Snapshot(telemetryConfig *config.TelemetryInfo) ([]dtos.Metric, error) {
+	ret := _m.Called(telemetryConfig)
+
+	var r0 []dtos.Metric
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*config.TelemetryInfo) ([]dtos.Metric, error)); ok {
+		return rf(telemetryConfig)
+	}
+	if rf, ok := ret.Get(0).(func(*config.TelemetryInfo) []dtos.Metric); ok {
+		r0 = rf(telemetryConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtos.Metric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*config.TelemetryInfo) error); ok {
+		r1 = rf(telemetryConfig)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartTime provides a mock function with given fields: name
+func (_m *MetricsManager) StartTime(name string) (time.Time, bool) {
+	ret := _m.Called(name)
+
+	var r0 time.Time
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (time.Time, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) time.Time); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // Unregister provides a mock function with given fields: name
 func (_m *MetricsManager) Unregister(name string) {
 	_m.Called(name)
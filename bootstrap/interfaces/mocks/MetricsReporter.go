@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	metrics "github.com/rcrowley/go-metrics"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -26,6 +28,54 @@ func (_m *MetricsReporter) Report(registry metrics.Registry, metricTags map[stri
 	return r0
 }
 
+// ReportWithCount provides a mock function with given fields: registry, metricTags
+func (_m *MetricsReporter) ReportWithCount(registry metrics.Registry, metricTags map[string]map[string]string) (int, error) {
+	ret := _m.Called(registry, metricTags)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(metrics.Registry, map[string]map[string]string) (int, error)); ok {
+		return rf(registry, metricTags)
+	}
+	if rf, ok := ret.Get(0).(func(metrics.Registry, map[string]map[string]string) int); ok {
+		r0 = rf(registry, metricTags)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(metrics.Registry, map[string]map[string]string) error); ok {
+		r1 = rf(registry, metricTags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportWithContext provides a mock function with given fields: ctx, registry, metricTags
+func (_m *MetricsReporter) ReportWithContext(ctx context.Context, registry metrics.Registry, metricTags map[string]map[string]string) (int, error) {
+	ret := _m.Called(ctx, registry, metricTags)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, metrics.Registry, map[string]map[string]string) (int, error)); ok {
+		return rf(ctx, registry, metricTags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, metrics.Registry, map[string]map[string]string) int); ok {
+		r0 = rf(ctx, registry, metricTags)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, metrics.Registry, map[string]map[string]string) error); ok {
+		r1 = rf(ctx, registry, metricTags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewMetricsReporter interface {
 	mock.TestingT
 	Cleanup(func())
@@ -51,6 +51,38 @@ func (_m *SecretProvider) GetSecret(secretName string, keys ...string) (map[stri
 	return r0, r1
 }
 
+// GetSecrets provides a mock function with given fields: secretNames
+func (_m *SecretProvider) GetSecrets(secretNames ...string) (map[string]map[string]string, error) {
+	_va := make([]interface{}, len(secretNames))
+	for _i := range secretNames {
+		_va[_i] = secretNames[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 map[string]map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...string) (map[string]map[string]string, error)); ok {
+		return rf(secretNames...)
+	}
+	if rf, ok := ret.Get(0).(func(...string) map[string]map[string]string); ok {
+		r0 = rf(secretNames...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(secretNames...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HasSecret provides a mock function with given fields: secretName
 func (_m *SecretProvider) HasSecret(secretName string) (bool, error) {
 	ret := _m.Called(secretName)
@@ -115,6 +147,20 @@ func (_m *SecretProvider) RegisterSecretUpdatedCallback(secretName string, callb
 	return r0
 }
 
+// RegisterSealStateChangedCallback provides a mock function with given fields: callback
+func (_m *SecretProvider) RegisterSealStateChangedCallback(callback func(bool)) error {
+	ret := _m.Called(callback)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(bool)) error); ok {
+		r0 = rf(callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SecretsLastUpdated provides a mock function with given fields:
 func (_m *SecretProvider) SecretsLastUpdated() time.Time {
 	ret := _m.Called()
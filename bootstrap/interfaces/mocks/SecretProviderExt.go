@@ -110,6 +110,42 @@ func (_m *SecretProviderExt) GetSecret(secretName string, keys ...string) (map[s
 	return r0, r1
 }
 
+// GetSecrets provides a mock function with given fields: secretNames
+func (_m *SecretProviderExt) GetSecrets(secretNames ...string) (map[string]map[string]string, error) {
+	_va := make([]interface{}, len(secretNames))
+	for _i := range secretNames {
+		_va[_i] = secretNames[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecrets")
+	}
+
+	var r0 map[string]map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...string) (map[string]map[string]string, error)); ok {
+		return rf(secretNames...)
+	}
+	if rf, ok := ret.Get(0).(func(...string) map[string]map[string]string); ok {
+		r0 = rf(secretNames...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(secretNames...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSelfJWT provides a mock function with given fields:
 func (_m *SecretProviderExt) GetSelfJWT() (string, error) {
 	ret := _m.Called()
@@ -262,6 +298,24 @@ func (_m *SecretProviderExt) ListSecretNames() ([]string, error) {
 	return r0, r1
 }
 
+// RegisterSealStateChangedCallback provides a mock function with given fields: callback
+func (_m *SecretProviderExt) RegisterSealStateChangedCallback(callback func(bool)) error {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterSealStateChangedCallback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(bool)) error); ok {
+		r0 = rf(callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RegisterSecretUpdatedCallback provides a mock function with given fields: secretName, callback
 func (_m *SecretProviderExt) RegisterSecretUpdatedCallback(secretName string, callback func(string)) error {
 	ret := _m.Called(secretName, callback)
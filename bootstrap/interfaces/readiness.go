@@ -0,0 +1,27 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package interfaces
+
+// Readiness aggregates the checks which determine whether the service is ready to handle requests, i.e. that the
+// dependencies such as the SecretStore and MessageBus are reachable. The checks are registered by the bootstrap
+// handlers, and by the service, once the dependency has been set up.
+type Readiness interface {
+	// RegisterCheck registers the named check, which returns an error when the dependency is not ready. A check
+	// registered with the same name as an existing check replaces it.
+	RegisterCheck(name string, check func() error)
+	// Check runs all the registered checks and returns the errors of those which failed keyed by the check's name.
+	// The service is ready when no checks failed.
+	Check() map[string]error
+}
@@ -29,6 +29,11 @@ type SecretProvider interface {
 	// GetSecret retrieves secrets from the service's SecretStore at the specified secretName.
 	GetSecret(secretName string, keys ...string) (map[string]string, error)
 
+	// GetSecrets retrieves all the keys of the secrets from the service's SecretStore at each of the specified
+	// secretNames, returning them keyed by secretName. When some of the secrets can't be retrieved the secrets which
+	// were retrieved are returned along with an error aggregating the failures.
+	GetSecrets(secretNames ...string) (map[string]map[string]string, error)
+
 	// SecretsLastUpdated returns the last time secrets were updated
 	SecretsLastUpdated() time.Time
 
@@ -46,6 +51,11 @@ type SecretProvider interface {
 
 	// DeregisterSecretUpdatedCallback removes a secret's registered callback secretName.
 	DeregisterSecretUpdatedCallback(secretName string)
+
+	// RegisterSealStateChangedCallback registers a callback which is called with the new seal state when the
+	// SecretStore becomes sealed or unsealed. This is distinct from the secret updated callbacks and allows the
+	// service to enter a degraded mode while secrets can't be retrieved.
+	RegisterSealStateChangedCallback(callback func(sealed bool)) error
 }
 
 // SecretProviderExt defines the extended contract for secret provider implementations that
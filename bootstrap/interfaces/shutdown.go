@@ -0,0 +1,24 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package interfaces
+
+// ShutdownRegistry allows bootstrap handlers, and the service, to register cleanup functions which are run in
+// priority order once the bootstrap context is cancelled, i.e. so the HTTP server stops accepting requests before
+// the database connection pool is closed.
+type ShutdownRegistry interface {
+	// Register registers the named cleanup function. Cleanup functions are run in ascending order of priority, with
+	// those of equal priority run in the order registered.
+	Register(name string, priority int, cleanup func())
+}
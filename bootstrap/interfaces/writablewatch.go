@@ -0,0 +1,24 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package interfaces
+
+// WritableWatcher notifies the service when a section of its Writable configuration is changed in the Configuration
+// Provider, so a service can react to the changes of its own Writable settings.
+type WritableWatcher interface {
+	// Watch registers the callback invoked with the new value of the Writable section when it changes. The section is
+	// the path of the section within Writable, i.e. "Pipeline" or "Pipeline.Functions". The value passed to the
+	// callback is the value returned by newTarget with the section decoded into it. Rapid successive changes are
+	// debounced so the callback only receives the value of the last change.
+	Watch(section string, newTarget func() any, callback func(value any)) error
+}
@@ -0,0 +1,260 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package loadbalance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	clientinterfaces "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-registry/v3/pkg/types"
+)
+
+// The policies for selecting the instance of the service to send each request to, see config.ClientInfo
+const (
+	PolicyRoundRobin       = "round-robin"
+	PolicyLeastConnections = "least-connections"
+)
+
+// DefaultRefreshInterval is how often the instances are refreshed when the InstanceRefreshInterval isn't set
+const DefaultRefreshInterval = time.Second * 30
+
+// InstancesFunc returns the endpoints of the service's healthy instances
+type InstancesFunc func() ([]types.ServiceEndpoint, error)
+
+// instance is an instance of the service along with its number of in-flight requests
+type instance struct {
+	endpoint types.ServiceEndpoint
+	address  string
+	active   int
+	failed   bool
+}
+
+// Balancer spreads the requests to a service across its healthy instances using the policy. An instance whose request
+// fails to be sent is dropped until the instances are next refreshed and it is still reported as healthy.
+type Balancer struct {
+	serviceKey   string
+	policy       string
+	getInstances InstancesFunc
+	lc           logger.LoggingClient
+	instances    []*instance
+	next         int
+	mutex        sync.Mutex
+}
+
+// NewBalancer returns the Balancer for the service's instances using the policy. Refresh must be called to get the
+// initial instances before the Balancer is used.
+func NewBalancer(serviceKey string, policy string, getInstances InstancesFunc, lc logger.LoggingClient) (*Balancer, error) {
+	normalized := strings.ToLower(strings.TrimSpace(policy))
+	if normalized != PolicyRoundRobin && normalized != PolicyLeastConnections {
+		return nil, fmt.Errorf("invalid LoadBalancePolicy '%s' for '%s', must be '%s' or '%s'",
+			policy, serviceKey, PolicyRoundRobin, PolicyLeastConnections)
+	}
+
+	return &Balancer{
+		serviceKey:   serviceKey,
+		policy:       normalized,
+		getInstances: getInstances,
+		lc:           lc,
+	}, nil
+}
+
+// Refresh replaces the instances with the service's current healthy instances, keeping the in-flight request counts
+// of the instances which are still healthy.
+func (b *Balancer) Refresh() error {
+	endpoints, err := b.getInstances()
+	if err != nil {
+		return fmt.Errorf("unable to refresh the instances of '%s': %s", b.serviceKey, err.Error())
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	current := make(map[string]*instance, len(b.instances))
+	for _, existing := range b.instances {
+		current[existing.address] = existing
+	}
+
+	instances := make([]*instance, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		address := net.JoinHostPort(endpoint.Host, strconv.Itoa(endpoint.Port))
+		if existing, ok := current[address]; ok {
+			existing.failed = false
+			instances = append(instances, existing)
+			continue
+		}
+
+		instances = append(instances, &instance{endpoint: endpoint, address: address})
+	}
+
+	if len(instances) != len(b.instances) {
+		b.lc.Debugf("Load balancing '%s' requests across %d instances", b.serviceKey, len(instances))
+	}
+
+	b.instances = instances
+	return nil
+}
+
+// Start refreshes the instances at the interval until the context is cancelled
+func (b *Balancer) Start(ctx context.Context, wg *sync.WaitGroup, interval time.Duration) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := b.Refresh(); err != nil {
+					b.lc.Warn(err.Error())
+				}
+			}
+		}
+	}()
+}
+
+// acquire selects the instance to send the next request to and counts the request as in-flight on it
+func (b *Balancer) acquire() (*instance, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	candidates := make([]*instance, 0, len(b.instances))
+	for _, candidate := range b.instances {
+		if !candidate.failed {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no healthy instances of '%s' available", b.serviceKey)
+	}
+
+	// Starting from the next instance in turn also rotates across the instances with the least connections
+	start := b.next % len(candidates)
+	b.next++
+
+	selected := candidates[start]
+	if b.policy == PolicyLeastConnections {
+		for offset := 1; offset < len(candidates); offset++ {
+			candidate := candidates[(start+offset)%len(candidates)]
+			if candidate.active < selected.active {
+				selected = candidate
+			}
+		}
+	}
+
+	selected.active++
+	return selected, nil
+}
+
+// release counts the request as no longer in-flight on the instance, dropping the instance when the request failed
+func (b *Balancer) release(selected *instance, failed bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	selected.active--
+	if failed && !selected.failed {
+		selected.failed = true
+		b.lc.Warnf("Dropped instance of '%s' at %s until its health is refreshed", b.serviceKey, selected.address)
+	}
+}
+
+// RoundTripper returns the http.RoundTripper which sends each request to the selected instance using the next
+// http.RoundTripper, which defaults to http.DefaultTransport when nil.
+func (b *Balancer) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &roundTripper{
+		balancer: b,
+		next:     next,
+	}
+}
+
+type roundTripper struct {
+	balancer *Balancer
+	next     http.RoundTripper
+}
+
+func (rt *roundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	selected, err := rt.balancer.acquire()
+	if err != nil {
+		return nil, err
+	}
+
+	// The caller's request must not be modified
+	balanced := request.Clone(request.Context())
+	balanced.URL.Host = selected.address
+	balanced.Host = ""
+
+	response, err := rt.next.RoundTrip(balanced)
+	if err != nil {
+		// A cancelled request says nothing about the instance's health
+		failed := !errors.Is(err, context.Canceled) && request.Context().Err() == nil
+		rt.balancer.release(selected, failed)
+		return nil, err
+	}
+
+	// The request is in-flight until its response has been read
+	response.Body = &releasingBody{
+		ReadCloser: response.Body,
+		release:    func() { rt.balancer.release(selected, false) },
+	}
+	return response, nil
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (r *releasingBody) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
+
+// NewAuthenticationInjector returns the AuthenticationInjector for the EdgeX clients which load balances their
+// requests using the Balancer, after they're authenticated by the injector.
+func NewAuthenticationInjector(injector clientinterfaces.AuthenticationInjector, balancer *Balancer) clientinterfaces.AuthenticationInjector {
+	return &authenticationInjector{
+		AuthenticationInjector: injector,
+		balancer:               balancer,
+	}
+}
+
+type authenticationInjector struct {
+	clientinterfaces.AuthenticationInjector
+	balancer *Balancer
+}
+
+func (a *authenticationInjector) RoundTripper() http.RoundTripper {
+	return a.balancer.RoundTripper(a.AuthenticationInjector.RoundTripper())
+}
@@ -0,0 +1,224 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package loadbalance
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-registry/v3/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInstances are the instances of the service, each counting the requests it has received
+type fakeInstances struct {
+	servers  []*httptest.Server
+	healthy  []bool
+	requests map[string]int
+	mutex    sync.Mutex
+}
+
+func newFakeInstances(t *testing.T, count int, handler func(name string)) *fakeInstances {
+	instances := &fakeInstances{requests: make(map[string]int)}
+	for index := 0; index < count; index++ {
+		name := "instance-" + strconv.Itoa(index)
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			instances.mutex.Lock()
+			instances.requests[name]++
+			instances.mutex.Unlock()
+
+			if handler != nil {
+				handler(name)
+			}
+			_, _ = writer.Write([]byte(name))
+		}))
+		t.Cleanup(server.Close)
+
+		instances.servers = append(instances.servers, server)
+		instances.healthy = append(instances.healthy, true)
+	}
+	return instances
+}
+
+func (f *fakeInstances) setHealthy(index int, healthy bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.healthy[index] = healthy
+}
+
+func (f *fakeInstances) requestCounts() map[string]int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	counts := make(map[string]int, len(f.requests))
+	for name, count := range f.requests {
+		counts[name] = count
+	}
+	return counts
+}
+
+// getInstances returns the endpoints of the healthy instances, as the Registry does
+func (f *fakeInstances) getInstances() ([]types.ServiceEndpoint, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	var endpoints []types.ServiceEndpoint
+	for index, server := range f.servers {
+		if !f.healthy[index] {
+			continue
+		}
+
+		host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+		if err != nil {
+			return nil, err
+		}
+		portNumber, err := strconv.Atoi(port)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, types.ServiceEndpoint{ServiceId: "instance-" + strconv.Itoa(index), Host: host, Port: portNumber})
+	}
+	return endpoints, nil
+}
+
+func newTestBalancer(t *testing.T, policy string, instances *fakeInstances) (*Balancer, *http.Client) {
+	balancer, err := NewBalancer("core-data", policy, instances.getInstances, logger.NewMockClient())
+	require.NoError(t, err)
+	require.NoError(t, balancer.Refresh())
+
+	return balancer, &http.Client{Transport: balancer.RoundTripper(nil)}
+}
+
+// get sends the request to the service's configured URL, which the balancer redirects to the selected instance
+func get(t *testing.T, client *http.Client) string {
+	response, err := client.Get("http://core-data:59880/api/v3/ping")
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestNewBalancer(t *testing.T) {
+	for _, policy := range []string{PolicyRoundRobin, "Least-Connections"} {
+		_, err := NewBalancer("core-data", policy, nil, logger.NewMockClient())
+		assert.NoError(t, err)
+	}
+
+	_, err := NewBalancer("core-data", "random", nil, logger.NewMockClient())
+	assert.Error(t, err)
+}
+
+func TestRoundRobin(t *testing.T) {
+	instances := newFakeInstances(t, 3, nil)
+	_, client := newTestBalancer(t, PolicyRoundRobin, instances)
+
+	for count := 0; count < 9; count++ {
+		get(t, client)
+	}
+
+	assert.Equal(t, map[string]int{"instance-0": 3, "instance-1": 3, "instance-2": 3}, instances.requestCounts())
+}
+
+func TestLeastConnections(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	instances := newFakeInstances(t, 3, func(name string) {
+		if name == "instance-0" {
+			started <- struct{}{}
+			<-release
+		}
+	})
+	_, client := newTestBalancer(t, PolicyLeastConnections, instances)
+
+	// The first request is held in-flight on the first instance
+	done := make(chan error)
+	go func() {
+		response, err := client.Get("http://core-data:59880/api/v3/ping")
+		if err == nil {
+			err = response.Body.Close()
+		}
+		done <- err
+	}()
+	<-started
+
+	for count := 0; count < 4; count++ {
+		assert.NotEqual(t, "instance-0", get(t, client))
+	}
+
+	close(release)
+	require.NoError(t, <-done)
+
+	counts := instances.requestCounts()
+	assert.Equal(t, 1, counts["instance-0"])
+	assert.Equal(t, 4, counts["instance-1"]+counts["instance-2"])
+}
+
+func TestUnhealthyInstanceDropped(t *testing.T) {
+	instances := newFakeInstances(t, 3, nil)
+	balancer, client := newTestBalancer(t, PolicyRoundRobin, instances)
+
+	instances.setHealthy(1, false)
+	require.NoError(t, balancer.Refresh())
+
+	for count := 0; count < 6; count++ {
+		get(t, client)
+	}
+
+	assert.Equal(t, map[string]int{"instance-0": 3, "instance-2": 3}, instances.requestCounts())
+}
+
+func TestFailedInstanceDropped(t *testing.T) {
+	instances := newFakeInstances(t, 3, nil)
+	balancer, client := newTestBalancer(t, PolicyRoundRobin, instances)
+
+	// The first request goes to the stopped instance, which is dropped until refreshed
+	instances.servers[0].Close()
+	_, err := client.Get("http://core-data:59880/api/v3/ping")
+	require.Error(t, err)
+
+	for count := 0; count < 4; count++ {
+		assert.NotEqual(t, "instance-0", get(t, client))
+	}
+
+	for index := range instances.servers {
+		instances.setHealthy(index, false)
+	}
+	require.NoError(t, balancer.Refresh())
+
+	_, err = client.Get("http://core-data:59880/api/v3/ping")
+	require.Error(t, err)
+}
+
+func TestRefreshError(t *testing.T) {
+	balancer, err := NewBalancer("core-data", PolicyRoundRobin, func() ([]types.ServiceEndpoint, error) {
+		return nil, errors.New("registry unavailable")
+	}, logger.NewMockClient())
+	require.NoError(t, err)
+
+	require.Error(t, balancer.Refresh())
+
+	_, err = balancer.acquire()
+	require.Error(t, err)
+}
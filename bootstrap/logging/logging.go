@@ -0,0 +1,247 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package logging contains the LoggingClient implementations which are selectable for the service's log output.
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+const (
+	// FormatText is the human-readable logfmt format of the standard EdgeX LoggingClient, which is the default
+	FormatText = "text"
+	// FormatJSON is one JSON object per log entry, for ingestion into log aggregators
+	FormatJSON = "json"
+
+	// CorrelationIDField is the JSON field the correlation id is written to when passed as the
+	// common.CorrelationHeader key/value pair, i.e. lc.Debug("msg", common.CorrelationHeader, correlationId)
+	CorrelationIDField = "correlation_id"
+)
+
+// NewClient creates the LoggingClient for the passed in format writing to stdout, defaulting to the human-readable
+// format when the format is not set or unknown.
+func NewClient(serviceKey string, logLevel string, format string) logger.LoggingClient {
+	if strings.EqualFold(strings.TrimSpace(format), FormatJSON) {
+		return NewJSONClient(serviceKey, logLevel, os.Stdout)
+	}
+
+	return logger.NewClient(serviceKey, logLevel)
+}
+
+// jsonLogger is a LoggingClient which writes each entry as a single line JSON object containing the timestamp,
+// level, service name, source and message along with any key/value pairs passed as args.
+type jsonLogger struct {
+	serviceKey string
+	logLevel   *string
+	out        io.Writer
+	mutex      *sync.Mutex
+}
+
+// NewJSONClient creates a LoggingClient which writes JSON formatted entries to the passed in writer
+func NewJSONClient(serviceKey string, logLevel string, out io.Writer) logger.LoggingClient {
+	if !isValidLogLevel(logLevel) {
+		logLevel = models.InfoLog
+	}
+
+	return jsonLogger{
+		serviceKey: serviceKey,
+		logLevel:   &logLevel,
+		out:        out,
+		mutex:      &sync.Mutex{},
+	}
+}
+
+// logLevels returns the possible log levels in order from most to least verbose.
+func logLevels() []string {
+	return []string{
+		models.TraceLog,
+		models.DebugLog,
+		models.InfoLog,
+		models.WarnLog,
+		models.ErrorLog}
+}
+
+func isValidLogLevel(level string) bool {
+	for _, name := range logLevels() {
+		if name == level {
+			return true
+		}
+	}
+	return false
+}
+
+func (lc jsonLogger) log(logLevel string, formatted bool, msg string, args ...interface{}) {
+	lc.mutex.Lock()
+	defer lc.mutex.Unlock()
+
+	// Check minimum log level
+	for _, name := range logLevels() {
+		if name == *lc.logLevel {
+			break
+		}
+		if name == logLevel {
+			return
+		}
+	}
+
+	entry := map[string]interface{}{
+		"ts":      time.Now().UTC().Format(time.RFC3339Nano),
+		"level":   logLevel,
+		"service": lc.serviceKey,
+	}
+
+	if source := callerSource(); len(source) > 0 {
+		entry["source"] = source
+	}
+
+	if formatted {
+		entry["msg"] = fmt.Sprintf(msg, args...)
+	} else {
+		if len(msg) > 0 {
+			entry["msg"] = msg
+		}
+
+		for i := 0; i < len(args); i += 2 {
+			key := fmt.Sprint(args[i])
+			if key == common.CorrelationHeader {
+				key = CorrelationIDField
+			}
+
+			var value interface{} = ""
+			if i+1 < len(args) {
+				value = jsonValue(args[i+1])
+			}
+			entry[key] = value
+		}
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		// Fall back to the string representation for values which can't be marshalled
+		for key, value := range entry {
+			entry[key] = fmt.Sprint(value)
+		}
+		data, _ = json.Marshal(entry)
+	}
+
+	_, _ = lc.out.Write(append(data, '\n'))
+}
+
+// callerSource returns the file and line which called the LoggingClient, skipping the frames of the bootstrap's
+// LoggingClient implementations, i.e. when wrapped by the sampling client.
+func callerSource() string {
+	pcs := make([]uintptr, 10)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !isBootstrapLoggerFrame(frame.Function) {
+			return fmt.Sprintf("%s:%d", filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// isBootstrapLoggerFrame returns whether the function is a method of one of the bootstrap's LoggingClient
+// implementations, which are all named xxxLogger
+func isBootstrapLoggerFrame(function string) bool {
+	return strings.HasPrefix(function, "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/") &&
+		(strings.Contains(function, "Logger.") || strings.Contains(function, "Logger)."))
+}
+
+// jsonValue returns the value to write for a logged arg, using the message for errors which otherwise marshal as {}
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return value
+	}
+}
+
+func (lc jsonLogger) SetLogLevel(logLevel string) errors.EdgeX {
+	if !isValidLogLevel(logLevel) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid log level `%s`", logLevel), nil)
+	}
+
+	lc.mutex.Lock()
+	defer lc.mutex.Unlock()
+
+	*lc.logLevel = logLevel
+	return nil
+}
+
+func (lc jsonLogger) LogLevel() string {
+	lc.mutex.Lock()
+	defer lc.mutex.Unlock()
+
+	return *lc.logLevel
+}
+
+func (lc jsonLogger) Info(msg string, args ...interface{}) {
+	lc.log(models.InfoLog, false, msg, args...)
+}
+
+func (lc jsonLogger) Trace(msg string, args ...interface{}) {
+	lc.log(models.TraceLog, false, msg, args...)
+}
+
+func (lc jsonLogger) Debug(msg string, args ...interface{}) {
+	lc.log(models.DebugLog, false, msg, args...)
+}
+
+func (lc jsonLogger) Warn(msg string, args ...interface{}) {
+	lc.log(models.WarnLog, false, msg, args...)
+}
+
+func (lc jsonLogger) Error(msg string, args ...interface{}) {
+	lc.log(models.ErrorLog, false, msg, args...)
+}
+
+func (lc jsonLogger) Infof(msg string, args ...interface{}) {
+	lc.log(models.InfoLog, true, msg, args...)
+}
+
+func (lc jsonLogger) Tracef(msg string, args ...interface{}) {
+	lc.log(models.TraceLog, true, msg, args...)
+}
+
+func (lc jsonLogger) Debugf(msg string, args ...interface{}) {
+	lc.log(models.DebugLog, true, msg, args...)
+}
+
+func (lc jsonLogger) Warnf(msg string, args ...interface{}) {
+	lc.log(models.WarnLog, true, msg, args...)
+}
+
+func (lc jsonLogger) Errorf(msg string, args ...interface{}) {
+	lc.log(models.ErrorLog, true, msg, args...)
+}
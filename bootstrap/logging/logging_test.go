@@ -0,0 +1,132 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceKey = "core-data"
+
+func parseEntries(t *testing.T, output *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	scanner := bufio.NewScanner(output)
+	for scanner.Scan() {
+		entry := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry), "expected valid JSON: %s", scanner.Text())
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestJSONClient(t *testing.T) {
+	output := &bytes.Buffer{}
+	lc := NewJSONClient(testServiceKey, models.InfoLog, output)
+
+	lc.Info("request received", common.CorrelationHeader, "1234", "path", "/api/v3/ping")
+	lc.Errorf("failed after %d attempts", 3)
+	lc.Warn("odd args", "key")
+	lc.Error("with error", "error", errors.New("boom"))
+	lc.Debug("filtered out by the log level")
+
+	entries := parseEntries(t, output)
+	require.Len(t, entries, 4)
+
+	for _, entry := range entries {
+		assert.Equal(t, testServiceKey, entry["service"])
+		assert.NotEmpty(t, entry["ts"])
+		assert.Contains(t, entry["source"], "logging_test.go:")
+	}
+
+	assert.Equal(t, models.InfoLog, entries[0]["level"])
+	assert.Equal(t, "request received", entries[0]["msg"])
+	assert.Equal(t, "1234", entries[0][CorrelationIDField])
+	assert.Equal(t, "/api/v3/ping", entries[0]["path"])
+
+	assert.Equal(t, models.ErrorLog, entries[1]["level"])
+	assert.Equal(t, "failed after 3 attempts", entries[1]["msg"])
+
+	assert.Equal(t, "", entries[2]["key"])
+	assert.Equal(t, "boom", entries[3]["error"])
+}
+
+func TestJSONClientLogLevel(t *testing.T) {
+	output := &bytes.Buffer{}
+	lc := NewJSONClient(testServiceKey, "invalid", output)
+	assert.Equal(t, models.InfoLog, lc.LogLevel())
+
+	require.NoError(t, lc.SetLogLevel(models.TraceLog))
+	assert.Equal(t, models.TraceLog, lc.LogLevel())
+	assert.Error(t, lc.SetLogLevel("invalid"))
+
+	lc.Trace("now logged")
+	entries := parseEntries(t, output)
+	require.Len(t, entries, 1)
+	assert.Equal(t, models.TraceLog, entries[0]["level"])
+}
+
+// captureStdout returns what is written to stdout by the LoggingClient created while stdout is redirected
+func captureStdout(t *testing.T, format string, log func(lc logger.LoggingClient)) string {
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+
+	stdout := os.Stdout
+	os.Stdout = writer
+	lc := NewClient(testServiceKey, models.InfoLog, format)
+	os.Stdout = stdout
+
+	log(lc)
+	require.NoError(t, writer.Close())
+
+	output, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return string(output)
+}
+
+func TestNewClient(t *testing.T) {
+	logMessage := func(lc logger.LoggingClient) {
+		lc.Info("service started")
+	}
+
+	t.Run("text by default", func(t *testing.T) {
+		for _, format := range []string{"", FormatText, "unknown"} {
+			output := captureStdout(t, format, logMessage)
+			assert.Contains(t, output, "app="+testServiceKey)
+			assert.Contains(t, output, `msg="service started"`)
+			assert.False(t, json.Valid([]byte(strings.TrimSpace(output))))
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		output := captureStdout(t, "JSON", logMessage)
+		entries := parseEntries(t, bytes.NewBufferString(output))
+		require.Len(t, entries, 1)
+		assert.Equal(t, testServiceKey, entries[0]["service"])
+		assert.Equal(t, "service started", entries[0]["msg"])
+	})
+}
@@ -0,0 +1,113 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package logging
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// maxSampledMessages bounds the number of message templates tracked, the counts are reset once exceeded
+const maxSampledMessages = 1000
+
+// sampleCount tracks the occurrences of a message template
+type sampleCount struct {
+	occurrences int
+	lastLogged  time.Time
+}
+
+// samplingLogger is a LoggingClient which rate limits repeated Trace and Debug messages, keyed by the level and
+// message template, before passing them to the wrapped LoggingClient. The first occurrence of a message is always
+// logged, then every Nth occurrence and/or the first occurrence once the interval has passed since it was last
+// logged. Info, Warn and Error messages are never sampled so no signal is lost.
+type samplingLogger struct {
+	logger.LoggingClient
+	every    int
+	interval time.Duration
+	counts   map[string]*sampleCount
+	mutex    sync.Mutex
+}
+
+// NewSamplingClient wraps the LoggingClient so repeated Trace and Debug messages are only logged once per every
+// occurrences and/or once per interval. Zero disables the respective limit, the LoggingClient is returned unwrapped
+// when both are zero.
+func NewSamplingClient(lc logger.LoggingClient, every int, interval time.Duration) logger.LoggingClient {
+	if every <= 0 && interval <= 0 {
+		return lc
+	}
+
+	return &samplingLogger{
+		LoggingClient: lc,
+		every:         every,
+		interval:      interval,
+		counts:        make(map[string]*sampleCount),
+	}
+}
+
+// sample returns whether the occurrence of the message should be logged
+func (s *samplingLogger) sample(level string, msg string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := level + ":" + msg
+	count, exists := s.counts[key]
+	if !exists {
+		if len(s.counts) >= maxSampledMessages {
+			s.counts = make(map[string]*sampleCount)
+		}
+		count = &sampleCount{}
+		s.counts[key] = count
+	}
+
+	count.occurrences++
+	now := time.Now()
+
+	log := count.occurrences == 1 ||
+		(s.every > 0 && (count.occurrences-1)%s.every == 0) ||
+		(s.interval > 0 && now.Sub(count.lastLogged) >= s.interval)
+
+	if log {
+		count.lastLogged = now
+	}
+
+	return log
+}
+
+func (s *samplingLogger) Trace(msg string, args ...interface{}) {
+	if s.sample(models.TraceLog, msg) {
+		s.LoggingClient.Trace(msg, args...)
+	}
+}
+
+func (s *samplingLogger) Debug(msg string, args ...interface{}) {
+	if s.sample(models.DebugLog, msg) {
+		s.LoggingClient.Debug(msg, args...)
+	}
+}
+
+func (s *samplingLogger) Tracef(msg string, args ...interface{}) {
+	if s.sample(models.TraceLog, msg) {
+		s.LoggingClient.Tracef(msg, args...)
+	}
+}
+
+func (s *samplingLogger) Debugf(msg string, args ...interface{}) {
+	if s.sample(models.DebugLog, msg) {
+		s.LoggingClient.Debugf(msg, args...)
+	}
+}
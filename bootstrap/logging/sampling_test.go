@@ -0,0 +1,86 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package logging
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSamplingClientEvery(t *testing.T) {
+	output := &bytes.Buffer{}
+	lc := NewSamplingClient(NewJSONClient(testServiceKey, models.TraceLog, output), 10, 0)
+
+	for i := 0; i < 25; i++ {
+		lc.Debugf("Publish %d metrics", i)
+		lc.Trace("tick")
+	}
+
+	entries := parseEntries(t, output)
+	var published []string
+	ticks := 0
+	for _, entry := range entries {
+		if entry["msg"] == "tick" {
+			ticks++
+			continue
+		}
+		published = append(published, entry["msg"].(string))
+	}
+
+	// The 1st, 11th and 21st occurrences are logged
+	assert.Equal(t, []string{"Publish 0 metrics", "Publish 10 metrics", "Publish 20 metrics"}, published)
+	assert.Equal(t, 3, ticks)
+	assert.Contains(t, entries[0]["source"], "sampling_test.go:")
+}
+
+func TestSamplingClientInterval(t *testing.T) {
+	output := &bytes.Buffer{}
+	lc := NewSamplingClient(NewJSONClient(testServiceKey, models.DebugLog, output), 0, time.Millisecond*50)
+
+	for i := 0; i < 100; i++ {
+		lc.Debug("flood")
+	}
+	require.Len(t, parseEntries(t, output), 1)
+
+	time.Sleep(time.Millisecond * 60)
+	for i := 0; i < 100; i++ {
+		lc.Debug("flood")
+	}
+	require.Len(t, parseEntries(t, output), 1)
+}
+
+func TestSamplingClientNotSampled(t *testing.T) {
+	output := &bytes.Buffer{}
+	lc := NewSamplingClient(NewJSONClient(testServiceKey, models.InfoLog, output), 10, time.Minute)
+
+	for i := 0; i < 5; i++ {
+		lc.Info("info")
+		lc.Warnf("warn %d", i)
+		lc.Error("error")
+	}
+
+	assert.Len(t, parseEntries(t, output), 15)
+	assert.Equal(t, models.InfoLog, lc.LogLevel())
+}
+
+func TestSamplingClientDisabled(t *testing.T) {
+	wrapped := NewJSONClient(testServiceKey, models.InfoLog, &bytes.Buffer{})
+	assert.Equal(t, wrapped, NewSamplingClient(wrapped, 0, 0))
+}
@@ -19,6 +19,7 @@
 package messaging
 
 import (
+	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"fmt"
@@ -102,6 +103,59 @@ func SetOptionsAuthData(messageBusInfo *config.MessageBusInfo, lc logger.Logging
 	return nil
 }
 
+// SetOptionsTLSData sets the TLS client certificate and key and/or CA certificate options from the secret named by
+// the TLSSecretName, so the connection to the MessageBus is TLS or mutual TLS. Nothing is set when the TLSSecretName
+// is not set, in which case the connection is plaintext unless the AuthMode uses certificates.
+func SetOptionsTLSData(messageBusInfo *config.MessageBusInfo, lc logger.LoggingClient, dic *di.Container) error {
+	if len(messageBusInfo.TLSSecretName) == 0 {
+		return nil
+	}
+
+	lc.Infof("Setting TLS options for MessageBus from SecretName='%s'", messageBusInfo.TLSSecretName)
+
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	if secretProvider == nil {
+		return errors.New("secret provider is missing. Make sure it is specified to be used in bootstrap.Run()")
+	}
+
+	secrets, err := secretProvider.GetSecret(messageBusInfo.TLSSecretName)
+	if err != nil {
+		return fmt.Errorf("unable to get TLS secret data for message bus: %w", err)
+	}
+
+	certPemBlock := secrets[SecretClientCert]
+	keyPemBlock := secrets[SecretClientKey]
+	caPemBlock := secrets[SecretCACert]
+
+	if len(certPemBlock) == 0 && len(keyPemBlock) == 0 && len(caPemBlock) == 0 {
+		return fmt.Errorf("no client cert, key or CA PEM block was found for secret=%s", messageBusInfo.TLSSecretName)
+	}
+
+	if messageBusInfo.Optional == nil {
+		messageBusInfo.Optional = map[string]string{}
+	}
+
+	if len(certPemBlock) > 0 || len(keyPemBlock) > 0 {
+		// need both to make a successful connection
+		if _, err := tls.X509KeyPair([]byte(certPemBlock), []byte(keyPemBlock)); err != nil {
+			return fmt.Errorf("invalid client cert and key PEM blocks for secret=%s: %w", messageBusInfo.TLSSecretName, err)
+		}
+
+		messageBusInfo.Optional[OptionsCertPEMBlockKey] = certPemBlock
+		messageBusInfo.Optional[OptionsKeyPEMBlockKey] = keyPemBlock
+	}
+
+	if len(caPemBlock) > 0 {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(caPemBlock)) {
+			return fmt.Errorf("error parsing CA Certificate for secret=%s", messageBusInfo.TLSSecretName)
+		}
+
+		messageBusInfo.Optional[OptionsCaPEMBlockKey] = caPemBlock
+	}
+
+	return nil
+}
+
 func GetSecretData(authMode string, secretName string, provider SecretDataProvider) (*SecretData, error) {
 	// No Auth? No Problem!...No secrets required.
 	if authMode == AuthModeNone {
@@ -257,3 +257,84 @@ func TestSetOptionalAuthData(t *testing.T) {
 		})
 	}
 }
+
+func TestSetOptionsTLSData(t *testing.T) {
+	provider := secret.NewInMemorySecretProvider(map[string]map[string]string{
+		"mtls": {
+			SecretClientCert: testClientCert,
+			SecretClientKey:  testClientKey,
+			SecretCACert:     testCACert,
+		},
+		"ca": {
+			SecretCACert: testCACert,
+		},
+		"cert-only": {
+			SecretClientCert: testClientCert,
+		},
+		"bad-ca": {
+			SecretCACert: "not a certificate",
+		},
+		"empty": {
+			SecretUsernameKey: "username",
+		},
+	})
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.SecretProviderName: func(get di.Get) interface{} {
+			return provider
+		},
+	})
+
+	tests := []struct {
+		Name                string
+		TLSSecretName       string
+		ExpectedOptionsData map[string]string
+		ErrorExpected       bool
+	}{
+		{
+			Name:          "Valid mutual TLS",
+			TLSSecretName: "mtls",
+			ExpectedOptionsData: map[string]string{
+				OptionsCertPEMBlockKey: testClientCert,
+				OptionsKeyPEMBlockKey:  testClientKey,
+				OptionsCaPEMBlockKey:   testCACert,
+			},
+		},
+		{
+			Name:          "Valid CA only",
+			TLSSecretName: "ca",
+			ExpectedOptionsData: map[string]string{
+				OptionsCaPEMBlockKey: testCACert,
+			},
+		},
+		{Name: "Plaintext - no TLS secret name", ExpectedOptionsData: nil},
+		{Name: "Invalid - cert without key", TLSSecretName: "cert-only", ErrorExpected: true},
+		{Name: "Invalid - bad CA", TLSSecretName: "bad-ca", ErrorExpected: true},
+		{Name: "Invalid - no TLS data", TLSSecretName: "empty", ErrorExpected: true},
+		{Name: "Invalid - secret not found", TLSSecretName: "notfound", ErrorExpected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			messageBusInfo := config.MessageBusInfo{
+				AuthMode:      AuthModeNone,
+				TLSSecretName: test.TLSSecretName,
+			}
+
+			err := SetOptionsTLSData(&messageBusInfo, lc, dic)
+			if test.ErrorExpected {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.ExpectedOptionsData, messageBusInfo.Optional)
+		})
+	}
+}
+
+func TestSetOptionsTLSData_NoProvider(t *testing.T) {
+	messageBusInfo := config.MessageBusInfo{TLSSecretName: "mtls"}
+	err := SetOptionsTLSData(&messageBusInfo, lc, di.NewContainer(di.ServiceConstructorMap{}))
+	require.Error(t, err)
+}
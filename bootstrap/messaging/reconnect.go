@@ -0,0 +1,245 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package messaging
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+)
+
+const (
+	// DefaultReconnectInitialBackoff is the time waited before the first attempt to reconnect to the MessageBus
+	DefaultReconnectInitialBackoff = time.Second
+	// DefaultReconnectMaxBackoff is the longest time waited between attempts to reconnect to the MessageBus
+	DefaultReconnectMaxBackoff = time.Second * 30
+)
+
+// MessageClientFactory creates a new, unconnected, MessageClient
+type MessageClientFactory func() (messaging.MessageClient, error)
+
+// subscription is the set of topics subscribed to by a single call to Subscribe
+type subscription struct {
+	topics        []types.TopicChannel
+	messageErrors chan error
+}
+
+// ReconnectingMessageClient is a messaging.MessageClient which re-establishes the connection to the MessageBus once
+// it has been lost. A failure to publish is treated as the connection being lost, as is a call to ConnectionLost.
+// A new client is created using the factory and connected with exponential backoff between attempts, then all the
+// previously subscribed topics are re-subscribed using the callers' channels, so reconnecting is transparent to them.
+type ReconnectingMessageClient struct {
+	lc             logger.LoggingClient
+	newClient      MessageClientFactory
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	client         messaging.MessageClient
+	subscriptions  []subscription
+	reconnecting   bool
+	disconnected   bool
+	done           chan struct{}
+	mutex          sync.RWMutex
+}
+
+// NewReconnectingMessageClient creates a ReconnectingMessageClient which wraps the passed in client and uses the
+// factory to create replacement clients once the connection is lost.
+func NewReconnectingMessageClient(
+	client messaging.MessageClient,
+	newClient MessageClientFactory,
+	lc logger.LoggingClient) *ReconnectingMessageClient {
+	return &ReconnectingMessageClient{
+		lc:             lc,
+		newClient:      newClient,
+		initialBackoff: DefaultReconnectInitialBackoff,
+		maxBackoff:     DefaultReconnectMaxBackoff,
+		client:         client,
+		done:           make(chan struct{}),
+	}
+}
+
+// Connect connects the wrapped client to the MessageBus
+func (r *ReconnectingMessageClient) Connect() error {
+	return r.currentClient().Connect()
+}
+
+// Publish publishes the message using the wrapped client. The connection is re-established in the background when
+// publishing fails, the error is still returned to the caller.
+func (r *ReconnectingMessageClient) Publish(message types.MessageEnvelope, topic string) error {
+	err := r.currentClient().Publish(message, topic)
+	if err != nil {
+		r.ConnectionLost(err)
+	}
+	return err
+}
+
+// Subscribe subscribes to the topics using the wrapped client. The topics are re-subscribed once reconnected.
+func (r *ReconnectingMessageClient) Subscribe(topics []types.TopicChannel, messageErrors chan error) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.client.Subscribe(topics, messageErrors); err != nil {
+		return err
+	}
+
+	r.subscriptions = append(r.subscriptions, subscription{
+		topics:        append([]types.TopicChannel(nil), topics...),
+		messageErrors: messageErrors,
+	})
+
+	return nil
+}
+
+// Request sends the request using the wrapped client.
+func (r *ReconnectingMessageClient) Request(
+	message types.MessageEnvelope,
+	requestTopic string,
+	responseTopicPrefix string,
+	timeout time.Duration) (*types.MessageEnvelope, error) {
+	return r.currentClient().Request(message, requestTopic, responseTopicPrefix, timeout)
+}
+
+// Unsubscribe unsubscribes from the topics using the wrapped client, so they are no longer re-subscribed.
+func (r *ReconnectingMessageClient) Unsubscribe(topics ...string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.client.Unsubscribe(topics...); err != nil {
+		return err
+	}
+
+	unsubscribed := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		unsubscribed[topic] = true
+	}
+
+	var subscriptions []subscription
+	for _, sub := range r.subscriptions {
+		var remaining []types.TopicChannel
+		for _, topic := range sub.topics {
+			if !unsubscribed[topic.Topic] {
+				remaining = append(remaining, topic)
+			}
+		}
+		if len(remaining) > 0 {
+			sub.topics = remaining
+			subscriptions = append(subscriptions, sub)
+		}
+	}
+	r.subscriptions = subscriptions
+
+	return nil
+}
+
+// Disconnect stops any reconnecting and disconnects the wrapped client from the MessageBus
+func (r *ReconnectingMessageClient) Disconnect() error {
+	r.mutex.Lock()
+	if !r.disconnected {
+		r.disconnected = true
+		close(r.done)
+	}
+	client := r.client
+	r.mutex.Unlock()
+
+	return client.Disconnect()
+}
+
+// ConnectionLost starts re-establishing the connection to the MessageBus in the background, unless already doing so.
+// Callers which detect the connection has been lost by other means, i.e. an error on the subscription's error
+// channel, use this to trigger reconnecting.
+func (r *ReconnectingMessageClient) ConnectionLost(err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.reconnecting || r.disconnected {
+		return
+	}
+
+	r.reconnecting = true
+	r.lc.Warnf("MessageBus connection lost, reconnecting: %v", err)
+	go r.reconnect()
+}
+
+// IsReconnecting returns whether the connection to the MessageBus has been lost and not yet re-established
+func (r *ReconnectingMessageClient) IsReconnecting() bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.reconnecting
+}
+
+func (r *ReconnectingMessageClient) reconnect() {
+	backoff := r.initialBackoff
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-time.After(backoff):
+		}
+
+		if err := r.tryReconnect(); err != nil {
+			r.lc.Warnf("Unable to reconnect to MessageBus, retrying in %s: %v", backoff.String(), err)
+			backoff *= 2
+			if backoff > r.maxBackoff {
+				backoff = r.maxBackoff
+			}
+			continue
+		}
+
+		r.lc.Info("Reconnected to MessageBus")
+		return
+	}
+}
+
+// tryReconnect creates and connects a new client, re-subscribes it to all the topics and then replaces the lost
+// client with it. The lost client isn't disconnected since that would close the callers' channels.
+func (r *ReconnectingMessageClient) tryReconnect() error {
+	client, err := r.newClient()
+	if err != nil {
+		return err
+	}
+
+	if err = client.Connect(); err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.disconnected {
+		return client.Disconnect()
+	}
+
+	for _, sub := range r.subscriptions {
+		// Not disconnected on failure since that would close the callers' channels already subscribed
+		if err = client.Subscribe(sub.topics, sub.messageErrors); err != nil {
+			return err
+		}
+	}
+
+	r.client = client
+	r.reconnecting = false
+	return nil
+}
+
+func (r *ReconnectingMessageClient) currentClient() messaging.MessageClient {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.client
+}
@@ -0,0 +1,188 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package messaging
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMessageClient records the subscriptions and publishes, failing to publish once the connection is dropped
+type fakeMessageClient struct {
+	mutex      sync.Mutex
+	connected  bool
+	dropped    bool
+	subscribed []types.TopicChannel
+	published  []string
+}
+
+func (f *fakeMessageClient) Connect() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.connected = true
+	return nil
+}
+
+func (f *fakeMessageClient) Publish(_ types.MessageEnvelope, topic string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.dropped {
+		return errors.New("connection lost")
+	}
+	f.published = append(f.published, topic)
+	return nil
+}
+
+func (f *fakeMessageClient) Subscribe(topics []types.TopicChannel, _ chan error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.subscribed = append(f.subscribed, topics...)
+	return nil
+}
+
+func (f *fakeMessageClient) Request(types.MessageEnvelope, string, string, time.Duration) (*types.MessageEnvelope, error) {
+	return nil, nil
+}
+
+func (f *fakeMessageClient) Unsubscribe(...string) error {
+	return nil
+}
+
+func (f *fakeMessageClient) Disconnect() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.connected = false
+	return nil
+}
+
+func (f *fakeMessageClient) drop() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.dropped = true
+}
+
+func (f *fakeMessageClient) subscribedTopics() []types.TopicChannel {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]types.TopicChannel(nil), f.subscribed...)
+}
+
+func (f *fakeMessageClient) publishedTopics() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]string(nil), f.published...)
+}
+
+func TestReconnectingMessageClient(t *testing.T) {
+	initial := &fakeMessageClient{}
+	replacement := &fakeMessageClient{}
+
+	var created []*fakeMessageClient
+	var createdMutex sync.Mutex
+	attempts := 0
+	factory := func() (messaging.MessageClient, error) {
+		createdMutex.Lock()
+		defer createdMutex.Unlock()
+		attempts++
+		if attempts < 3 {
+			return nil, errors.New("broker unavailable")
+		}
+		created = append(created, replacement)
+		return replacement, nil
+	}
+
+	client := NewReconnectingMessageClient(initial, factory, lc)
+	client.initialBackoff = time.Millisecond
+	client.maxBackoff = time.Millisecond * 5
+
+	var _ messaging.MessageClient = client
+
+	require.NoError(t, client.Connect())
+	assert.True(t, initial.connected)
+
+	events := types.TopicChannel{Topic: "events", Messages: make(chan types.MessageEnvelope)}
+	commands := types.TopicChannel{Topic: "commands", Messages: make(chan types.MessageEnvelope)}
+	messageErrors := make(chan error)
+	require.NoError(t, client.Subscribe([]types.TopicChannel{events, commands}, messageErrors))
+	require.NoError(t, client.Unsubscribe("commands"))
+
+	assert.False(t, client.IsReconnecting())
+	initial.drop()
+	assert.Error(t, client.Publish(types.MessageEnvelope{}, "metrics"))
+	assert.True(t, client.IsReconnecting())
+
+	require.Eventually(t, func() bool {
+		return len(replacement.subscribedTopics()) > 0
+	}, time.Second, time.Millisecond*5)
+
+	// Only the topics still subscribed are re-subscribed, using the caller's channels
+	subscribed := replacement.subscribedTopics()
+	require.Len(t, subscribed, 1)
+	assert.Equal(t, "events", subscribed[0].Topic)
+	assert.Equal(t, events.Messages, subscribed[0].Messages)
+	assert.True(t, replacement.connected)
+
+	require.Eventually(t, func() bool {
+		return client.Publish(types.MessageEnvelope{}, "metrics") == nil
+	}, time.Second, time.Millisecond*5)
+	assert.Equal(t, []string{"metrics"}, replacement.publishedTopics())
+	assert.False(t, client.IsReconnecting())
+
+	createdMutex.Lock()
+	assert.Equal(t, 3, attempts)
+	assert.Len(t, created, 1)
+	createdMutex.Unlock()
+}
+
+func TestReconnectingMessageClient_Disconnect(t *testing.T) {
+	initial := &fakeMessageClient{}
+	factoryCalled := make(chan struct{}, 1)
+	factory := func() (messaging.MessageClient, error) {
+		factoryCalled <- struct{}{}
+		return &fakeMessageClient{}, nil
+	}
+
+	client := NewReconnectingMessageClient(initial, factory, lc)
+	client.initialBackoff = time.Millisecond * 50
+
+	require.NoError(t, client.Connect())
+	initial.drop()
+	assert.Error(t, client.Publish(types.MessageEnvelope{}, "metrics"))
+
+	// Disconnecting stops the pending reconnect
+	require.NoError(t, client.Disconnect())
+	assert.False(t, initial.connected)
+
+	select {
+	case <-factoryCalled:
+		t.Fatal("expected reconnecting to stop once disconnected")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	// No reconnecting is started once disconnected
+	client.ConnectionLost(errors.New("connection lost"))
+	select {
+	case <-factoryCalled:
+		t.Fatal("expected no reconnecting once disconnected")
+	case <-time.After(time.Millisecond * 100):
+	}
+}
@@ -0,0 +1,42 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package messaging
+
+import (
+	"errors"
+)
+
+// ErrSubscriberCountUnsupported is returned by SubscriberCount when the MessageBus can't report the number of
+// subscribers to a topic
+var ErrSubscriberCountUnsupported = errors.New("MessageBus is unable to report the subscribers to a topic")
+
+// SubscriberCounter is implemented by the MessageClients which are able to query the MessageBus for the number of
+// subscribers to a topic, i.e. from the broker's statistics
+type SubscriberCounter interface {
+	// SubscriberCount returns the number of subscribers to the topic, or ErrSubscriberCountUnsupported when the
+	// MessageBus can't report them.
+	SubscriberCount(topic string) (int, error)
+}
+
+// SubscriberCount returns the number of subscribers to the topic reported by the wrapped client, or
+// ErrSubscriberCountUnsupported when the wrapped client doesn't implement SubscriberCounter.
+func (r *ReconnectingMessageClient) SubscriberCount(topic string) (int, error) {
+	counter, ok := r.currentClient().(SubscriberCounter)
+	if !ok {
+		return 0, ErrSubscriberCountUnsupported
+	}
+
+	return counter.SubscriberCount(topic)
+}
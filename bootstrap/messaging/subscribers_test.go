@@ -0,0 +1,53 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package messaging
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingMessageClient is a fakeMessageClient which reports the subscribers to the topics
+type countingMessageClient struct {
+	fakeMessageClient
+	subscribers map[string]int
+}
+
+func (c *countingMessageClient) SubscriberCount(topic string) (int, error) {
+	return c.subscribers[topic], nil
+}
+
+func TestReconnectingMessageClient_SubscriberCount(t *testing.T) {
+	newClient := func() (messaging.MessageClient, error) { return &fakeMessageClient{}, nil }
+
+	counting := &countingMessageClient{subscribers: map[string]int{"edgex/events": 2}}
+	target := NewReconnectingMessageClient(counting, newClient, logger.NewMockClient())
+
+	count, err := target.SubscriberCount("edgex/events")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	count, err = target.SubscriberCount("edgex/other")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	target = NewReconnectingMessageClient(&fakeMessageClient{}, newClient, logger.NewMockClient())
+	_, err = target.SubscriberCount("edgex/events")
+	assert.ErrorIs(t, err, ErrSubscriberCountUnsupported)
+}
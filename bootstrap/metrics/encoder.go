@@ -0,0 +1,61 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Encoder encodes the metrics published by the MessageBus reporter
+type Encoder interface {
+	// Marshal encodes the metric or batch of metrics
+	Marshal(v interface{}) ([]byte, error)
+	// ContentType returns the content type set on the published MessageEnvelope
+	ContentType() string
+}
+
+type jsonEncoder struct{}
+
+// NewJSONEncoder creates a new Encoder which encodes the metrics as JSON. This is the default Encoder.
+func NewJSONEncoder() Encoder {
+	return jsonEncoder{}
+}
+
+func (jsonEncoder) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonEncoder) ContentType() string {
+	return common.ContentTypeJSON
+}
+
+type cborEncoder struct{}
+
+// NewCBOREncoder creates a new Encoder which encodes the metrics as CBOR, which reduces the payload size over
+// constrained links
+func NewCBOREncoder() Encoder {
+	return cborEncoder{}
+}
+
+func (cborEncoder) Marshal(v interface{}) ([]byte, error) {
+	return cbor.Marshal(v)
+}
+
+func (cborEncoder) ContentType() string {
+	return common.ContentTypeCBOR
+}
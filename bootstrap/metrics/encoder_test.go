@@ -0,0 +1,86 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/fxamacker/cbor/v2"
+	gometrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+func TestMessageBusReporter_Report_Encoder(t *testing.T) {
+	metricName := "test-counter"
+
+	tests := []struct {
+		Name                string
+		Encoder             Encoder
+		ExpectedContentType string
+		Unmarshal           func(data []byte, v interface{}) error
+	}{
+		{"Default", nil, common.ContentTypeJSON, json.Unmarshal},
+		{"JSON", NewJSONEncoder(), common.ContentTypeJSON, json.Unmarshal},
+		{"CBOR", NewCBOREncoder(), common.ContentTypeCBOR, cbor.Unmarshal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			telemetryConfig := &config.TelemetryInfo{
+				Metrics: map[string]bool{metricName: true},
+			}
+
+			counter := gometrics.NewCounter()
+			counter.Inc(5)
+			reg := gometrics.NewRegistry()
+			require.NoError(t, reg.Register(metricName, counter))
+
+			var actual types.MessageEnvelope
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				message, ok := args.Get(0).(types.MessageEnvelope)
+				require.True(t, ok)
+				actual = message
+			})
+
+			var options []ReporterOption
+			if test.Encoder != nil {
+				options = append(options, WithEncoder(test.Encoder))
+			}
+
+			target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", nil, telemetryConfig, options...)
+			target.(MessageClientSetter).SetMessageClient(mockClient)
+			require.NoError(t, target.Report(reg, nil))
+
+			assert.Equal(t, test.ExpectedContentType, actual.ContentType)
+
+			metric := dtos.Metric{}
+			require.NoError(t, test.Unmarshal(actual.Payload, &metric))
+			assert.Equal(t, metricName, metric.Name)
+			require.Len(t, metric.Fields, 1)
+			assert.Equal(t, counterCountName, metric.Fields[0].Name)
+		})
+	}
+}
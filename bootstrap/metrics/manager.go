@@ -16,51 +16,182 @@ package metrics
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	gometrics "github.com/rcrowley/go-metrics"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+const (
+	// ReporterPublishedMetricName is the name of the Metrics Manager's Counter of metrics successfully reported
+	ReporterPublishedMetricName = "ReporterPublished"
+	// ReporterFailuresMetricName is the name of the Metrics Manager's Counter of metrics that failed to be reported
+	ReporterFailuresMetricName = "ReporterFailures"
+	// UnitTagKey is the name of the tag holding the unit of the metrics registered with a unit, i.e. ms or bytes
+	UnitTagKey = "unit"
 )
 
 type manager struct {
-	lc         logger.LoggingClient
-	metricTags map[string]map[string]string
-	tagsMutex  *sync.RWMutex
-	registry   gometrics.Registry
-	reporter   interfaces.MetricsReporter
-	interval   time.Duration
-	ticker     *time.Ticker
+	lc              logger.LoggingClient
+	metricTags      map[string]map[string]string
+	tagsMutex       *sync.RWMutex
+	registry        gometrics.Registry
+	reporter        interfaces.MetricsReporter
+	enabledReporter interfaces.MetricsReporter
+	reporterMutex   *sync.RWMutex
+	interval        time.Duration
+	metricIntervals map[string]time.Duration
+	lastReported    map[string]time.Time
+	intervalsMutex  *sync.RWMutex
+	overrides       *enabledOverrides
+	serviceTags     *serviceTags
+	startTimes      *startTimes
+	ticker          *time.Ticker
+	published       gometrics.Counter
+	failures        gometrics.Counter
+	runtimeMetrics  *runtimeMetrics
+	flushed         atomic.Bool
 }
 
 func (m *manager) ResetInterval(interval time.Duration) {
+	m.intervalsMutex.Lock()
 	m.interval = interval
+	m.intervalsMutex.Unlock()
+
+	// An interval of math.MaxInt64 effectively disables reporting, any other enables it
+	if interval < math.MaxInt64 {
+		m.enableReporter()
+	}
+
+	if m.ticker == nil {
+		return
+	}
+
+	m.ticker.Reset(m.tickInterval())
+	m.lc.Infof("Metrics Manager report interval changed to %s", interval.String())
+}
+
+// ResetMetricIntervals resets the per-metric report interval overrides. Metrics without an override are reported
+// using the interval set via ResetInterval.
+func (m *manager) ResetMetricIntervals(intervals map[string]time.Duration) {
+	m.intervalsMutex.Lock()
+	m.metricIntervals = intervals
+	m.intervalsMutex.Unlock()
+
 	if m.ticker == nil {
 		return
 	}
 
-	m.ticker.Reset(m.interval)
-	m.lc.Infof("Metrics Manager report interval changed to %s", m.interval.String())
+	m.ticker.Reset(m.tickInterval())
+	m.lc.Infof("Metrics Manager metric report interval overrides changed to %v", intervals)
 }
 
 // NewManager creates a new metrics manager
-func NewManager(lc logger.LoggingClient, interval time.Duration, reporter interfaces.MetricsReporter) interfaces.MetricsManager {
+func NewManager(lc logger.LoggingClient, interval time.Duration, reporter interfaces.MetricsReporter, options ...ManagerOption) interfaces.MetricsManager {
 	m := &manager{
-		lc:         lc,
-		registry:   gometrics.NewRegistry(),
-		reporter:   reporter,
-		interval:   interval,
-		metricTags: make(map[string]map[string]string),
-		tagsMutex:  new(sync.RWMutex),
+		lc:             lc,
+		registry:       gometrics.NewRegistry(),
+		reporter:       reporter,
+		reporterMutex:  new(sync.RWMutex),
+		interval:       interval,
+		metricTags:     make(map[string]map[string]string),
+		tagsMutex:      new(sync.RWMutex),
+		lastReported:   make(map[string]time.Time),
+		intervalsMutex: new(sync.RWMutex),
+		overrides:      newEnabledOverrides(),
+		serviceTags:    newServiceTags(),
+		startTimes:     newStartTimes(),
+		published:      gometrics.NewCounter(),
+		failures:       gometrics.NewCounter(),
+	}
+
+	// The reporter health metrics are in the same registry so they are reported to the same sink as the service's
+	// metrics when enabled in the service's Telemetry configuration.
+	_ = m.registry.Register(ReporterPublishedMetricName, m.published)
+	_ = m.registry.Register(ReporterFailuresMetricName, m.failures)
+	m.startTimes.set(ReporterPublishedMetricName, time.Now())
+	m.startTimes.set(ReporterFailuresMetricName, time.Now())
+
+	m.attachReporter(reporter)
+
+	for _, option := range options {
+		option(m)
 	}
 
 	return m
 }
 
+// attachReporter shares the manager's enabled overrides, service tags and start times with the reporter
+func (m *manager) attachReporter(reporter interfaces.MetricsReporter) {
+	if target, ok := reporter.(overridableReporter); ok {
+		target.setEnabledOverrides(m.overrides)
+	}
+
+	if target, ok := reporter.(taggableReporter); ok {
+		target.setServiceTags(m.serviceTags)
+	}
+
+	if target, ok := reporter.(startTimeReporter); ok {
+		target.setStartTimes(m.startTimes)
+	}
+}
+
+// enableReporter swaps in the reporter set via WithEnabledReporter, if any, once reporting is enabled
+func (m *manager) enableReporter() {
+	m.reporterMutex.Lock()
+	defer m.reporterMutex.Unlock()
+
+	if m.enabledReporter == nil {
+		return
+	}
+
+	m.attachReporter(m.enabledReporter)
+	m.reporter = m.enabledReporter
+	m.enabledReporter = nil
+	m.lc.Info("Metrics Manager reporter enabled")
+}
+
+func (m *manager) currentReporter() interfaces.MetricsReporter {
+	m.reporterMutex.RLock()
+	defer m.reporterMutex.RUnlock()
+
+	return m.reporter
+}
+
+// SetMetricEnabled overrides whether the named metric is reported, taking precedence over the configured Metrics.
+// The name is matched as a prefix of the registered metric names in the same way as the configured Metrics names.
+func (m *manager) SetMetricEnabled(name string, enabled bool) {
+	m.overrides.set(name, enabled)
+	m.lc.Infof("Metric '%s' enabled state overridden to %t", name, enabled)
+}
+
+// ClearMetricEnabled removes the override set by SetMetricEnabled so the configured Metrics determine if the named
+// metric is reported
+func (m *manager) ClearMetricEnabled(name string) {
+	m.overrides.clear(name)
+	m.lc.Infof("Metric '%s' enabled state override cleared", name)
+}
+
+// ResetServiceTags atomically swaps the service level tags reported with every metric, i.e. when the Telemetry Tags
+// have been updated via the Configuration Provider, so the next report uses the new tags.
+func (m *manager) ResetServiceTags(tags map[string]string) {
+	previous := m.serviceTags.set(tags)
+	m.lc.Infof("Metrics Manager service tags changed from %v to %v", previous, tags)
+}
+
 // Register registers a go-metric metric item which must be one of the
 func (m *manager) Register(name string, item interface{}, tags map[string]string) error {
 	if err := dtos.ValidateMetricName(name, "metric"); err != nil {
@@ -77,9 +208,38 @@ func (m *manager) Register(name string, item interface{}, tags map[string]string
 		return err
 	}
 
+	m.startTimes.set(name, time.Now())
 	return nil
 }
 
+// RegisterWithUnit registers a go-metric metric item along with the unit of its values, i.e. ms or bytes, which is
+// reported as the unit tag of the metric so the values can be displayed correctly downstream.
+func (m *manager) RegisterWithUnit(name string, item interface{}, unit string, tags map[string]string) error {
+	if err := dtos.ValidateMetricName(unit, "unit"); err != nil {
+		return err
+	}
+
+	unitTags := make(map[string]string, len(tags)+1)
+	for tagName, tagValue := range tags {
+		unitTags[tagName] = tagValue
+	}
+	unitTags[UnitTagKey] = unit
+
+	return m.Register(name, item, unitTags)
+}
+
+// RegisterGaugeFunc registers a functional Gauge whose value is computed by calling the function when the metrics
+// are reported, i.e. for metrics such as the current queue depth.
+func (m *manager) RegisterGaugeFunc(name string, valueFunc func() int64, tags map[string]string) error {
+	return m.Register(name, gometrics.NewFunctionalGauge(valueFunc), tags)
+}
+
+// RegisterGaugeFloat64Func registers a functional GaugeFloat64 whose value is computed by calling the function when
+// the metrics are reported.
+func (m *manager) RegisterGaugeFloat64Func(name string, valueFunc func() float64, tags map[string]string) error {
+	return m.Register(name, gometrics.NewFunctionalGaugeFloat64(valueFunc), tags)
+}
+
 // IsRegistered checks whether a metric has been registered
 func (m *manager) IsRegistered(name string) bool {
 	return m.registry.Get(name) != nil
@@ -92,12 +252,21 @@ func (m *manager) Unregister(name string) {
 
 	m.registry.Unregister(name)
 	m.metricTags[name] = nil
+	m.startTimes.clear(name)
+}
+
+// StartTime returns the time the named metric was registered, which is the start of the interval its cumulative
+// values are accumulated over, and whether the metric is registered. A metric registered again after being
+// unregistered has a new start time, as its values restart from zero.
+func (m *manager) StartTime(name string) (time.Time, bool) {
+	return m.startTimes.get(name)
 }
 
 // Run periodically (based on configured interval) reports the collected metrics using the configured MetricsReporter.
 func (m *manager) Run(ctx context.Context, wg *sync.WaitGroup) {
 
-	m.ticker = time.NewTicker(m.interval)
+	m.ticker = time.NewTicker(m.tickInterval())
+	started := time.Now()
 
 	wg.Add(1)
 
@@ -110,12 +279,8 @@ func (m *manager) Run(ctx context.Context, wg *sync.WaitGroup) {
 				m.lc.Info("Exited Metrics Manager Run...")
 				return
 
-			case <-m.ticker.C:
-				m.tagsMutex.RLock()
-				tags := copyTagMaps(m.metricTags)
-				m.tagsMutex.RUnlock()
-
-				if err := m.reporter.Report(m.registry, tags); err != nil {
+			case now := <-m.ticker.C:
+				if err := m.report(ctx, m.dueRegistry(started, now)); err != nil {
 					m.lc.Errorf(err.Error())
 					continue
 				}
@@ -128,6 +293,141 @@ func (m *manager) Run(ctx context.Context, wg *sync.WaitGroup) {
 	m.lc.Infof("Metrics Manager started with a report interval of %s", m.interval.String())
 }
 
+// Flush reports all the metrics one last time, so the changes since the last report aren't lost on shutdown. The
+// metrics are only flushed once, and aren't flushed when the context has already been cancelled, as the report would
+// be abandoned. The report is stopped when the context's deadline passes.
+func (m *manager) Flush(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("metrics not flushed: %s", ctx.Err().Error())
+	}
+
+	if !m.flushed.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	if err := m.report(ctx, m.registry); err != nil {
+		return err
+	}
+
+	m.lc.Info("Flushed metrics")
+	return nil
+}
+
+// report reports the metrics in the registry, including the reporter health metrics, in a single report. The health
+// metrics are updated after the report, so they hold the results up to the previous report, and the reporters don't
+// count them in the number of metrics reported so reporting them doesn't inflate their own counts.
+func (m *manager) report(ctx context.Context, registry gometrics.Registry) error {
+	// The runtime metrics are refreshed from a single snapshot of the runtime stats per report
+	if m.runtimeMetrics != nil {
+		m.runtimeMetrics.refresh()
+	}
+
+	m.tagsMutex.RLock()
+	tags := copyTagMaps(m.metricTags)
+	m.tagsMutex.RUnlock()
+
+	publishedCount, err := m.currentReporter().ReportWithContext(ctx, registry, tags)
+	m.recordReport(publishedCount, err)
+
+	return err
+}
+
+// recordReport updates the reporter health metrics from the result of reporting the service's metrics. The number
+// of failures is the number of errors in the multierror returned by the reporter.
+func (m *manager) recordReport(publishedCount int, err error) {
+	m.published.Inc(int64(publishedCount))
+
+	if err == nil {
+		return
+	}
+
+	var errs *multierror.Error
+	if errors.As(err, &errs) {
+		m.failures.Inc(int64(len(errs.Errors)))
+		return
+	}
+
+	m.failures.Inc(1)
+}
+
+func isSelfMetric(name string) bool {
+	return name == ReporterPublishedMetricName || name == ReporterFailuresMetricName
+}
+
+// tickInterval returns the shortest of the report interval and the per-metric interval overrides, which is the
+// interval at which the Run loop must wake up to report all metrics when they are due.
+func (m *manager) tickInterval() time.Duration {
+	m.intervalsMutex.RLock()
+	defer m.intervalsMutex.RUnlock()
+
+	return m.shortestInterval()
+}
+
+// shortestInterval returns the shortest of all the intervals. The caller must hold the intervalsMutex.
+func (m *manager) shortestInterval() time.Duration {
+	tick := m.interval
+	for _, interval := range m.metricIntervals {
+		if interval < tick {
+			tick = interval
+		}
+	}
+
+	return tick
+}
+
+// dueRegistry returns the registry of metrics that are due to be reported. When there are no per-metric interval
+// overrides every tick is due for all metrics, so the full registry is returned.
+func (m *manager) dueRegistry(started time.Time, now time.Time) gometrics.Registry {
+	m.intervalsMutex.Lock()
+	defer m.intervalsMutex.Unlock()
+
+	if len(m.metricIntervals) == 0 {
+		return m.registry
+	}
+
+	// Allow for the ticker firing slightly early relative to the last report time
+	tolerance := m.shortestInterval() / 2
+
+	due := gometrics.NewRegistry()
+	m.registry.Each(func(name string, item interface{}) {
+		lastReported, found := m.lastReported[name]
+		if !found {
+			lastReported = started
+		}
+
+		if now.Sub(lastReported)+tolerance < m.metricInterval(name) {
+			return
+		}
+
+		m.lastReported[name] = now
+		_ = due.Register(name, item)
+	})
+
+	return due
+}
+
+// metricInterval returns the interval override for the metric if one has been set, otherwise the report interval.
+// The override's metric name is matched as a prefix of the registered metric name in the same way the configured
+// Metrics names are matched. An exact match is used over a prefix match and the longest of the matching prefixes is
+// used when several overrides match, so the interval doesn't depend on the order the overrides are ranged over.
+// The caller must hold the intervalsMutex.
+func (m *manager) metricInterval(name string) time.Duration {
+	if interval, found := m.metricIntervals[name]; found {
+		return interval
+	}
+
+	result := m.interval
+	longestMatch := 0
+	for metricName, interval := range m.metricIntervals {
+		if len(metricName) > longestMatch && strings.HasPrefix(name, metricName) {
+			result = interval
+			longestMatch = len(metricName)
+		}
+	}
+
+	return result
+}
+
 func copyTagMaps(origTagMaps map[string]map[string]string) map[string]map[string]string {
 	tags := make(map[string]map[string]string)
 	for key, value := range origTagMaps {
@@ -214,6 +514,61 @@ func (m *manager) GetTimer(name string) gometrics.Timer {
 	return timer
 }
 
+// Snapshot returns the current values of the enabled metrics, sorted by name. The metrics are filtered and named in
+// the same way as when they are reported, so the snapshot matches what the reporter publishes. The Counter deltas are
+// not included since they are relative to the previous report.
+func (m *manager) Snapshot(telemetryConfig *config.TelemetryInfo) ([]dtos.Metric, error) {
+	var errs error
+
+	m.tagsMutex.RLock()
+	tags := copyTagMaps(m.metricTags)
+	m.tagsMutex.RUnlock()
+
+	serviceTags := buildSnapshotTags(m.serviceTags.get(telemetryConfig))
+
+	metrics := []dtos.Metric{}
+	m.registry.Each(func(itemName string, item interface{}) {
+		name, isEnabled := m.overrides.getEnabledMetricName(itemName, telemetryConfig)
+		if !isEnabled {
+			return
+		}
+
+		fields, err := buildMetricFields(item, telemetryConfig.GetHistogramPercentiles())
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			return
+		}
+
+		metric, err := dtos.NewMetric(name, fields, mergeMetricTags(serviceTags, buildSnapshotTags(tags[itemName])))
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("unable to create metric for '%s': %s", name, err.Error()))
+			return
+		}
+
+		metrics = append(metrics, metric)
+	})
+
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Name < metrics[j].Name
+	})
+
+	return metrics, errs
+}
+
+// buildSnapshotTags builds the metric tags sorted by name so the snapshot is stable between requests
+func buildSnapshotTags(tags map[string]string) []dtos.MetricTag {
+	metricTags := make([]dtos.MetricTag, 0, len(tags))
+	for tagName, tagValue := range tags {
+		metricTags = append(metricTags, dtos.MetricTag{Name: tagName, Value: tagValue})
+	}
+
+	sort.Slice(metricTags, func(i, j int) bool {
+		return metricTags[i].Name < metricTags[j].Name
+	})
+
+	return metricTags
+}
+
 func (m *manager) setMetricTags(metricName string, tags map[string]string) error {
 	for tagName := range tags {
 		if err := dtos.ValidateMetricName(tagName, "Tag"); err != nil {
@@ -16,11 +16,19 @@ package metrics
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/labstack/echo/v4"
 	gometrics "github.com/rcrowley/go-metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -30,6 +38,7 @@ import (
 	mocks2 "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger/mocks"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 )
 
 func TestNewManager(t *testing.T) {
@@ -128,6 +137,35 @@ func TestManager_Register_Unregister(t *testing.T) {
 	assert.Equal(t, expectedTags, target.metricTags[expectedName])
 }
 
+func TestManager_RegisterGaugeFunc(t *testing.T) {
+	target := NewManager(logger.NewMockClient(), time.Second*5, nil)
+
+	queueDepth := int64(5)
+	err := target.RegisterGaugeFunc("queue-depth", func() int64 { return queueDepth }, map[string]string{"queue": "my-queue"})
+	require.NoError(t, err)
+
+	gauge := target.GetGauge("queue-depth")
+	require.NotNil(t, gauge)
+	assert.Equal(t, int64(5), gauge.Snapshot().Value())
+
+	queueDepth = 10
+	assert.Equal(t, int64(10), gauge.Snapshot().Value())
+
+	ratio := 0.25
+	err = target.RegisterGaugeFloat64Func("queue-ratio", func() float64 { return ratio }, nil)
+	require.NoError(t, err)
+
+	gaugeFloat64 := target.GetGaugeFloat64("queue-ratio")
+	require.NotNil(t, gaugeFloat64)
+	assert.Equal(t, 0.25, gaugeFloat64.Snapshot().Value())
+
+	ratio = 0.75
+	assert.Equal(t, 0.75, gaugeFloat64.Snapshot().Value())
+
+	err = target.RegisterGaugeFunc(" ", func() int64 { return 0 }, nil)
+	require.Error(t, err)
+}
+
 func TestManager_Register_Error(t *testing.T) {
 	target := NewManager(logger.NewMockClient(), time.Second*5, &mocks.MetricsReporter{})
 
@@ -154,7 +192,7 @@ func TestManager_Run(t *testing.T) {
 	m := NewManager(logger.NewMockClient(), time.Millisecond*1, mockReporter)
 	target := m.(*manager)
 
-	mockReporter.On("Report", target.registry, target.metricTags).Return(nil)
+	mockReporter.On("ReportWithContext", mock.Anything, mock.Anything, target.metricTags).Return(0, nil)
 
 	target.Run(ctx, wg)
 	time.Sleep(time.Millisecond * 100)
@@ -179,7 +217,7 @@ func TestManager_Run_Error(t *testing.T) {
 	m := NewManager(mockLogger, time.Millisecond*1, mockReporter)
 	target := m.(*manager)
 
-	mockReporter.On("Report", target.registry, target.metricTags).Return(errors.New("failed"))
+	mockReporter.On("ReportWithContext", mock.Anything, mock.Anything, target.metricTags).Return(0, errors.New("failed"))
 	mockLogger.On("Errorf", "failed", mock.Anything)
 	mockLogger.On("Infof", mock.Anything, mock.Anything)
 	target.Run(context.Background(), &sync.WaitGroup{})
@@ -189,6 +227,185 @@ func TestManager_Run_Error(t *testing.T) {
 	mockLogger.AssertExpectations(t)
 }
 
+func TestManager_Run_MetricIntervals(t *testing.T) {
+	fastMetricName := "fast-metric"
+	slowMetricName := "slow-metric"
+
+	mockReporter := &mocks.MetricsReporter{}
+	m := NewManager(logger.NewMockClient(), time.Millisecond*200, mockReporter)
+	target := m.(*manager)
+	target.ResetMetricIntervals(map[string]time.Duration{fastMetricName: time.Millisecond * 20})
+
+	require.NoError(t, target.Register(fastMetricName, gometrics.NewCounter(), nil))
+	require.NoError(t, target.Register(slowMetricName, gometrics.NewCounter(), nil))
+
+	mutex := sync.Mutex{}
+	reportedCounts := make(map[string]int)
+	mockReporter.On("ReportWithContext", mock.Anything, mock.Anything, mock.Anything).Return(0, nil).Run(func(args mock.Arguments) {
+		registry := args.Get(1).(gometrics.Registry)
+		mutex.Lock()
+		defer mutex.Unlock()
+		registry.Each(func(name string, _ interface{}) {
+			reportedCounts[name]++
+		})
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	target.Run(ctx, wg)
+	time.Sleep(time.Millisecond * 500)
+	cancel()
+	wg.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.GreaterOrEqual(t, reportedCounts[slowMetricName], 1)
+	assert.Greater(t, reportedCounts[fastMetricName], reportedCounts[slowMetricName]*5)
+}
+
+func TestManager_MetricInterval(t *testing.T) {
+	m := NewManager(logger.NewMockClient(), time.Second*30, NewNullReporter())
+	target := m.(*manager)
+	target.ResetMetricIntervals(map[string]time.Duration{
+		"Events":         time.Second * 5,
+		"EventsSent":     time.Second * 10,
+		"EventsSent-dev": time.Second * 15,
+	})
+
+	tests := []struct {
+		name     string
+		metric   string
+		expected time.Duration
+	}{
+		{"exact match", "EventsSent", time.Second * 10},
+		{"longest prefix", "EventsSent-device-1", time.Second * 15},
+		{"shorter prefix", "EventsReceived", time.Second * 5},
+		{"no match", "ReadingsSent", time.Second * 30},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Repeated since the overrides are ranged over in a random order
+			for i := 0; i < 20; i++ {
+				assert.Equal(t, test.expected, target.metricInterval(test.metric))
+			}
+		})
+	}
+}
+
+func TestManager_Run_SelfMetrics(t *testing.T) {
+	metricName := "MyCounter"
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{
+			metricName:                  true,
+			ReporterPublishedMetricName: true,
+			ReporterFailuresMetricName:  true,
+		},
+	}
+
+	mutex := sync.Mutex{}
+	var selfPublishes int
+	mockClient := &messagingMocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, mock.MatchedBy(func(topic string) bool {
+		return strings.HasSuffix(topic, metricName)
+	})).Return(errors.New("publish failed"))
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		selfPublishes++
+	})
+
+	reporter := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", nil, telemetryConfig)
+	reporter.(MessageClientSetter).SetMessageClient(mockClient)
+
+	m := NewManager(logger.NewMockClient(), time.Millisecond*10, reporter)
+	target := m.(*manager)
+	require.NoError(t, target.Register(metricName, gometrics.NewCounter(), nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	target.Run(ctx, wg)
+	time.Sleep(time.Millisecond * 100)
+	cancel()
+	wg.Wait()
+
+	failures := target.GetCounter(ReporterFailuresMetricName)
+	require.NotNil(t, failures)
+	assert.Greater(t, failures.Count(), int64(0))
+
+	// The successful publishes of the self metrics are not counted
+	published := target.GetCounter(ReporterPublishedMetricName)
+	require.NotNil(t, published)
+	assert.Equal(t, int64(0), published.Count())
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Greater(t, selfPublishes, 0)
+}
+
+func TestManager_Report_SelfMetricsBatchPublish(t *testing.T) {
+	metricName := "MyCounter"
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{
+			metricName:                  true,
+			ReporterPublishedMetricName: true,
+			ReporterFailuresMetricName:  true,
+		},
+		BatchPublish: true,
+	}
+
+	var batches [][]dtos.Metric
+	mockClient := &messagingMocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		message := args.Get(0).(types.MessageEnvelope)
+		var batch []dtos.Metric
+		require.NoError(t, json.Unmarshal(message.Payload, &batch))
+		batches = append(batches, batch)
+	})
+
+	reporter := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", nil, telemetryConfig)
+	reporter.(MessageClientSetter).SetMessageClient(mockClient)
+
+	target := NewManager(logger.NewMockClient(), time.Second, reporter).(*manager)
+	require.NoError(t, target.Register(metricName, gometrics.NewCounter(), nil))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, target.report(context.Background(), target.registry))
+	}
+
+	// The self metrics are published in the same batch as the service's metrics
+	mockClient.AssertNumberOfCalls(t, "Publish", 3)
+	require.Len(t, batches, 3)
+
+	for index, batch := range batches {
+		published := make(map[string]float64)
+		for _, metric := range batch {
+			require.NotEmpty(t, metric.Fields)
+			published[metric.Name] = metric.Fields[0].Value.(float64)
+		}
+
+		require.Len(t, published, 3)
+		// Each report only counts the service's metric and holds the count up to the previous report
+		assert.Equal(t, float64(index), published[ReporterPublishedMetricName])
+		assert.Equal(t, float64(0), published[ReporterFailuresMetricName])
+	}
+
+	assert.Equal(t, int64(3), target.published.Count())
+}
+
+func TestManager_RecordReport(t *testing.T) {
+	target := NewManager(logger.NewMockClient(), time.Second, nil).(*manager)
+
+	var errs error
+	errs = multierror.Append(errs, errors.New("failed one"), errors.New("failed two"))
+	target.recordReport(3, errs)
+	target.recordReport(1, errors.New("failed"))
+	target.recordReport(2, nil)
+
+	assert.Equal(t, int64(6), target.published.Count())
+	assert.Equal(t, int64(3), target.failures.Count())
+}
+
 func TestManager_ResetInterval(t *testing.T) {
 	mockReporter := &mocks.MetricsReporter{}
 	mockLogger := &mocks2.LoggingClient{}
@@ -203,3 +420,300 @@ func TestManager_ResetInterval(t *testing.T) {
 	target.ResetInterval(expected)
 	assert.Equal(t, expected, target.interval)
 }
+
+func TestManager_SetMetricEnabled(t *testing.T) {
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{
+			"EnabledMetric":  true,
+			"DisabledMetric": false,
+		},
+	}
+
+	reporter := NewPrometheusReporter(logger.NewMockClient(), "test-service", telemetryConfig, echo.New())
+	m := NewManager(logger.NewMockClient(), time.Second, reporter)
+	target := m.(*manager)
+
+	require.NoError(t, target.Register("EnabledMetric", gometrics.NewCounter(), nil))
+	require.NoError(t, target.Register("DisabledMetric", gometrics.NewCounter(), nil))
+	require.NoError(t, target.Register("UnconfiguredMetric", gometrics.NewCounter(), nil))
+
+	tests := []struct {
+		Name          string
+		Toggle        func()
+		ExpectedCount int
+	}{
+		{"Configuration only", func() {}, 1},
+		{"Disable enabled metric", func() { target.SetMetricEnabled("EnabledMetric", false) }, 0},
+		{"Enable disabled metric", func() { target.SetMetricEnabled("DisabledMetric", true) }, 1},
+		{"Enable unconfigured metric", func() { target.SetMetricEnabled("UnconfiguredMetric", true) }, 2},
+		{"Clear overrides", func() {
+			target.ClearMetricEnabled("EnabledMetric")
+			target.ClearMetricEnabled("DisabledMetric")
+			target.ClearMetricEnabled("UnconfiguredMetric")
+		}, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Toggle()
+			actual, err := reporter.ReportWithCount(target.registry, target.metricTags)
+			require.NoError(t, err)
+			assert.Equal(t, test.ExpectedCount, actual)
+		})
+	}
+}
+
+func TestManager_SetMetricEnabled_OverlappingPrefixes(t *testing.T) {
+	m := NewManager(logger.NewMockClient(), time.Second, nil)
+	target := m.(*manager)
+
+	target.SetMetricEnabled("Http", false)
+	target.SetMetricEnabled("HttpRequestLatency", true)
+	target.SetMetricEnabled("HttpRequestLatency_GET_api_v3_ping", false)
+
+	tests := []struct {
+		Name            string
+		ItemName        string
+		ExpectedName    string
+		ExpectedEnabled bool
+	}{
+		{"Exact match", "HttpRequestLatency", "HttpRequestLatency", true},
+		{"Longest prefix enabled", "HttpRequestLatency_GET_api_v3_config", "HttpRequestLatency", true},
+		{"Exact match disabled", "HttpRequestLatency_GET_api_v3_ping", "", false},
+		{"Shorter prefix disabled", "HttpRequestCount", "", false},
+		{"No match", "EventsPersisted", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			// Map iteration order is random, so repeat to verify the match doesn't depend on it
+			for i := 0; i < 100; i++ {
+				actualName, actualEnabled := target.overrides.getEnabledMetricName(test.ItemName, &config.TelemetryInfo{})
+				require.Equal(t, test.ExpectedEnabled, actualEnabled)
+				require.Equal(t, test.ExpectedName, actualName)
+			}
+		})
+	}
+}
+
+func TestManager_SetMetricEnabled_Concurrent(t *testing.T) {
+	metricName := "MyMetric"
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{metricName: true},
+	}
+
+	reporter := NewPrometheusReporter(logger.NewMockClient(), "test-service", telemetryConfig, echo.New())
+	m := NewManager(logger.NewMockClient(), time.Millisecond, reporter)
+	target := m.(*manager)
+	require.NoError(t, target.Register(metricName, gometrics.NewCounter(), nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	target.Run(ctx, wg)
+
+	togglers := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		togglers.Add(1)
+		go func(enabled bool) {
+			defer togglers.Done()
+			for j := 0; j < 100; j++ {
+				target.SetMetricEnabled(metricName, enabled)
+				target.ClearMetricEnabled(metricName)
+			}
+		}(i%2 == 0)
+	}
+
+	togglers.Wait()
+	cancel()
+	wg.Wait()
+
+	// All overrides have been cleared so the configuration determines the metric is reported
+	actual, err := reporter.ReportWithCount(target.registry, target.metricTags)
+	require.NoError(t, err)
+	assert.Equal(t, 1, actual)
+}
+
+func TestManager_ResetServiceTags(t *testing.T) {
+	metricName := "MyCounter"
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{metricName: true},
+		Tags:    map[string]string{"Gateway": "gateway-1"},
+	}
+
+	var actualTags []dtos.MetricTag
+	mockClient := &messagingMocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		message, ok := args.Get(0).(types.MessageEnvelope)
+		require.True(t, ok)
+		metric := dtos.Metric{}
+		require.NoError(t, json.Unmarshal(message.Payload, &metric))
+		actualTags = metric.Tags
+	})
+
+	reporter := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", nil, telemetryConfig)
+	reporter.(MessageClientSetter).SetMessageClient(mockClient)
+	m := NewManager(logger.NewMockClient(), time.Second, reporter)
+	target := m.(*manager)
+	require.NoError(t, target.Register(metricName, gometrics.NewCounter(), nil))
+
+	// Configured tags are used until the tags have been reset
+	_, err := reporter.ReportWithCount(target.registry, target.metricTags)
+	require.NoError(t, err)
+	assert.Contains(t, actualTags, dtos.MetricTag{Name: "Gateway", Value: "gateway-1"})
+
+	// Simulates the Telemetry Tags being updated via the Configuration Provider
+	updatedTags := map[string]string{"Gateway": "gateway-2", "Location": "site-a"}
+	target.ResetServiceTags(updatedTags)
+
+	// Changes to the caller's map after the reset must not affect the reported tags
+	updatedTags["Location"] = "site-b"
+
+	_, err = reporter.ReportWithCount(target.registry, target.metricTags)
+	require.NoError(t, err)
+	assert.Contains(t, actualTags, dtos.MetricTag{Name: "Gateway", Value: "gateway-2"})
+	assert.Contains(t, actualTags, dtos.MetricTag{Name: "Location", Value: "site-a"})
+	assert.NotContains(t, actualTags, dtos.MetricTag{Name: "Gateway", Value: "gateway-1"})
+}
+
+func TestManager_Snapshot(t *testing.T) {
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{
+			"Counter":  true,
+			"Gauge":    true,
+			"Timer":    true,
+			"Disabled": false,
+		},
+		Tags: map[string]string{"Gateway": "gateway-1"},
+	}
+
+	m := NewManager(logger.NewMockClient(), time.Second, NewNullReporter())
+	m.SetMetricEnabled("Overridden", true)
+
+	counter := gometrics.NewCounter()
+	counter.Inc(5)
+	gauge := gometrics.NewGauge()
+	gauge.Update(42)
+	timer := gometrics.NewTimer()
+	timer.Update(time.Millisecond * 100)
+	timer.Update(time.Millisecond * 300)
+
+	require.NoError(t, m.Register("Counter", counter, map[string]string{"Pipeline": "pipeline-1"}))
+	require.NoError(t, m.Register("Gauge", gauge, nil))
+	require.NoError(t, m.Register("Timer", timer, nil))
+	require.NoError(t, m.Register("Disabled", gometrics.NewCounter(), nil))
+	require.NoError(t, m.Register("Unconfigured", gometrics.NewCounter(), nil))
+	require.NoError(t, m.RegisterGaugeFunc("Overridden", func() int64 { return 7 }, nil))
+
+	actual, err := m.Snapshot(telemetryConfig)
+	require.NoError(t, err)
+
+	actualFields := make(map[string]map[string]any)
+	for _, metric := range actual {
+		fields := make(map[string]any)
+		for _, field := range metric.Fields {
+			fields[field.Name] = field.Value
+		}
+		actualFields[metric.Name] = fields
+		assert.Contains(t, metric.Tags, dtos.MetricTag{Name: "Gateway", Value: "gateway-1"})
+	}
+
+	require.Len(t, actual, 4)
+	assert.Equal(t, []string{"Counter", "Gauge", "Overridden", "Timer"},
+		[]string{actual[0].Name, actual[1].Name, actual[2].Name, actual[3].Name})
+	assert.Contains(t, actual[0].Tags, dtos.MetricTag{Name: "Pipeline", Value: "pipeline-1"})
+	assert.Equal(t, int64(5), actualFields["Counter"][counterCountName])
+	assert.NotContains(t, actualFields["Counter"], counterDeltaName)
+	assert.Equal(t, int64(42), actualFields["Gauge"][gaugeValueName])
+	assert.Equal(t, int64(7), actualFields["Overridden"][gaugeValueName])
+	assert.Equal(t, int64(2), actualFields["Timer"][timerCountName])
+	assert.Equal(t, int64(time.Millisecond*100), actualFields["Timer"][timerMinName])
+	assert.Equal(t, int64(time.Millisecond*300), actualFields["Timer"][timerMaxName])
+	assert.Equal(t, float64(time.Millisecond*200), actualFields["Timer"][timerMeanName])
+
+	// Counters are snapshotted on each request so the current values are returned
+	counter.Inc(3)
+	actual, err = m.Snapshot(telemetryConfig)
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), actual[0].Fields[0].Value)
+}
+
+func TestManager_RegisterWithUnit(t *testing.T) {
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{"QueueLatency": true, "QueueSize": true},
+	}
+
+	actualTags := make(map[string][]dtos.MetricTag)
+	mockClient := &messagingMocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		message, ok := args.Get(0).(types.MessageEnvelope)
+		require.True(t, ok)
+		metric := dtos.Metric{}
+		require.NoError(t, json.Unmarshal(message.Payload, &metric))
+		actualTags[metric.Name] = metric.Tags
+	})
+
+	reporter := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", nil, telemetryConfig)
+	reporter.(MessageClientSetter).SetMessageClient(mockClient)
+	m := NewManager(logger.NewMockClient(), time.Second, reporter)
+	target := m.(*manager)
+
+	tags := map[string]string{"Queue": "events"}
+	require.NoError(t, target.RegisterWithUnit("QueueLatency", gometrics.NewTimer(), "ms", tags))
+	require.NoError(t, target.RegisterWithUnit("QueueSize", gometrics.NewGauge(), "bytes", nil))
+	assert.Equal(t, map[string]string{"Queue": "events"}, tags, "caller's tags must not be modified")
+
+	err := target.RegisterWithUnit("NoUnit", gometrics.NewGauge(), " ", nil)
+	require.Error(t, err)
+	assert.False(t, target.IsRegistered("NoUnit"))
+
+	_, err = reporter.ReportWithCount(target.registry, target.metricTags)
+	require.NoError(t, err)
+
+	assert.Contains(t, actualTags["QueueLatency"], dtos.MetricTag{Name: UnitTagKey, Value: "ms"})
+	assert.Contains(t, actualTags["QueueLatency"], dtos.MetricTag{Name: "Queue", Value: "events"})
+	assert.Contains(t, actualTags["QueueSize"], dtos.MetricTag{Name: UnitTagKey, Value: "bytes"})
+}
+
+func TestManager_Flush(t *testing.T) {
+	mockReporter := &mocks.MetricsReporter{}
+	mockReporter.On("ReportWithContext", mock.Anything, mock.Anything, mock.Anything).Return(1, nil)
+
+	m := NewManager(logger.NewMockClient(), time.Hour, mockReporter)
+	counter := gometrics.NewCounter()
+	require.NoError(t, m.Register("MyCounter", counter, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	m.Run(ctx, wg)
+
+	// The last changes are only reported by the flush since the report interval never elapses
+	counter.Inc(1)
+	cancel()
+	wg.Wait()
+	mockReporter.AssertNotCalled(t, "ReportWithContext", mock.Anything, mock.Anything, mock.Anything)
+
+	flushCtx, flushCancel := context.WithTimeout(context.Background(), time.Second)
+	defer flushCancel()
+	require.NoError(t, m.Flush(flushCtx))
+
+	// The service's metrics are reported, followed by the reporter health metrics
+	mockReporter.AssertNumberOfCalls(t, "ReportWithContext", 2)
+	registry, ok := mockReporter.Calls[0].Arguments.Get(1).(gometrics.Registry)
+	require.True(t, ok)
+	assert.Same(t, counter, registry.Get("MyCounter"))
+
+	// The metrics are only flushed once
+	require.NoError(t, m.Flush(flushCtx))
+	mockReporter.AssertNumberOfCalls(t, "ReportWithContext", 2)
+}
+
+func TestManager_Flush_Cancelled(t *testing.T) {
+	mockReporter := &mocks.MetricsReporter{}
+	m := NewManager(logger.NewMockClient(), time.Hour, mockReporter)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.Error(t, m.Flush(ctx))
+	mockReporter.AssertNotCalled(t, "ReportWithContext", mock.Anything, mock.Anything, mock.Anything)
+}
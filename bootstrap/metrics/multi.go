@@ -0,0 +1,98 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/hashicorp/go-multierror"
+	gometrics "github.com/rcrowley/go-metrics"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+)
+
+type multiReporter struct {
+	reporters []interfaces.MetricsReporter
+}
+
+// NewMultiReporter creates a new reporter which reports the metrics to each of the reporters, i.e. to both the
+// MessageBus and Prometheus. A reporter failing to report doesn't prevent the remaining reporters from reporting.
+func NewMultiReporter(reporters ...interfaces.MetricsReporter) interfaces.MetricsReporter {
+	return &multiReporter{
+		reporters: reporters,
+	}
+}
+
+// Report reports the metrics to each of the reporters and returns the errors of all the reporters
+func (r *multiReporter) Report(registry gometrics.Registry, metricTags map[string]map[string]string) error {
+	_, err := r.ReportWithCount(registry, metricTags)
+	return err
+}
+
+// ReportWithCount reports the metrics to each of the reporters and returns the total number of metrics successfully
+// reported by all the reporters
+func (r *multiReporter) ReportWithCount(registry gometrics.Registry, metricTags map[string]map[string]string) (int, error) {
+	return r.ReportWithContext(context.Background(), registry, metricTags)
+}
+
+// ReportWithContext is the same as ReportWithCount, with the context passed to each of the reporters
+func (r *multiReporter) ReportWithContext(ctx context.Context, registry gometrics.Registry, metricTags map[string]map[string]string) (int, error) {
+	var errs error
+	reportedCount := 0
+
+	for _, reporter := range r.reporters {
+		count, err := reporter.ReportWithContext(ctx, registry, metricTags)
+		reportedCount += count
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return reportedCount, errs
+}
+
+// SetMessageClient sets the MessageClient of each of the reporters which publish the metrics using a MessageClient
+func (r *multiReporter) SetMessageClient(client messaging.MessageClient) {
+	for _, reporter := range r.reporters {
+		if target, ok := reporter.(MessageClientSetter); ok {
+			target.SetMessageClient(client)
+		}
+	}
+}
+
+func (r *multiReporter) setEnabledOverrides(overrides *enabledOverrides) {
+	for _, reporter := range r.reporters {
+		if target, ok := reporter.(overridableReporter); ok {
+			target.setEnabledOverrides(overrides)
+		}
+	}
+}
+
+func (r *multiReporter) setServiceTags(tags *serviceTags) {
+	for _, reporter := range r.reporters {
+		if target, ok := reporter.(taggableReporter); ok {
+			target.setServiceTags(tags)
+		}
+	}
+}
+
+func (r *multiReporter) setStartTimes(startTimes *startTimes) {
+	for _, reporter := range r.reporters {
+		if target, ok := reporter.(startTimeReporter); ok {
+			target.setStartTimes(startTimes)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/hashicorp/go-multierror"
+	"github.com/labstack/echo/v4"
+	gometrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+func TestMultiReporter_Report(t *testing.T) {
+	reg := gometrics.NewRegistry()
+	require.NoError(t, reg.Register("MyCounter", gometrics.NewCounter()))
+	tags := map[string]map[string]string{"MyCounter": {"tag": "value"}}
+
+	var failures error
+	failures = multierror.Append(failures, errors.New("publish failed"), errors.New("marshal failed"))
+
+	succeeding := &mocks.MetricsReporter{}
+	succeeding.On("ReportWithContext", mock.Anything, reg, tags).Return(1, nil)
+	failing := &mocks.MetricsReporter{}
+	failing.On("ReportWithContext", mock.Anything, reg, tags).Return(0, failures)
+	alsoSucceeding := &mocks.MetricsReporter{}
+	alsoSucceeding.On("ReportWithContext", mock.Anything, reg, tags).Return(1, nil)
+
+	target := NewMultiReporter(succeeding, failing, alsoSucceeding)
+
+	count, err := target.ReportWithCount(reg, tags)
+	require.Error(t, err)
+	assert.Equal(t, 2, count)
+
+	// The failing reporter doesn't prevent the reporters after it from reporting
+	succeeding.AssertExpectations(t)
+	failing.AssertExpectations(t)
+	alsoSucceeding.AssertExpectations(t)
+
+	var errs *multierror.Error
+	require.True(t, errors.As(err, &errs))
+	assert.Len(t, errs.Errors, 2)
+	assert.EqualError(t, errs.Errors[0], "publish failed")
+	assert.EqualError(t, errs.Errors[1], "marshal failed")
+
+	assert.Error(t, target.Report(reg, tags))
+}
+
+func TestMultiReporter_ReportWithContext(t *testing.T) {
+	reg := gometrics.NewRegistry()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	first := &mocks.MetricsReporter{}
+	first.On("ReportWithContext", ctx, reg, mock.Anything).Return(0, nil)
+	second := &mocks.MetricsReporter{}
+	second.On("ReportWithContext", ctx, reg, mock.Anything).Return(0, nil)
+
+	count, err := NewMultiReporter(first, second).ReportWithContext(ctx, reg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	first.AssertExpectations(t)
+	second.AssertExpectations(t)
+}
+
+func TestMultiReporter_EnabledOverrides(t *testing.T) {
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{},
+	}
+
+	prometheus := NewPrometheusReporter(logger.NewMockClient(), "test-service", telemetryConfig, echo.New())
+	m := NewManager(logger.NewMockClient(), time.Second, NewMultiReporter(prometheus, NewNullReporter()))
+	target := m.(*manager)
+	require.NoError(t, target.Register("MyCounter", gometrics.NewCounter(), nil))
+
+	// The overrides set on the Metrics Manager are passed through to the reporters
+	count, err := prometheus.ReportWithCount(target.registry, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	target.SetMetricEnabled("MyCounter", true)
+	count, err = prometheus.ReportWithCount(target.registry, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
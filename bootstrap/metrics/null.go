@@ -0,0 +1,55 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"context"
+
+	gometrics "github.com/rcrowley/go-metrics"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+)
+
+type nullReporter struct{}
+
+// NewNullReporter creates a new reporter which discards the metrics. It is used when telemetry is disabled and by unit
+// tests which need a MetricsReporter without wiring up the MessageBus.
+func NewNullReporter() interfaces.MetricsReporter {
+	return &nullReporter{}
+}
+
+// WithEnabledReporter sets the reporter which replaces the Null reporter the Metrics Manager is created with when
+// telemetry is disabled, once telemetry is enabled at runtime by resetting the interval to a value other than
+// math.MaxInt64.
+func WithEnabledReporter(reporter interfaces.MetricsReporter) ManagerOption {
+	return func(m *manager) {
+		m.enabledReporter = reporter
+	}
+}
+
+// Report does nothing and always returns nil
+func (r *nullReporter) Report(_ gometrics.Registry, _ map[string]map[string]string) error {
+	return nil
+}
+
+// ReportWithCount does nothing and always returns a count of zero
+func (r *nullReporter) ReportWithCount(_ gometrics.Registry, _ map[string]map[string]string) (int, error) {
+	return 0, nil
+}
+
+// ReportWithContext does nothing and always returns a count of zero
+func (r *nullReporter) ReportWithContext(_ context.Context, _ gometrics.Registry, _ map[string]map[string]string) (int, error) {
+	return 0, nil
+}
@@ -0,0 +1,43 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"testing"
+
+	gometrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+)
+
+func TestNullReporter_Report(t *testing.T) {
+	var target interfaces.MetricsReporter = NewNullReporter()
+
+	reg := gometrics.NewRegistry()
+	counter := gometrics.NewCounter()
+	counter.Inc(5)
+	require.NoError(t, reg.Register("MyCounter", counter))
+
+	// Unsupported types are ignored rather than reported as errors since nothing is reported
+	require.NoError(t, reg.Register("MyEWMA", gometrics.NewEWMA1()))
+
+	assert.NoError(t, target.Report(reg, nil))
+
+	count, err := target.ReportWithCount(reg, map[string]map[string]string{"MyCounter": {"tag": "value"}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,322 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/hashicorp/go-multierror"
+	gometrics "github.com/rcrowley/go-metrics"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+const (
+	// otlpServiceNameKey is the OpenTelemetry semantic convention resource attribute for the service name
+	otlpServiceNameKey = "service.name"
+	otlpScopeName      = "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/metrics"
+	otlpExportTimeout  = time.Second * 10
+)
+
+type otlpReporter struct {
+	lc          logger.LoggingClient
+	serviceName string
+	endpoint    string
+	config      *config.TelemetryInfo
+	dialOptions []grpc.DialOption
+	client      colmetricspb.MetricsServiceClient
+	clientMutex sync.Mutex
+	startTime   time.Time
+	overrides   *enabledOverrides
+	serviceTags *serviceTags
+	startTimes  *startTimes
+}
+
+// OTLPReporterOption is a function which sets an optional behavior of the OTLP reporter
+type OTLPReporterOption func(*otlpReporter)
+
+// WithOTLPDialOptions sets the gRPC dial options used to connect to the OpenTelemetry collector, i.e. the TLS transport
+// credentials. By default, the connection to the collector is insecure.
+func WithOTLPDialOptions(options ...grpc.DialOption) OTLPReporterOption {
+	return func(reporter *otlpReporter) {
+		reporter.dialOptions = options
+	}
+}
+
+// NewOTLPReporter creates a new OpenTelemetry reporter which exports the metrics to an OpenTelemetry collector using
+// the OTLP/gRPC MetricsService. The endpoint is the collector's gRPC target, i.e. localhost:4317
+func NewOTLPReporter(lc logger.LoggingClient, serviceName string, endpoint string, config *config.TelemetryInfo, options ...OTLPReporterOption) interfaces.MetricsReporter {
+	reporter := &otlpReporter{
+		lc:          lc,
+		serviceName: serviceName,
+		endpoint:    endpoint,
+		config:      config,
+		dialOptions: []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
+		startTime:   time.Now(),
+	}
+
+	for _, option := range options {
+		option(reporter)
+	}
+
+	return reporter
+}
+
+// Report converts all the current metrics to OTLP data points and exports them to the OpenTelemetry collector.
+// Counters are exported as cumulative monotonic sums, gauges as gauges and timers and histograms as summaries.
+func (r *otlpReporter) Report(registry gometrics.Registry, metricTags map[string]map[string]string) error {
+	_, err := r.ReportWithCount(registry, metricTags)
+	return err
+}
+
+// ReportWithCount converts all the current metrics to OTLP data points, exports them to the OpenTelemetry collector
+// and returns the number of metrics exported
+func (r *otlpReporter) ReportWithCount(registry gometrics.Registry, metricTags map[string]map[string]string) (int, error) {
+	return r.ReportWithContext(context.Background(), registry, metricTags)
+}
+
+// ReportWithContext is the same as ReportWithCount, but the export request to the collector is aborted when the
+// context is cancelled
+func (r *otlpReporter) ReportWithContext(ctx context.Context, registry gometrics.Registry, metricTags map[string]map[string]string) (int, error) {
+	var errs error
+	var metrics []*metricspb.Metric
+	selfCount := 0
+
+	now := uint64(time.Now().UnixNano())
+	quantiles := percentileRatios(r.config.GetHistogramPercentiles())
+
+	registry.Each(func(itemName string, item interface{}) {
+		name, isEnabled := r.overrides.getEnabledMetricName(itemName, r.config)
+		if !isEnabled {
+			// This metric is not enable so do not report it.
+			return
+		}
+
+		attributes := buildOTLPAttributes(metricTags[itemName])
+		start := r.metricStartTime(itemName)
+		nextMetric := &metricspb.Metric{Name: name}
+
+		switch metric := item.(type) {
+		case gometrics.Counter:
+			nextMetric.Data = newOTLPSum(attributes, start, now, metric.Snapshot().Count())
+
+		case gometrics.Gauge:
+			nextMetric.Data = newOTLPGauge(&metricspb.NumberDataPoint{
+				Attributes:   attributes,
+				TimeUnixNano: now,
+				Value:        &metricspb.NumberDataPoint_AsInt{AsInt: metric.Snapshot().Value()},
+			})
+
+		case gometrics.GaugeFloat64:
+			nextMetric.Data = newOTLPGauge(&metricspb.NumberDataPoint{
+				Attributes:   attributes,
+				TimeUnixNano: now,
+				Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: metric.Snapshot().Value()},
+			})
+
+		case gometrics.Timer:
+			snapshot := metric.Snapshot()
+			nextMetric.Data = newOTLPSummary(attributes, start, now, quantiles, snapshot.Percentiles(quantiles), snapshot.Sum(), snapshot.Count())
+
+		case gometrics.Histogram:
+			snapshot := metric.Snapshot()
+			nextMetric.Data = newOTLPSummary(attributes, start, now, quantiles, snapshot.Percentiles(quantiles), snapshot.Sum(), snapshot.Count())
+
+		case gometrics.Meter:
+			nextMetric.Data = newOTLPSum(attributes, start, now, metric.Snapshot().Count())
+
+		default:
+			errs = multierror.Append(errs, fmt.Errorf("metric type %T not supported", metric))
+			return
+		}
+
+		metrics = append(metrics, nextMetric)
+		if isSelfMetric(itemName) {
+			selfCount++
+		}
+	})
+
+	if len(metrics) == 0 {
+		return 0, errs
+	}
+
+	request := &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource: &resourcepb.Resource{Attributes: r.buildResourceAttributes()},
+				ScopeMetrics: []*metricspb.ScopeMetrics{
+					{
+						Scope:   &commonpb.InstrumentationScope{Name: otlpScopeName},
+						Metrics: metrics,
+					},
+				},
+			},
+		},
+	}
+
+	if err := r.export(ctx, request); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("failed to export %d metrics to '%s': %s", len(metrics), r.endpoint, err.Error()))
+		return 0, errs
+	}
+
+	r.lc.Debugf("Exported %d metrics to the '%s' OTLP endpoint", len(metrics), r.endpoint)
+
+	return len(metrics) - selfCount, errs
+}
+
+func (r *otlpReporter) setEnabledOverrides(overrides *enabledOverrides) {
+	r.overrides = overrides
+}
+
+func (r *otlpReporter) setServiceTags(tags *serviceTags) {
+	r.serviceTags = tags
+}
+
+func (r *otlpReporter) setStartTimes(startTimes *startTimes) {
+	r.startTimes = startTimes
+}
+
+// metricStartTime returns the start time of the metric's cumulative values, which is when it was registered with the
+// Metrics Manager, or when the reporter was created for the metrics registered elsewhere
+func (r *otlpReporter) metricStartTime(itemName string) uint64 {
+	startTime, found := r.startTimes.get(itemName)
+	if !found {
+		startTime = r.startTime
+	}
+
+	return uint64(startTime.UnixNano())
+}
+
+// getClient returns the MetricsService client, connecting to the collector the first time it is called. The
+// connection isn't blocking, so a collector that isn't yet available fails the export rather than the connection.
+func (r *otlpReporter) getClient() (colmetricspb.MetricsServiceClient, error) {
+	r.clientMutex.Lock()
+	defer r.clientMutex.Unlock()
+
+	if r.client != nil {
+		return r.client, nil
+	}
+
+	conn, err := grpc.Dial(r.endpoint, r.dialOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.client = colmetricspb.NewMetricsServiceClient(conn)
+	return r.client, nil
+}
+
+func (r *otlpReporter) export(ctx context.Context, request *colmetricspb.ExportMetricsServiceRequest) error {
+	client, err := r.getClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to the collector: %s", err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, otlpExportTimeout)
+	defer cancel()
+
+	response, err := client.Export(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if partialSuccess := response.GetPartialSuccess(); partialSuccess.GetRejectedDataPoints() > 0 {
+		return fmt.Errorf("collector rejected %d data points: %s", partialSuccess.GetRejectedDataPoints(), partialSuccess.GetErrorMessage())
+	}
+
+	return nil
+}
+
+// buildResourceAttributes builds the resource attributes from the service level tags and the service name
+func (r *otlpReporter) buildResourceAttributes() []*commonpb.KeyValue {
+	// Build the service tags each time we report since that can be changed in the Writable config
+	attributes := buildOTLPAttributes(r.serviceTags.get(r.config))
+	attributes = append(attributes, newOTLPKeyValue(otlpServiceNameKey, r.serviceName))
+
+	return attributes
+}
+
+func buildOTLPAttributes(tags map[string]string) []*commonpb.KeyValue {
+	var attributes []*commonpb.KeyValue
+
+	for tagName, tagValue := range tags {
+		attributes = append(attributes, newOTLPKeyValue(tagName, tagValue))
+	}
+
+	return attributes
+}
+
+func newOTLPKeyValue(key string, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func newOTLPSum(attributes []*commonpb.KeyValue, start uint64, now uint64, count int64) *metricspb.Metric_Sum {
+	return &metricspb.Metric_Sum{
+		Sum: &metricspb.Sum{
+			DataPoints: []*metricspb.NumberDataPoint{
+				{
+					Attributes:        attributes,
+					StartTimeUnixNano: start,
+					TimeUnixNano:      now,
+					Value:             &metricspb.NumberDataPoint_AsInt{AsInt: count},
+				},
+			},
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+		},
+	}
+}
+
+func newOTLPGauge(dataPoint *metricspb.NumberDataPoint) *metricspb.Metric_Gauge {
+	return &metricspb.Metric_Gauge{
+		Gauge: &metricspb.Gauge{DataPoints: []*metricspb.NumberDataPoint{dataPoint}},
+	}
+}
+
+func newOTLPSummary(attributes []*commonpb.KeyValue, start uint64, now uint64, quantiles []float64, values []float64, sum int64, count int64) *metricspb.Metric_Summary {
+	quantileValues := make([]*metricspb.SummaryDataPoint_ValueAtQuantile, len(quantiles))
+	for index, quantile := range quantiles {
+		quantileValues[index] = &metricspb.SummaryDataPoint_ValueAtQuantile{Quantile: quantile, Value: values[index]}
+	}
+
+	return &metricspb.Metric_Summary{
+		Summary: &metricspb.Summary{
+			DataPoints: []*metricspb.SummaryDataPoint{
+				{
+					Attributes:        attributes,
+					StartTimeUnixNano: start,
+					TimeUnixNano:      now,
+					Count:             uint64(count),
+					Sum:               float64(sum),
+					QuantileValues:    quantileValues,
+				},
+			},
+		},
+	}
+}
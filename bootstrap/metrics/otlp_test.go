@@ -0,0 +1,248 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	gometrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// mockOTLPReceiver is an in-process OTLP/gRPC MetricsService which records the export requests it receives
+type mockOTLPReceiver struct {
+	colmetricspb.UnimplementedMetricsServiceServer
+	mutex    sync.Mutex
+	received []*colmetricspb.ExportMetricsServiceRequest
+	err      error
+}
+
+func (m *mockOTLPReceiver) Export(_ context.Context, request *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	m.received = append(m.received, request)
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+func (m *mockOTLPReceiver) requests() []*colmetricspb.ExportMetricsServiceRequest {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.received
+}
+
+// startMockOTLPReceiver serves the mock receiver over an in-memory listener and returns the reporter option which
+// connects to it
+func startMockOTLPReceiver(t *testing.T, receiver *mockOTLPReceiver) OTLPReporterOption {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	colmetricspb.RegisterMetricsServiceServer(server, receiver)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return WithOTLPDialOptions(
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+}
+
+func TestOTLPReporter_Report(t *testing.T) {
+	receiver := &mockOTLPReceiver{}
+	dialOption := startMockOTLPReceiver(t, receiver)
+
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{
+			"MyCounter":       true,
+			"MyGauge":         true,
+			"MyTimer":         true,
+			"DisabledCounter": false,
+		},
+		Tags: map[string]string{"gateway": "my-gateway"},
+	}
+
+	reg := gometrics.NewRegistry()
+
+	counter := gometrics.NewCounter()
+	counter.Inc(5)
+	require.NoError(t, reg.Register("MyCounter", counter))
+
+	gauge := gometrics.NewGaugeFloat64()
+	gauge.Update(1.5)
+	require.NoError(t, reg.Register("MyGauge", gauge))
+
+	timer := gometrics.NewTimer()
+	defer timer.Stop()
+	timer.Update(time.Millisecond)
+	require.NoError(t, reg.Register("MyTimer", timer))
+
+	require.NoError(t, reg.Register("DisabledCounter", gometrics.NewCounter()))
+
+	target := NewOTLPReporter(logger.NewMockClient(), "test-service", "passthrough:///bufnet", telemetryConfig, dialOption)
+	metricTags := map[string]map[string]string{"MyCounter": {"pipeline": "my-pipeline"}}
+	err := target.Report(reg, metricTags)
+	require.NoError(t, err)
+
+	received := receiver.requests()
+	require.Len(t, received, 1)
+	require.Len(t, received[0].ResourceMetrics, 1)
+	resourceMetrics := received[0].ResourceMetrics[0]
+
+	assert.ElementsMatch(t, []string{"gateway=my-gateway", otlpServiceNameKey + "=test-service"},
+		otlpKeyValues(resourceMetrics.GetResource().GetAttributes()))
+
+	require.Len(t, resourceMetrics.ScopeMetrics, 1)
+	assert.Equal(t, otlpScopeName, resourceMetrics.ScopeMetrics[0].GetScope().GetName())
+	actual := make(map[string]*metricspb.Metric)
+	for _, metric := range resourceMetrics.ScopeMetrics[0].Metrics {
+		actual[metric.Name] = metric
+	}
+	require.Len(t, actual, 3)
+
+	counterSum := actual["MyCounter"].GetSum()
+	require.NotNil(t, counterSum)
+	require.Len(t, counterSum.DataPoints, 1)
+	counterPoint := counterSum.DataPoints[0]
+	assert.Equal(t, int64(5), counterPoint.GetAsInt())
+	assert.NotZero(t, counterPoint.StartTimeUnixNano)
+	assert.Equal(t, []string{"pipeline=my-pipeline"}, otlpKeyValues(counterPoint.Attributes))
+	assert.True(t, counterSum.IsMonotonic)
+	assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, counterSum.AggregationTemporality)
+
+	gaugeData := actual["MyGauge"].GetGauge()
+	require.NotNil(t, gaugeData)
+	require.Len(t, gaugeData.DataPoints, 1)
+	assert.Equal(t, 1.5, gaugeData.DataPoints[0].GetAsDouble())
+
+	timerSummary := actual["MyTimer"].GetSummary()
+	require.NotNil(t, timerSummary)
+	require.Len(t, timerSummary.DataPoints, 1)
+	timerPoint := timerSummary.DataPoints[0]
+	assert.Equal(t, uint64(1), timerPoint.Count)
+	assert.Equal(t, float64(time.Millisecond), timerPoint.Sum)
+	assert.Len(t, timerPoint.QuantileValues, len(config.DefaultHistogramPercentiles))
+}
+
+func TestOTLPReporter_Report_CollectorError(t *testing.T) {
+	receiver := &mockOTLPReceiver{err: status.Error(codes.Unavailable, "collector unavailable")}
+	dialOption := startMockOTLPReceiver(t, receiver)
+
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{"MyCounter": true},
+	}
+
+	reg := gometrics.NewRegistry()
+	require.NoError(t, reg.Register("MyCounter", gometrics.NewCounter()))
+
+	target := NewOTLPReporter(logger.NewMockClient(), "test-service", "passthrough:///bufnet", telemetryConfig, dialOption)
+	count, err := target.ReportWithCount(reg, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "collector unavailable")
+	assert.Zero(t, count)
+}
+
+func TestOTLPReporter_Report_StartTimes(t *testing.T) {
+	receiver := &mockOTLPReceiver{}
+	dialOption := startMockOTLPReceiver(t, receiver)
+
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{"FirstCounter": true, "SecondCounter": true},
+	}
+
+	reporter := NewOTLPReporter(logger.NewMockClient(), "test-service", "passthrough:///bufnet", telemetryConfig, dialOption)
+	m := NewManager(logger.NewMockClient(), time.Second, reporter)
+	target := m.(*manager)
+
+	before := time.Now()
+	first := gometrics.NewCounter()
+	require.NoError(t, m.Register("FirstCounter", first, nil))
+	after := time.Now()
+
+	// The start time is captured when the metric is registered
+	firstStart, found := m.StartTime("FirstCounter")
+	require.True(t, found)
+	assert.False(t, firstStart.Before(before))
+	assert.False(t, firstStart.After(after))
+
+	time.Sleep(time.Millisecond * 5)
+	require.NoError(t, m.Register("SecondCounter", gometrics.NewCounter(), nil))
+	secondStart, found := m.StartTime("SecondCounter")
+	require.True(t, found)
+	assert.True(t, secondStart.After(firstStart))
+
+	_, found = m.StartTime("Unregistered")
+	assert.False(t, found)
+
+	startTimes := func() map[string]uint64 {
+		received := receiver.requests()
+		actual := make(map[string]uint64)
+		for _, metric := range received[len(received)-1].ResourceMetrics[0].ScopeMetrics[0].Metrics {
+			actual[metric.Name] = metric.GetSum().DataPoints[0].StartTimeUnixNano
+		}
+		return actual
+	}
+
+	// The start times are stable across reports while the values accumulate
+	for report := 1; report <= 3; report++ {
+		first.Inc(1)
+		require.NoError(t, reporter.Report(target.registry, nil))
+
+		actual := startTimes()
+		assert.Equal(t, uint64(firstStart.UnixNano()), actual["FirstCounter"])
+		assert.Equal(t, uint64(secondStart.UnixNano()), actual["SecondCounter"])
+	}
+
+	// A metric registered again restarts from zero, so has a new start time
+	m.Unregister("FirstCounter")
+	_, found = m.StartTime("FirstCounter")
+	assert.False(t, found)
+	require.NoError(t, m.Register("FirstCounter", gometrics.NewCounter(), nil))
+	restarted, found := m.StartTime("FirstCounter")
+	require.True(t, found)
+	assert.True(t, restarted.After(firstStart))
+}
+
+// otlpKeyValues flattens the attributes to key=value strings for comparison
+func otlpKeyValues(attributes []*commonpb.KeyValue) []string {
+	var keyValues []string
+	for _, attribute := range attributes {
+		keyValues = append(keyValues, attribute.Key+"="+attribute.GetValue().GetStringValue())
+	}
+	return keyValues
+}
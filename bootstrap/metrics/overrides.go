@@ -0,0 +1,106 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// enabledOverrides holds the runtime overrides of which metrics are enabled, which take precedence over the
+// configured Metrics. It is safe for concurrent use since the overrides are set by the service, i.e. from an HTTP
+// handler, while the reporter consults them from the Metrics Manager's Run go routine.
+type enabledOverrides struct {
+	overrides map[string]bool
+	mutex     sync.RWMutex
+}
+
+// overridableReporter is implemented by the reporters which support the runtime enabled overrides
+type overridableReporter interface {
+	setEnabledOverrides(overrides *enabledOverrides)
+}
+
+func newEnabledOverrides() *enabledOverrides {
+	return &enabledOverrides{
+		overrides: make(map[string]bool),
+	}
+}
+
+func (o *enabledOverrides) set(name string, enabled bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	o.overrides[name] = enabled
+}
+
+func (o *enabledOverrides) clear(name string) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	delete(o.overrides, name)
+}
+
+// getEnabledMetricName returns the metric name to report and if the metric is enabled. The runtime overrides are
+// consulted before the configured Metrics. The override name is matched as a prefix of the metric item name in the
+// same way as the configured Metrics names are matched, with an exact match taking precedence over the longest
+// matching prefix.
+func (o *enabledOverrides) getEnabledMetricName(itemName string, telemetryConfig *config.TelemetryInfo) (string, bool) {
+	configName, configEnabled := telemetryConfig.GetEnabledMetricName(itemName)
+
+	// Reporters not created via a Metrics Manager have no overrides
+	if o == nil {
+		return configName, configEnabled
+	}
+
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	overrideName, found := o.match(itemName)
+	if !found {
+		return configName, configEnabled
+	}
+
+	if !o.overrides[overrideName] {
+		return "", false
+	}
+
+	// Metric is not in the configuration, so report it using the override name
+	if len(configName) == 0 {
+		return overrideName, true
+	}
+
+	return configName, true
+}
+
+// match returns the override name which applies to the metric item name, which is the exact name if overridden and
+// otherwise the longest overridden prefix of the name. The caller must hold the mutex.
+func (o *enabledOverrides) match(itemName string) (string, bool) {
+	if _, found := o.overrides[itemName]; found {
+		return itemName, true
+	}
+
+	result := ""
+	found := false
+	for overrideName := range o.overrides {
+		if len(overrideName) > len(result) && strings.HasPrefix(itemName, overrideName) {
+			result = overrideName
+			found = true
+		}
+	}
+
+	return result, found
+}
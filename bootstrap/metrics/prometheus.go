@@ -0,0 +1,276 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/hashicorp/go-multierror"
+	"github.com/labstack/echo/v4"
+	gometrics "github.com/rcrowley/go-metrics"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+const (
+	// PrometheusMetricsRoute is the route the Prometheus reporter serves the metrics on for scraping
+	PrometheusMetricsRoute = "/metrics"
+	prometheusContentType  = "text/plain; version=0.0.4; charset=utf-8"
+	prometheusCounterType  = "counter"
+	prometheusGaugeType    = "gauge"
+	prometheusSummaryType  = "summary"
+	prometheusQuantileKey  = "quantile"
+)
+
+type prometheusReporter struct {
+	lc          logger.LoggingClient
+	serviceName string
+	config      *config.TelemetryInfo
+	exposition  []byte
+	mutex       sync.RWMutex
+	overrides   *enabledOverrides
+	serviceTags *serviceTags
+}
+
+// prometheusFamily is a Prometheus metric family, i.e. all the samples with the same metric name
+type prometheusFamily struct {
+	metricType string
+	samples    []string
+}
+
+// NewPrometheusReporter creates a new Prometheus reporter which exposes the metrics in the Prometheus text exposition
+// format for scraping. The scrape handler is registered on the router used by the service's bootstrap HTTP server.
+func NewPrometheusReporter(lc logger.LoggingClient, serviceName string, config *config.TelemetryInfo, router *echo.Echo) interfaces.MetricsReporter {
+	reporter := &prometheusReporter{
+		lc:          lc,
+		serviceName: serviceName,
+		config:      config,
+	}
+
+	router.GET(PrometheusMetricsRoute, reporter.serveMetrics)
+
+	return reporter
+}
+
+// Report translates all the current metrics to the Prometheus exposition format, which is served on the next scrape.
+// Counters are exposed as Prometheus counters, gauges as gauges and timers and histograms as summaries.
+func (r *prometheusReporter) Report(registry gometrics.Registry, metricTags map[string]map[string]string) error {
+	_, err := r.ReportWithCount(registry, metricTags)
+	return err
+}
+
+// ReportWithCount translates all the current metrics to the Prometheus exposition format and returns the number
+// of metrics exposed
+func (r *prometheusReporter) ReportWithCount(registry gometrics.Registry, metricTags map[string]map[string]string) (int, error) {
+	return r.ReportWithContext(context.Background(), registry, metricTags)
+}
+
+// ReportWithContext is the same as ReportWithCount, but does not update the exposed metrics when the context has
+// already been cancelled
+func (r *prometheusReporter) ReportWithContext(ctx context.Context, registry gometrics.Registry, metricTags map[string]map[string]string) (int, error) {
+	if ctx.Err() != nil {
+		return 0, fmt.Errorf("report cancelled before exposing metrics: %s", ctx.Err().Error())
+	}
+
+	var errs error
+	exposedCount := 0
+	families := make(map[string]*prometheusFamily)
+	quantiles := percentileRatios(r.config.GetHistogramPercentiles())
+
+	registry.Each(func(itemName string, item interface{}) {
+		name, isEnabled := r.overrides.getEnabledMetricName(itemName, r.config)
+		if !isEnabled {
+			// This metric is not enable so do not report it.
+			return
+		}
+
+		familyName := prometheusMetricName(name)
+		labels := r.buildLabels(metricTags[itemName])
+
+		var metricType string
+		var samples []string
+
+		switch metric := item.(type) {
+		case gometrics.Counter:
+			metricType = prometheusCounterType
+			samples = []string{prometheusSample(familyName, labels, float64(metric.Snapshot().Count()))}
+
+		case gometrics.Gauge:
+			metricType = prometheusGaugeType
+			samples = []string{prometheusSample(familyName, labels, float64(metric.Snapshot().Value()))}
+
+		case gometrics.GaugeFloat64:
+			metricType = prometheusGaugeType
+			samples = []string{prometheusSample(familyName, labels, metric.Snapshot().Value())}
+
+		case gometrics.Timer:
+			snapshot := metric.Snapshot()
+			metricType = prometheusSummaryType
+			samples = prometheusSummarySamples(familyName, labels, quantiles, snapshot.Percentiles(quantiles), snapshot.Sum(), snapshot.Count())
+
+		case gometrics.Histogram:
+			snapshot := metric.Snapshot()
+			metricType = prometheusSummaryType
+			samples = prometheusSummarySamples(familyName, labels, quantiles, snapshot.Percentiles(quantiles), snapshot.Sum(), snapshot.Count())
+
+		case gometrics.Meter:
+			metricType = prometheusCounterType
+			samples = []string{prometheusSample(familyName, labels, float64(metric.Snapshot().Count()))}
+
+		default:
+			errs = multierror.Append(errs, fmt.Errorf("metric type %T not supported", metric))
+			return
+		}
+
+		family, exists := families[familyName]
+		if !exists {
+			family = &prometheusFamily{metricType: metricType}
+			families[familyName] = family
+		}
+
+		family.samples = append(family.samples, samples...)
+		if !isSelfMetric(itemName) {
+			exposedCount++
+		}
+	})
+
+	familyNames := make([]string, 0, len(families))
+	for familyName := range families {
+		familyNames = append(familyNames, familyName)
+	}
+	sort.Strings(familyNames)
+
+	var exposition bytes.Buffer
+	for _, familyName := range familyNames {
+		family := families[familyName]
+		exposition.WriteString(fmt.Sprintf("# TYPE %s %s\n", familyName, family.metricType))
+		for _, sample := range family.samples {
+			exposition.WriteString(sample + "\n")
+		}
+	}
+
+	r.mutex.Lock()
+	r.exposition = exposition.Bytes()
+	r.mutex.Unlock()
+
+	r.lc.Debugf("Exposed %d metrics for Prometheus scraping", exposedCount)
+
+	return exposedCount, errs
+}
+
+func (r *prometheusReporter) setEnabledOverrides(overrides *enabledOverrides) {
+	r.overrides = overrides
+}
+
+func (r *prometheusReporter) setServiceTags(tags *serviceTags) {
+	r.serviceTags = tags
+}
+
+func (r *prometheusReporter) serveMetrics(c echo.Context) error {
+	r.mutex.RLock()
+	exposition := r.exposition
+	r.mutex.RUnlock()
+
+	c.Response().Header().Set(common.ContentType, prometheusContentType)
+	c.Response().WriteHeader(http.StatusOK)
+	_, err := c.Response().Write(exposition)
+	return err
+}
+
+// buildLabels builds the sorted Prometheus labels from the service level tags, the metric's tags and the service name
+func (r *prometheusReporter) buildLabels(metricTags map[string]string) []string {
+	// Build the service tags each time we report since that can be changed in the Writable config
+	tags := make(map[string]string)
+	for name, value := range r.serviceTags.get(r.config) {
+		tags[name] = value
+	}
+	for name, value := range metricTags {
+		tags[name] = value
+	}
+	tags[serviceNameTagKey] = r.serviceName
+
+	labels := make([]string, 0, len(tags))
+	for name, value := range tags {
+		labels = append(labels, prometheusLabel(name, value))
+	}
+	sort.Strings(labels)
+
+	return labels
+}
+
+func prometheusSummarySamples(name string, labels []string, quantiles []float64, values []float64, sum int64, count int64) []string {
+	samples := make([]string, 0, len(quantiles)+2)
+	for index, quantile := range quantiles {
+		quantileLabels := append([]string{prometheusLabel(prometheusQuantileKey, strconv.FormatFloat(quantile, 'g', -1, 64))}, labels...)
+		samples = append(samples, prometheusSample(name, quantileLabels, values[index]))
+	}
+
+	samples = append(samples,
+		prometheusSample(name+"_sum", labels, float64(sum)),
+		prometheusSample(name+"_count", labels, float64(count)))
+
+	return samples
+}
+
+func prometheusSample(name string, labels []string, value float64) string {
+	if len(labels) == 0 {
+		return fmt.Sprintf("%s %s", name, strconv.FormatFloat(value, 'g', -1, 64))
+	}
+
+	return fmt.Sprintf("%s{%s} %s", name, strings.Join(labels, ","), strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+func prometheusLabel(name string, value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+	return fmt.Sprintf(`%s="%s"`, prometheusLabelName(name), escaped)
+}
+
+// prometheusMetricName replaces the characters not allowed in Prometheus metric names with underscores
+func prometheusMetricName(name string) string {
+	return sanitizePrometheusName(name, true)
+}
+
+// prometheusLabelName replaces the characters not allowed in Prometheus label names with underscores
+func prometheusLabelName(name string) string {
+	return sanitizePrometheusName(name, false)
+}
+
+func sanitizePrometheusName(name string, allowColon bool) string {
+	var builder strings.Builder
+	for index, char := range name {
+		switch {
+		case char == '_',
+			char >= 'a' && char <= 'z',
+			char >= 'A' && char <= 'Z',
+			char >= '0' && char <= '9' && index > 0,
+			char == ':' && allowColon:
+			builder.WriteRune(char)
+		default:
+			builder.WriteRune('_')
+		}
+	}
+
+	return builder.String()
+}
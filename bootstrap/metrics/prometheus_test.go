@@ -0,0 +1,124 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/labstack/echo/v4"
+	gometrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+func TestPrometheusReporter_Report(t *testing.T) {
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{
+			"my-counter":      true,
+			"MyGauge":         true,
+			"MyTimer":         true,
+			"DisabledCounter": false,
+		},
+		Tags:                 map[string]string{"gateway": "my-gateway"},
+		HistogramPercentiles: []float64{50, 99},
+	}
+
+	router := echo.New()
+	target := NewPrometheusReporter(logger.NewMockClient(), "test-service", telemetryConfig, router)
+
+	reg := gometrics.NewRegistry()
+
+	counter := gometrics.NewCounter()
+	counter.Inc(5)
+	require.NoError(t, reg.Register("my-counter", counter))
+
+	gauge := gometrics.NewGauge()
+	gauge.Update(42)
+	require.NoError(t, reg.Register("MyGauge", gauge))
+
+	timer := gometrics.NewTimer()
+	defer timer.Stop()
+	timer.Update(time.Millisecond)
+	require.NoError(t, reg.Register("MyTimer", timer))
+
+	disabledCounter := gometrics.NewCounter()
+	require.NoError(t, reg.Register("DisabledCounter", disabledCounter))
+
+	metricTags := map[string]map[string]string{"MyGauge": {"pipeline": "my-pipeline"}}
+	err := target.Report(reg, metricTags)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, PrometheusMetricsRoute, nil)
+	router.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, prometheusContentType, recorder.Header().Get(common.ContentType))
+
+	lines := strings.Split(strings.TrimSpace(recorder.Body.String()), "\n")
+	expectedLines := []string{
+		`# TYPE MyGauge gauge`,
+		`MyGauge{gateway="my-gateway",pipeline="my-pipeline",service="test-service"} 42`,
+		`# TYPE MyTimer summary`,
+		`MyTimer{quantile="0.5",gateway="my-gateway",service="test-service"} 1e+06`,
+		`MyTimer{quantile="0.99",gateway="my-gateway",service="test-service"} 1e+06`,
+		`MyTimer_sum{gateway="my-gateway",service="test-service"} 1e+06`,
+		`MyTimer_count{gateway="my-gateway",service="test-service"} 1`,
+		`# TYPE my_counter counter`,
+		`my_counter{gateway="my-gateway",service="test-service"} 5`,
+	}
+	assert.Equal(t, expectedLines, lines)
+}
+
+func TestPrometheusReporter_Report_Unsupported(t *testing.T) {
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{"MyHealthcheck": true},
+	}
+
+	target := NewPrometheusReporter(logger.NewMockClient(), "test-service", telemetryConfig, echo.New())
+
+	reg := gometrics.NewRegistry()
+	require.NoError(t, reg.Register("MyHealthcheck", gometrics.NewHealthcheck(func(gometrics.Healthcheck) {})))
+
+	err := target.Report(reg, nil)
+	require.Error(t, err)
+}
+
+func TestPrometheusMetricName(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Metric   string
+		Expected string
+	}{
+		{"Valid", "my_metric:total", "my_metric:total"},
+		{"Dashes", "my-metric", "my_metric"},
+		{"Dots and slashes", "my.metric/one", "my_metric_one"},
+		{"Leading digit", "1metric", "_metric"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert.Equal(t, test.Expected, prometheusMetricName(test.Metric))
+		})
+	}
+}
@@ -15,13 +15,18 @@
 package metrics
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
-
-	"github.com/google/uuid"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/correlation"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -40,6 +45,7 @@ import (
 const (
 	serviceNameTagKey     = "service"
 	counterCountName      = "counter-count"
+	counterDeltaName      = "counter-delta"
 	gaugeValueName        = "gauge-value"
 	gaugeFloat64ValueName = "gaugeFloat64-value"
 	timerCountName        = "timer-count"
@@ -54,8 +60,24 @@ const (
 	histogramMaxName      = "histogram-max"
 	histogramStddevName   = "histogram-stddev"
 	histogramVarianceName = "histogram-variance"
+	meterCountName        = "meter-count"
+	meterRate1Name        = "meter-m1"
+	meterRate5Name        = "meter-m5"
+	meterRate15Name       = "meter-m15"
+	meterRateMeanName     = "meter-mean"
+	// histogramPercentilePrefix is combined with the percentile, i.e. histogram-p95
+	histogramPercentilePrefix = "histogram-p"
+	// ServiceTopicPlaceholder is always expanded to the service name in the PublishTopicPrefix
+	ServiceTopicPlaceholder = "service"
 )
 
+// defaultRetryMaxElapsed bounds the time spent retrying failed publishes in a single report when the RetryPolicy
+// doesn't specify MaxElapsed
+const defaultRetryMaxElapsed = time.Second * 10
+
+// topicPlaceholderRegex matches the {name} placeholders in the PublishTopicPrefix
+var topicPlaceholderRegex = regexp.MustCompile(`\{([^{}/]*)\}`)
+
 type messageBusReporter struct {
 	lc               logger.LoggingClient
 	serviceName      string
@@ -63,16 +85,137 @@ type messageBusReporter struct {
 	messageClient    messaging.MessageClient
 	config           *config.TelemetryInfo
 	baseMetricsTopic string
+	sanitizer        func(string) string
+	overrides        *enabledOverrides
+	serviceTags      *serviceTags
+	clientMutex      sync.Mutex
+	substitutions    map[string]string
+	previousCounts   map[string]int64
+	countsMutex      sync.Mutex
+	retryPolicy      RetryPolicy
+	encoder          Encoder
+	reportCorrelated bool
+	sortMetrics      bool
+	transform        func(dtos.Metric) (dtos.Metric, error)
+	failureThreshold int
+	onFailure        func(name string, failures int, err error)
+	failureCounts    map[string]int
+	failuresMutex    sync.Mutex
+	capMutex         sync.Mutex
+	capCursor        string
+	capping          bool
+}
+
+// RetryPolicy is the policy for retrying failed publishes of the metrics
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts to publish each message, including the first attempt
+	MaxAttempts int
+	// BaseBackoff is the time to wait before the first retry, which is doubled for each subsequent retry
+	BaseBackoff time.Duration
+	// MaxElapsed bounds the time spent retrying in a single report, after which failed publishes are no longer
+	// retried. This should be less than the reporting interval so a sustained outage doesn't stall reporting.
+	MaxElapsed time.Duration
+}
+
+// MessageClientSetter is implemented by the reporters which publish the metrics using a MessageClient, allowing the
+// client to be injected after the reporter has been constructed
+type MessageClientSetter interface {
+	SetMessageClient(client messaging.MessageClient)
+}
+
+// ReporterOption is a functional option for configuring the MessageBus reporter
+type ReporterOption func(*messageBusReporter)
+
+// WithSanitizer sets the function applied to metric names and tag names before the metrics are published.
+// This allows the names to be transformed to those accepted by downstream systems, i.e. Prometheus or InfluxDB.
+// By default, the names are published unchanged.
+func WithSanitizer(sanitizer func(string) string) ReporterOption {
+	return func(reporter *messageBusReporter) {
+		reporter.sanitizer = sanitizer
+	}
+}
+
+// WithReportCorrelationID sets the reporter to use a single CorrelationID for all the metric messages published by
+// each report, so that the metrics reported in the same interval can be correlated. By default, each published
+// message has its own CorrelationID.
+func WithReportCorrelationID() ReporterOption {
+	return func(reporter *messageBusReporter) {
+		reporter.reportCorrelated = true
+	}
+}
+
+// WithSortedMetrics sets the reporter to publish the metrics in name order, so the order of the publishes and of the
+// metrics in a batch is the same in every report. By default, the metrics are published in the registry's iteration
+// order, which varies between reports, to avoid the cost of sorting.
+func WithSortedMetrics() ReporterOption {
+	return func(reporter *messageBusReporter) {
+		reporter.sortMetrics = true
+	}
+}
+
+// WithTransform sets the function applied to each metric before it is published, after the tags have been merged,
+// allowing the metrics to be enriched, i.e. with a tenant tag, or fields to be stripped. Metrics which fail to be
+// transformed are not published, with the error reported in the returned error.
+func WithTransform(transform func(dtos.Metric) (dtos.Metric, error)) ReporterOption {
+	return func(reporter *messageBusReporter) {
+		reporter.transform = transform
+	}
+}
+
+// WithFailureCallback sets the function called when a metric has failed to be reported in threshold consecutive
+// reports, i.e. because its payload is too large for the broker, so the service can raise an alert or disable the
+// metric with SetMetricEnabled. The callback is passed the registered name of the metric, the number of consecutive
+// failures and the latest error. It is called once each time the threshold is reached, as the count of consecutive
+// failures is reset when the metric is next reported successfully.
+func WithFailureCallback(threshold int, callback func(name string, failures int, err error)) ReporterOption {
+	return func(reporter *messageBusReporter) {
+		reporter.failureThreshold = threshold
+		reporter.onFailure = callback
+	}
+}
+
+// WithEncoder sets the Encoder used to encode the published metrics, which also determines the ContentType of the
+// published MessageEnvelope. By default, the metrics are encoded as JSON.
+func WithEncoder(encoder Encoder) ReporterOption {
+	return func(reporter *messageBusReporter) {
+		reporter.encoder = encoder
+	}
+}
+
+// WithRetryPolicy sets the policy for retrying failed publishes before they are reported as errors.
+// By default, failed publishes are not retried.
+func WithRetryPolicy(policy RetryPolicy) ReporterOption {
+	return func(reporter *messageBusReporter) {
+		if policy.MaxElapsed <= 0 {
+			policy.MaxElapsed = defaultRetryMaxElapsed
+		}
+		reporter.retryPolicy = policy
+	}
+}
+
+// WithTopicSubstitutions sets the values the {name} placeholders in the configured PublishTopicPrefix are expanded to,
+// i.e. {"env": "production"}. The {service} placeholder is always expanded to the service name.
+func WithTopicSubstitutions(substitutions map[string]string) ReporterOption {
+	return func(reporter *messageBusReporter) {
+		reporter.substitutions = substitutions
+	}
 }
 
 // NewMessageBusReporter creates a new MessageBus reporter which reports metrics to the EdgeX MessageBus
-func NewMessageBusReporter(lc logger.LoggingClient, baseTopic string, serviceName string, dic *di.Container, config *config.TelemetryInfo) interfaces.MetricsReporter {
+func NewMessageBusReporter(lc logger.LoggingClient, baseTopic string, serviceName string, dic *di.Container, config *config.TelemetryInfo, options ...ReporterOption) interfaces.MetricsReporter {
 	reporter := &messageBusReporter{
 		lc:               lc,
 		serviceName:      serviceName,
 		dic:              dic,
 		config:           config,
 		baseMetricsTopic: common.BuildTopic(baseTopic, common.MetricsPublishTopic, serviceName),
+		previousCounts:   make(map[string]int64),
+		failureCounts:    make(map[string]int),
+		encoder:          NewJSONEncoder(),
+	}
+
+	for _, option := range options {
+		option(reporter)
 	}
 
 	return reporter
@@ -81,131 +224,538 @@ func NewMessageBusReporter(lc logger.LoggingClient, baseTopic string, serviceNam
 // Report collects all the current metrics and reports them to the EdgeX MessageBus
 // The approach here was adapted from https://github.com/vrischmann/go-metrics-influxdb
 func (r *messageBusReporter) Report(registry gometrics.Registry, metricTags map[string]map[string]string) error {
+	_, err := r.ReportWithCount(registry, metricTags)
+	return err
+}
+
+// ReportWithCount collects all the current metrics, reports them to the EdgeX MessageBus and returns the number
+// of metrics successfully published
+func (r *messageBusReporter) ReportWithCount(registry gometrics.Registry, metricTags map[string]map[string]string) (int, error) {
+	return r.ReportWithContext(context.Background(), registry, metricTags)
+}
+
+// ReportWithContext collects all the current metrics, reports them to the EdgeX MessageBus and returns the number
+// of metrics successfully published. The context is checked between publishes so that cancelling it, i.e. on
+// shutdown, stops the report with the remaining metrics skipped and reported in the returned error.
+func (r *messageBusReporter) ReportWithContext(ctx context.Context, registry gometrics.Registry, metricTags map[string]map[string]string) (int, error) {
 	var errs error
 	publishedCount := 0
 
-	// App Services create the messaging client after bootstrapping, so must get it from DIC when the first time
-	if r.messageClient == nil {
-		r.messageClient = container.MessagingClientFrom(r.dic.Get)
-	}
-
 	// If messaging client nil, then service hasn't set it up and can not report metrics this pass.
 	// This may happen during bootstrapping if interval time is lower than time to bootstrap,
-	// but will be resolved one messaging client has been added to the DIC.
-	if r.messageClient == nil {
-		return errors.New("messaging client not available. Unable to report metrics")
+	// but will be resolved one messaging client has been added to the DIC or set on the reporter.
+	messageClient := r.getMessageClient()
+	if messageClient == nil {
+		errs = multierror.Append(errs, errors.New("messaging client not available. Unable to report metrics"))
+		return 0, errs
 	}
 
+	// Expand the topic each time we report since the PublishTopicPrefix can be changed in the Writable config
+	baseMetricsTopic, err := r.metricsTopic()
+	if err != nil {
+		errs = multierror.Append(errs, err)
+		return 0, errs
+	}
+
+	// The cap is checked each time we report since the MaxMetricsPerReport can be changed in the Writable config
+	registry = r.capRegistry(registry)
+
 	// Build the service tags each time we report since that can be changed in the Writable config
-	serviceTags := buildMetricTags(r.config.Tags)
+	serviceTags := r.buildMetricTags(r.serviceTags.get(r.config))
 	serviceTags = append(serviceTags, dtos.MetricTag{
-		Name:  serviceNameTagKey,
+		Name:  r.sanitize(serviceNameTagKey),
 		Value: r.serviceName,
 	})
 
-	registry.Each(func(itemName string, item interface{}) {
-		var nextMetric dtos.Metric
-		var err error
-
+	var metrics []dtos.Metric
+	var itemNames []string
+	r.eachMetric(registry, func(itemName string, item interface{}) {
 		// If itemName matches a configured Metric name, use the configured Metric name in case it is a partial match.
 		// The metric item will have the extra name portion as a tag.
 		// This is important for Metrics for App Service Pipelines, when the Metric name reported need to be the same
 		// for all pipelines, but each will have to have unique name (with pipeline ID added) registered.
 		// The Pipeline id will also be added as a tag.
-		name, isEnabled := r.config.GetEnabledMetricName(itemName)
+		name, isEnabled := r.overrides.getEnabledMetricName(itemName, r.config)
 		if !isEnabled {
 			// This metric is not enable so do not report it.
 			return
 		}
 
-		tags := append(serviceTags, buildMetricTags(metricTags[itemName])...)
-
-		switch metric := item.(type) {
-		case gometrics.Counter:
-			snapshot := metric.Snapshot()
-			fields := []dtos.MetricField{{Name: counterCountName, Value: snapshot.Count()}}
-			nextMetric, err = dtos.NewMetric(name, fields, tags)
-
-		case gometrics.Gauge:
-			snapshot := metric.Snapshot()
-			fields := []dtos.MetricField{{Name: gaugeValueName, Value: snapshot.Value()}}
-			nextMetric, err = dtos.NewMetric(name, fields, tags)
-
-		case gometrics.GaugeFloat64:
-			snapshot := metric.Snapshot()
-			fields := []dtos.MetricField{{Name: gaugeFloat64ValueName, Value: snapshot.Value()}}
-			nextMetric, err = dtos.NewMetric(name, fields, tags)
-
-		case gometrics.Timer:
-			snapshot := metric.Snapshot()
-			fields := []dtos.MetricField{
-				{Name: timerCountName, Value: snapshot.Count()},
-				{Name: timerMinName, Value: snapshot.Min()},
-				{Name: timerMaxName, Value: snapshot.Max()},
-				{Name: timerMeanName, Value: snapshot.Mean()},
-				{Name: timerStddevName, Value: snapshot.StdDev()},
-				{Name: timerVarianceName, Value: snapshot.Variance()},
-			}
-			nextMetric, err = dtos.NewMetric(name, fields, tags)
-
-		case gometrics.Histogram:
-			snapshot := metric.Snapshot()
-			fields := []dtos.MetricField{
-				{Name: histogramCountName, Value: snapshot.Count()},
-				{Name: histogramMinName, Value: snapshot.Min()},
-				{Name: histogramMaxName, Value: snapshot.Max()},
-				{Name: histogramMeanName, Value: snapshot.Mean()},
-				{Name: histogramStddevName, Value: snapshot.StdDev()},
-				{Name: histogramVarianceName, Value: snapshot.Variance()},
-			}
-			nextMetric, err = dtos.NewMetric(name, fields, tags)
+		name = r.sanitize(name)
+		tags := mergeMetricTags(serviceTags, r.buildMetricTags(metricTags[itemName]))
 
-		default:
-			errs = multierror.Append(errs, fmt.Errorf("metric type %T not supported", metric))
+		fields, err := buildMetricFields(item, r.config.GetHistogramPercentiles())
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			r.recordFailure(itemName, err)
 			return
 		}
 
+		if counter, ok := item.(gometrics.Counter); ok && r.config.CounterDeltas {
+			fields = append(fields, dtos.MetricField{Name: counterDeltaName, Value: r.counterDelta(itemName, counter.Snapshot().Count())})
+		}
+
+		nextMetric, err := dtos.NewMetric(name, fields, tags)
 		if err != nil {
 			err = fmt.Errorf("unable to create metric for '%s': %s", name, err.Error())
 			errs = multierror.Append(errs, err)
+			r.recordFailure(itemName, err)
 			return
 		}
 
-		payload, err := json.Marshal(nextMetric)
-		if err != nil {
-			errs = multierror.Append(errs, fmt.Errorf("failed to marshal metric '%s' to JSON: %s", nextMetric.Name, err.Error()))
-			return
+		if r.transform != nil {
+			nextMetric, err = r.transform(nextMetric)
+			if err != nil {
+				err = fmt.Errorf("unable to transform metric '%s': %s", name, err.Error())
+				errs = multierror.Append(errs, err)
+				r.recordFailure(itemName, err)
+				return
+			}
+		}
+
+		metrics = append(metrics, nextMetric)
+		itemNames = append(itemNames, itemName)
+	})
+
+	// The metrics were built in the order of the registered names, so metrics reported under the same configured
+	// name remain in a stable order
+	if r.sortMetrics {
+		metrics, itemNames = sortMetricsByName(metrics, itemNames)
+	}
+
+	retryCtx, cancel := context.WithTimeout(ctx, r.retryPolicy.MaxElapsed)
+	defer cancel()
+
+	// An empty CorrelationID results in a new one for each published message
+	correlationID := ""
+	if r.reportCorrelated {
+		correlationID = correlation.NewID()
+	}
+
+	if r.config.BatchPublish {
+		if len(metrics) > 0 && ctx.Err() != nil {
+			errs = multierror.Append(errs, fmt.Errorf("report cancelled before publishing batch of %d metrics: %s", len(metrics), ctx.Err().Error()))
+		} else if len(metrics) > 0 {
+			if err := r.publish(retryCtx, messageClient, metrics, baseMetricsTopic, correlationID); err != nil {
+				err = fmt.Errorf("failed to publish batch of %d metrics to topic '%s': %s", len(metrics), baseMetricsTopic, err.Error())
+				errs = multierror.Append(errs, err)
+				for _, itemName := range itemNames {
+					r.recordFailure(itemName, err)
+				}
+			} else {
+				for _, itemName := range itemNames {
+					if !isSelfMetric(itemName) {
+						publishedCount++
+					}
+					r.recordSuccess(itemName)
+				}
+			}
 		}
+	} else {
+		for index, metric := range metrics {
+			if ctx.Err() != nil {
+				errs = multierror.Append(errs, fmt.Errorf("report cancelled after publishing %d metrics, skipped remaining %d metrics: %s", publishedCount, len(metrics)-index, ctx.Err().Error()))
+				break
+			}
+
+			topic := common.BuildTopic(baseMetricsTopic, metric.Name)
+			if err := r.publish(retryCtx, messageClient, metric, topic, correlationID); err != nil {
+				err = fmt.Errorf("failed to publish metric '%s' to topic '%s': %s", metric.Name, topic, err.Error())
+				errs = multierror.Append(errs, err)
+				r.recordFailure(itemNames[index], err)
+				continue
+			}
 
-		message := types.MessageEnvelope{
-			CorrelationID: uuid.NewString(),
-			Payload:       payload,
-			ContentType:   common.ContentTypeJSON,
+			if !isSelfMetric(itemNames[index]) {
+				publishedCount++
+			}
+			r.recordSuccess(itemNames[index])
 		}
+	}
+
+	r.lc.Debugf("Publish %d metrics to the '%s' base topic", publishedCount, baseMetricsTopic)
+
+	return publishedCount, errs
+}
+
+// recordFailure counts the consecutive failures to report the metric and calls the failure callback when the count
+// reaches the threshold
+func (r *messageBusReporter) recordFailure(itemName string, err error) {
+	if r.onFailure == nil {
+		return
+	}
+
+	r.failuresMutex.Lock()
+	r.failureCounts[itemName]++
+	failures := r.failureCounts[itemName]
+	r.failuresMutex.Unlock()
+
+	if failures == r.failureThreshold {
+		r.onFailure(itemName, failures, err)
+	}
+}
+
+// recordSuccess resets the count of consecutive failures to report the metric
+func (r *messageBusReporter) recordSuccess(itemName string) {
+	if r.onFailure == nil {
+		return
+	}
+
+	r.failuresMutex.Lock()
+	delete(r.failureCounts, itemName)
+	r.failuresMutex.Unlock()
+}
+
+// sortMetricsByName sorts the metrics by name, along with their registered names. The sort is stable so metrics
+// reported under the same name remain in the order of their registered names.
+func sortMetricsByName(metrics []dtos.Metric, itemNames []string) ([]dtos.Metric, []string) {
+	order := make([]int, len(metrics))
+	for index := range order {
+		order[index] = index
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return metrics[order[i]].Name < metrics[order[j]].Name
+	})
+
+	sortedMetrics := make([]dtos.Metric, len(metrics))
+	sortedNames := make([]string, len(itemNames))
+	for index, original := range order {
+		sortedMetrics[index] = metrics[original]
+		sortedNames[index] = itemNames[original]
+	}
+
+	return sortedMetrics, sortedNames
+}
+
+// eachMetric calls fn for each of the metrics in the registry, in the order of the registered names when the metrics
+// are sorted
+func (r *messageBusReporter) eachMetric(registry gometrics.Registry, fn func(itemName string, item interface{})) {
+	if !r.sortMetrics {
+		registry.Each(fn)
+		return
+	}
+
+	var names []string
+	items := make(map[string]interface{})
+	registry.Each(func(itemName string, item interface{}) {
+		names = append(names, itemName)
+		items[itemName] = item
+	})
+
+	sort.Strings(names)
+	for _, name := range names {
+		fn(name, items[name])
+	}
+}
+
+// capRegistry returns the registry of the enabled metrics to report when there are more than MaxMetricsPerReport,
+// otherwise the registry is returned unchanged. The metrics are taken in name order starting after the last metric
+// reported by the previous capped report, wrapping around, so all the metrics are reported over the following reports.
+// The reporter health metrics are always reported and don't count towards the cap.
+func (r *messageBusReporter) capRegistry(registry gometrics.Registry) gometrics.Registry {
+	maxMetrics := r.config.MaxMetricsPerReport
+	if maxMetrics <= 0 {
+		return registry
+	}
 
-		topic := common.BuildTopic(r.baseMetricsTopic, name)
-		if err := r.messageClient.Publish(message, topic); err != nil {
-			errs = multierror.Append(errs, fmt.Errorf("failed to publish metric '%s' to topic '%s': %s", name, topic, err.Error()))
+	var names []string
+	registry.Each(func(itemName string, _ interface{}) {
+		if isSelfMetric(itemName) {
 			return
-		} else {
-			publishedCount++
+		}
+
+		if _, isEnabled := r.overrides.getEnabledMetricName(itemName, r.config); isEnabled {
+			names = append(names, itemName)
+		}
+	})
+
+	r.capMutex.Lock()
+	defer r.capMutex.Unlock()
+
+	if len(names) <= maxMetrics {
+		if r.capping {
+			r.lc.Infof("Telemetry no longer capped, reporting all %d metrics", len(names))
+			r.capping = false
+		}
+		return registry
+	}
+
+	if !r.capping {
+		r.lc.Infof("Telemetry capped by MaxMetricsPerReport, reporting %d of the %d metrics in each report", maxMetrics, len(names))
+		r.capping = true
+	}
+
+	sort.Strings(names)
+	start := sort.Search(len(names), func(index int) bool {
+		return names[index] > r.capCursor
+	})
+
+	capped := gometrics.NewRegistry()
+	for count := 0; count < maxMetrics; count++ {
+		name := names[(start+count)%len(names)]
+		_ = capped.Register(name, registry.Get(name))
+		r.capCursor = name
+	}
+
+	registry.Each(func(itemName string, item interface{}) {
+		if isSelfMetric(itemName) {
+			_ = capped.Register(itemName, item)
 		}
 	})
 
-	r.lc.Debugf("Publish %d metrics to the '%s' base topic", publishedCount, r.baseMetricsTopic)
+	r.lc.Debugf("Telemetry capped, reporting %d of %d metrics up to '%s'", maxMetrics, len(names), r.capCursor)
+
+	return capped
+}
+
+// publish encodes the payload, which is a single metric or a batch of metrics, and publishes it to the topic.
+// A new CorrelationID is generated when the correlationID is empty. Failed publishes are retried according to the
+// RetryPolicy until the context's deadline or it is cancelled.
+func (r *messageBusReporter) publish(ctx context.Context, messageClient messaging.MessageClient, payload interface{}, topic string, correlationID string) error {
+	data, err := r.encoder.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to %s: %s", r.encoder.ContentType(), err.Error())
+	}
+
+	if len(correlationID) == 0 {
+		correlationID = correlation.NewID()
+	}
+
+	message := types.MessageEnvelope{
+		CorrelationID: correlationID,
+		Payload:       data,
+		ContentType:   r.encoder.ContentType(),
+	}
+
+	backoff := r.retryPolicy.BaseBackoff
+	for attempt := 1; ; attempt++ {
+		err = messageClient.Publish(message, topic)
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= r.retryPolicy.MaxAttempts {
+			return err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(backoff).After(deadline) {
+			return err
+		}
+
+		r.lc.Debugf("Failed to publish to topic '%s' on attempt %d, retrying in %s: %s", topic, attempt, backoff.String(), err.Error())
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// metricsTopic returns the base topic the metrics are published under, which is the expanded PublishTopicPrefix
+// when configured
+func (r *messageBusReporter) metricsTopic() (string, error) {
+	if len(r.config.PublishTopicPrefix) == 0 {
+		return r.baseMetricsTopic, nil
+	}
+
+	substitutions := map[string]string{ServiceTopicPlaceholder: r.serviceName}
+	for name, value := range r.substitutions {
+		substitutions[name] = value
+	}
+
+	return ExpandTopicTemplate(r.config.PublishTopicPrefix, substitutions)
+}
+
+// SetMessageClient sets the MessageClient used to publish the metrics. This allows the client to be injected when
+// it becomes available after the reporter has been constructed, rather than waiting for it to be added to the DIC.
+func (r *messageBusReporter) SetMessageClient(client messaging.MessageClient) {
+	r.clientMutex.Lock()
+	defer r.clientMutex.Unlock()
+
+	r.messageClient = client
+}
+
+// getMessageClient returns the MessageClient, getting it from the DIC the first time since App Services create the
+// messaging client after bootstrapping. Returns nil if the client is not yet available.
+func (r *messageBusReporter) getMessageClient() messaging.MessageClient {
+	r.clientMutex.Lock()
+	defer r.clientMutex.Unlock()
+
+	if r.messageClient == nil && r.dic != nil {
+		r.messageClient = container.MessagingClientFrom(r.dic.Get)
+	}
 
-	return errs
+	return r.messageClient
 }
 
-func buildMetricTags(tags map[string]string) []dtos.MetricTag {
+func (r *messageBusReporter) buildMetricTags(tags map[string]string) []dtos.MetricTag {
 	var metricTags []dtos.MetricTag
 
 	for tagName, tagValue := range tags {
 		metricTags = append(metricTags, dtos.MetricTag{
-			Name:  tagName,
+			Name:  r.sanitize(tagName),
 			Value: tagValue,
 		})
 	}
 
 	return metricTags
 }
+
+func (r *messageBusReporter) setEnabledOverrides(overrides *enabledOverrides) {
+	r.overrides = overrides
+}
+
+func (r *messageBusReporter) setServiceTags(tags *serviceTags) {
+	r.serviceTags = tags
+}
+
+// sanitize applies the sanitizer, if one has been set, to the metric or tag name
+func (r *messageBusReporter) sanitize(name string) string {
+	if r.sanitizer == nil {
+		return name
+	}
+
+	return r.sanitizer(name)
+}
+
+// counterDelta returns the change in the named counter's count since the previous report and retains the count for
+// the next report. When the counter has been reset, i.e. the count is less than the previous count, the current
+// count is returned as the delta.
+func (r *messageBusReporter) counterDelta(name string, count int64) int64 {
+	r.countsMutex.Lock()
+	defer r.countsMutex.Unlock()
+
+	previous := r.previousCounts[name]
+	r.previousCounts[name] = count
+
+	if count < previous {
+		return count
+	}
+
+	return count - previous
+}
+
+// mergeMetricTags merges the service tags and the per-metric tags into a new deduplicated list. The per-metric tags take
+// precedence over service tags with the same name, which allows a metric to override a tag such as the service tag.
+func mergeMetricTags(serviceTags []dtos.MetricTag, metricTags []dtos.MetricTag) []dtos.MetricTag {
+	overridden := make(map[string]bool, len(metricTags))
+	for _, tag := range metricTags {
+		overridden[tag.Name] = true
+	}
+
+	merged := make([]dtos.MetricTag, 0, len(serviceTags)+len(metricTags))
+	for _, tag := range serviceTags {
+		if !overridden[tag.Name] {
+			merged = append(merged, tag)
+		}
+	}
+
+	return append(merged, metricTags...)
+}
+
+// buildMetricFields builds the fields of the metric item from a snapshot of its current values. The Counter delta
+// is not included since it depends on the previous report.
+func buildMetricFields(item interface{}, percentiles []float64) ([]dtos.MetricField, error) {
+	switch metric := item.(type) {
+	case gometrics.Counter:
+		snapshot := metric.Snapshot()
+		return []dtos.MetricField{{Name: counterCountName, Value: snapshot.Count()}}, nil
+
+	case gometrics.Gauge:
+		snapshot := metric.Snapshot()
+		return []dtos.MetricField{{Name: gaugeValueName, Value: snapshot.Value()}}, nil
+
+	case gometrics.GaugeFloat64:
+		snapshot := metric.Snapshot()
+		return []dtos.MetricField{{Name: gaugeFloat64ValueName, Value: snapshot.Value()}}, nil
+
+	case gometrics.Timer:
+		snapshot := metric.Snapshot()
+		return []dtos.MetricField{
+			{Name: timerCountName, Value: snapshot.Count()},
+			{Name: timerMinName, Value: snapshot.Min()},
+			{Name: timerMaxName, Value: snapshot.Max()},
+			{Name: timerMeanName, Value: snapshot.Mean()},
+			{Name: timerStddevName, Value: snapshot.StdDev()},
+			{Name: timerVarianceName, Value: snapshot.Variance()},
+		}, nil
+
+	case gometrics.Histogram:
+		snapshot := metric.Snapshot()
+		fields := []dtos.MetricField{
+			{Name: histogramCountName, Value: snapshot.Count()},
+			{Name: histogramMinName, Value: snapshot.Min()},
+			{Name: histogramMaxName, Value: snapshot.Max()},
+			{Name: histogramMeanName, Value: snapshot.Mean()},
+			{Name: histogramStddevName, Value: snapshot.StdDev()},
+			{Name: histogramVarianceName, Value: snapshot.Variance()},
+		}
+		return append(fields, buildPercentileFields(snapshot, percentiles)...), nil
+
+	case gometrics.Meter:
+		snapshot := metric.Snapshot()
+		return []dtos.MetricField{
+			{Name: meterCountName, Value: snapshot.Count()},
+			{Name: meterRate1Name, Value: snapshot.Rate1()},
+			{Name: meterRate5Name, Value: snapshot.Rate5()},
+			{Name: meterRate15Name, Value: snapshot.Rate15()},
+			{Name: meterRateMeanName, Value: snapshot.RateMean()},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("metric type %T not supported", metric)
+	}
+}
+
+// buildPercentileFields builds a metric field for each of the percentiles, which are expressed as
+// values between 0 and 100, from the histogram snapshot.
+func buildPercentileFields(snapshot gometrics.Histogram, percentiles []float64) []dtos.MetricField {
+	values := snapshot.Percentiles(percentileRatios(percentiles))
+
+	fields := make([]dtos.MetricField, len(percentiles))
+	for index, percentile := range percentiles {
+		fields[index] = dtos.MetricField{
+			Name:  histogramPercentilePrefix + strconv.FormatFloat(percentile, 'f', -1, 64),
+			Value: values[index],
+		}
+	}
+
+	return fields
+}
+
+// percentileRatios converts the percentiles, which are expressed as values between 0 and 100, to the ratios between
+// 0 and 1 expected by go-metrics
+func percentileRatios(percentiles []float64) []float64 {
+	ratios := make([]float64, len(percentiles))
+	for index, percentile := range percentiles {
+		ratios[index] = percentile / 100
+	}
+
+	return ratios
+}
+
+// ExpandTopicTemplate expands the {name} placeholders in the topic template with the matching substitution values.
+// A template without placeholders is returned unchanged. An error is returned if any of the placeholders does not
+// have a substitution value, so that metrics are never published to a literal {name} topic.
+func ExpandTopicTemplate(template string, substitutions map[string]string) (string, error) {
+	var unresolved []string
+
+	topic := topicPlaceholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		value, ok := substitutions[name]
+		if !ok || len(value) == 0 {
+			unresolved = append(unresolved, placeholder)
+			return placeholder
+		}
+
+		return value
+	})
+
+	if len(unresolved) > 0 {
+		return "", fmt.Errorf("topic '%s' has unresolved placeholders: %s", template, strings.Join(unresolved, ", "))
+	}
+
+	return topic, nil
+}
@@ -15,10 +15,16 @@
 package metrics
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/hashicorp/go-multierror"
 	gometrics "github.com/rcrowley/go-metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -142,6 +148,9 @@ func TestMessageBusReporter_Report(t *testing.T) {
 	gaugeFloat64 := gometrics.NewGaugeFloat64()
 	gaugeFloat64.Update(floatValue)
 
+	functionalGauge := gometrics.NewFunctionalGauge(func() int64 { return intValue })
+	functionalGaugeFloat64 := gometrics.NewFunctionalGaugeFloat64(func() float64 { return floatValue })
+
 	expectedTimerMetric := expectedCounterMetric
 	copy(expectedTimerMetric.Fields, expectedCounterMetric.Fields)
 	expectedTimerMetric.Fields = []dtos.MetricField{
@@ -175,9 +184,24 @@ func TestMessageBusReporter_Report(t *testing.T) {
 			{Name: histogramMeanName, Value: float64(0)},
 			{Name: histogramStddevName, Value: float64(0)},
 			{Name: histogramVarianceName, Value: float64(0)},
+			{Name: histogramPercentilePrefix + "50", Value: float64(0)},
+			{Name: histogramPercentilePrefix + "75", Value: float64(0)},
+			{Name: histogramPercentilePrefix + "95", Value: float64(0)},
+			{Name: histogramPercentilePrefix + "99", Value: float64(0)},
 		}...)
 	histogram := gometrics.NewHistogram(gometrics.NewUniformSample(1028))
 
+	expectedMeterMetric := expectedCounterMetric
+	expectedMeterMetric.Fields = []dtos.MetricField{
+		{Name: meterCountName, Value: float64(0)},
+		{Name: meterRate1Name, Value: float64(0)},
+		{Name: meterRate5Name, Value: float64(0)},
+		{Name: meterRate15Name, Value: float64(0)},
+		{Name: meterRateMeanName, Value: float64(0)},
+	}
+	meter := gometrics.NewMeter()
+	defer meter.Stop()
+
 	tests := []struct {
 		Name           string
 		Metric         interface{}
@@ -187,10 +211,13 @@ func TestMessageBusReporter_Report(t *testing.T) {
 		{"Happy path - Counter", counter, &expectedCounterMetric, false},
 		{"Happy path - Gauge", gauge, &expectedGaugeMetric, false},
 		{"Happy path - GaugeFloat64", gaugeFloat64, &expectedGaugeFloat64Metric, false},
+		{"Happy path - FunctionalGauge", functionalGauge, &expectedGaugeMetric, false},
+		{"Happy path - FunctionalGaugeFloat64", functionalGaugeFloat64, &expectedGaugeFloat64Metric, false},
 		{"Happy path - Timer", timer, &expectedTimerMetric, false},
 		{"Happy path - Histogram", histogram, &expectedHistogramMetric, false},
+		{"Happy path - Meter", meter, &expectedMeterMetric, false},
 		{"No Metrics", nil, nil, false},
-		{"Unsupported Metric", gometrics.NewMeter(), nil, true},
+		{"Unsupported Metric", gometrics.NewHealthcheck(func(gometrics.Healthcheck) {}), nil, true},
 	}
 
 	for _, test := range tests {
@@ -242,3 +269,917 @@ func TestMessageBusReporter_Report(t *testing.T) {
 		})
 	}
 }
+
+func TestMessageBusReporter_Report_MeterAndTimer(t *testing.T) {
+	meterName := "test-meter"
+	timerName := "test-timer"
+
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{
+			meterName: true,
+			timerName: true,
+		},
+	}
+
+	meter := gometrics.NewMeter()
+	defer meter.Stop()
+	meter.Mark(5)
+
+	timer := gometrics.NewTimer()
+	defer timer.Stop()
+	timer.Update(time.Millisecond * 10)
+
+	reg := gometrics.NewRegistry()
+	require.NoError(t, reg.Register(meterName, meter))
+	require.NoError(t, reg.Register(timerName, timer))
+
+	actual := make(map[string]dtos.Metric)
+	mockClient := &mocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		message, ok := args.Get(0).(types.MessageEnvelope)
+		require.True(t, ok)
+		metric := dtos.Metric{}
+		err := json.Unmarshal(message.Payload, &metric)
+		require.NoError(t, err)
+		actual[metric.Name] = metric
+	})
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.MessagingClientName: func(get di.Get) interface{} {
+			return mockClient
+		},
+	})
+
+	target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", dic, telemetryConfig)
+	err := target.Report(reg, nil)
+	require.NoError(t, err)
+	mockClient.AssertNumberOfCalls(t, "Publish", 2)
+
+	require.Contains(t, actual, meterName)
+	require.NotEmpty(t, actual[meterName].Fields)
+	assert.Equal(t, meterCountName, actual[meterName].Fields[0].Name)
+	assert.Equal(t, float64(5), actual[meterName].Fields[0].Value)
+
+	require.Contains(t, actual, timerName)
+	require.NotEmpty(t, actual[timerName].Fields)
+	assert.Equal(t, timerCountName, actual[timerName].Fields[0].Name)
+	assert.Equal(t, float64(1), actual[timerName].Fields[0].Value)
+}
+
+func TestMessageBusReporter_Report_BatchPublish(t *testing.T) {
+	expectedServiceName := "test-service"
+	expectedBaseTopic := common.BuildTopic(common.DefaultBaseTopic, common.MetricsPublishTopic, expectedServiceName)
+	metricNames := []string{"metric-one", "metric-two", "metric-three"}
+	metricTags := map[string]map[string]string{
+		"metric-two": {"pipeline": "my-pipeline"},
+	}
+
+	tests := []struct {
+		Name                 string
+		BatchPublish         bool
+		ExpectedPublishCalls int
+	}{
+		{"Batch publish", true, 1},
+		{"Publish per metric", false, len(metricNames)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			telemetryConfig := &config.TelemetryInfo{
+				Metrics:      make(map[string]bool),
+				BatchPublish: test.BatchPublish,
+			}
+
+			reg := gometrics.NewRegistry()
+			for _, name := range metricNames {
+				telemetryConfig.Metrics[name] = true
+				counter := gometrics.NewCounter()
+				counter.Inc(1)
+				require.NoError(t, reg.Register(name, counter))
+			}
+
+			var published []dtos.Metric
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				message, ok := args.Get(0).(types.MessageEnvelope)
+				require.True(t, ok)
+				topic := args.Get(1).(string)
+
+				if test.BatchPublish {
+					assert.Equal(t, expectedBaseTopic, topic)
+					var batch []dtos.Metric
+					require.NoError(t, json.Unmarshal(message.Payload, &batch))
+					published = append(published, batch...)
+					return
+				}
+
+				metric := dtos.Metric{}
+				require.NoError(t, json.Unmarshal(message.Payload, &metric))
+				assert.Equal(t, common.BuildTopic(expectedBaseTopic, metric.Name), topic)
+				published = append(published, metric)
+			})
+
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.MessagingClientName: func(get di.Get) interface{} {
+					return mockClient
+				},
+			})
+
+			target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, expectedServiceName, dic, telemetryConfig)
+			err := target.Report(reg, metricTags)
+			require.NoError(t, err)
+
+			mockClient.AssertNumberOfCalls(t, "Publish", test.ExpectedPublishCalls)
+			require.Len(t, published, len(metricNames))
+
+			for _, metric := range published {
+				assert.Contains(t, metric.Tags, dtos.MetricTag{Name: serviceNameTagKey, Value: expectedServiceName})
+				if metric.Name == "metric-two" {
+					assert.Contains(t, metric.Tags, dtos.MetricTag{Name: "pipeline", Value: "my-pipeline"})
+				}
+			}
+		})
+	}
+}
+
+func TestMessageBusReporter_Report_Sanitizer(t *testing.T) {
+	expectedServiceName := "test-service"
+	metricName := "my.metric/one"
+	expectedBaseTopic := common.BuildTopic(common.DefaultBaseTopic, common.MetricsPublishTopic, expectedServiceName)
+	sanitizer := strings.NewReplacer(".", "_", "/", "_").Replace
+
+	tests := []struct {
+		Name            string
+		Sanitizer       func(string) string
+		ExpectedName    string
+		ExpectedTagName string
+	}{
+		{"With sanitizer", sanitizer, "my_metric_one", "my_tag"},
+		{"Without sanitizer", nil, metricName, "my.tag"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			telemetryConfig := &config.TelemetryInfo{
+				Metrics: map[string]bool{metricName: true},
+				Tags:    map[string]string{"my.tag": "my-value"},
+			}
+
+			reg := gometrics.NewRegistry()
+			require.NoError(t, reg.Register(metricName, gometrics.NewCounter()))
+
+			var actual dtos.Metric
+			var actualTopic string
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				message, ok := args.Get(0).(types.MessageEnvelope)
+				require.True(t, ok)
+				require.NoError(t, json.Unmarshal(message.Payload, &actual))
+				actualTopic = args.Get(1).(string)
+			})
+
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.MessagingClientName: func(get di.Get) interface{} {
+					return mockClient
+				},
+			})
+
+			var options []ReporterOption
+			if test.Sanitizer != nil {
+				options = append(options, WithSanitizer(test.Sanitizer))
+			}
+
+			target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, expectedServiceName, dic, telemetryConfig, options...)
+			err := target.Report(reg, nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.ExpectedName, actual.Name)
+			assert.Equal(t, common.BuildTopic(expectedBaseTopic, test.ExpectedName), actualTopic)
+			assert.Contains(t, actual.Tags, dtos.MetricTag{Name: test.ExpectedTagName, Value: "my-value"})
+		})
+	}
+}
+
+func TestMessageBusReporter_ReportWithCount(t *testing.T) {
+	expectedServiceName := "test-service"
+	failingMetricName := "failing-metric"
+	expectedBaseTopic := common.BuildTopic(common.DefaultBaseTopic, common.MetricsPublishTopic, expectedServiceName)
+
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{
+			"metric-one":      true,
+			"metric-two":      true,
+			failingMetricName: true,
+		},
+	}
+
+	reg := gometrics.NewRegistry()
+	for name := range telemetryConfig.Metrics {
+		require.NoError(t, reg.Register(name, gometrics.NewCounter()))
+	}
+
+	mockClient := &mocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, common.BuildTopic(expectedBaseTopic, failingMetricName)).Return(errors.New("publish failed"))
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.MessagingClientName: func(get di.Get) interface{} {
+			return mockClient
+		},
+	})
+
+	target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, expectedServiceName, dic, telemetryConfig)
+	count, err := target.ReportWithCount(reg, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), failingMetricName)
+	assert.Equal(t, 2, count)
+	mockClient.AssertNumberOfCalls(t, "Publish", 3)
+}
+
+func TestMessageBusReporter_Report_HistogramPercentiles(t *testing.T) {
+	expectedMetricName := "test-histogram"
+
+	tests := []struct {
+		Name                string
+		Percentiles         []float64
+		ExpectedPercentiles map[string]float64
+	}{
+		{"Default percentiles", nil, map[string]float64{
+			histogramPercentilePrefix + "50": 50.5,
+			histogramPercentilePrefix + "75": 75.75,
+			histogramPercentilePrefix + "95": 95.95,
+			histogramPercentilePrefix + "99": 99.99,
+		}},
+		{"Configured percentiles", []float64{50, 99.9}, map[string]float64{
+			histogramPercentilePrefix + "50":   50.5,
+			histogramPercentilePrefix + "99.9": 100,
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			telemetryConfig := &config.TelemetryInfo{
+				Metrics:              map[string]bool{expectedMetricName: true},
+				HistogramPercentiles: test.Percentiles,
+			}
+
+			histogram := gometrics.NewHistogram(gometrics.NewUniformSample(1028))
+			for value := int64(1); value <= 100; value++ {
+				histogram.Update(value)
+			}
+
+			reg := gometrics.NewRegistry()
+			err := reg.Register(expectedMetricName, histogram)
+			require.NoError(t, err)
+
+			var actual dtos.Metric
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				message, ok := args.Get(0).(types.MessageEnvelope)
+				require.True(t, ok)
+				err := json.Unmarshal(message.Payload, &actual)
+				require.NoError(t, err)
+			})
+
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.MessagingClientName: func(get di.Get) interface{} {
+					return mockClient
+				},
+			})
+
+			target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", dic, telemetryConfig)
+			err = target.Report(reg, nil)
+			require.NoError(t, err)
+			mockClient.AssertNumberOfCalls(t, "Publish", 1)
+
+			actualPercentiles := make(map[string]float64)
+			for _, field := range actual.Fields {
+				if strings.HasPrefix(field.Name, histogramPercentilePrefix) {
+					actualPercentiles[field.Name] = field.Value.(float64)
+				}
+			}
+
+			require.Len(t, actualPercentiles, len(test.ExpectedPercentiles))
+			for name, expected := range test.ExpectedPercentiles {
+				assert.InDelta(t, expected, actualPercentiles[name], 0.0001, name)
+			}
+		})
+	}
+}
+
+func TestMessageBusReporter_Report_NilMessageClient(t *testing.T) {
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{"MyCounter": true},
+	}
+
+	reg := gometrics.NewRegistry()
+	require.NoError(t, reg.Register("MyCounter", gometrics.NewCounter()))
+
+	dic := di.NewContainer(di.ServiceConstructorMap{})
+
+	tests := []struct {
+		Name string
+		Dic  *di.Container
+	}{
+		{"No client in DIC", dic},
+		{"No DIC", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", test.Dic, telemetryConfig)
+
+			var count int
+			var err error
+			require.NotPanics(t, func() {
+				count, err = target.ReportWithCount(reg, nil)
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "messaging client not available")
+			assert.Equal(t, 0, count)
+
+			// Client injected after construction is used on the next report
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			setter, ok := target.(MessageClientSetter)
+			require.True(t, ok)
+			setter.SetMessageClient(mockClient)
+
+			count, err = target.ReportWithCount(reg, nil)
+			require.NoError(t, err)
+			assert.Equal(t, 1, count)
+			mockClient.AssertNumberOfCalls(t, "Publish", 1)
+		})
+	}
+}
+
+func TestMessageBusReporter_Report_PublishTopicPrefix(t *testing.T) {
+	expectedServiceName := "test-service"
+	metricName := "MyCounter"
+
+	tests := []struct {
+		Name          string
+		Prefix        string
+		ExpectedTopic string
+		ExpectError   bool
+	}{
+		{"Default prefix", "", common.BuildTopic(common.DefaultBaseTopic, common.MetricsPublishTopic, expectedServiceName, metricName), false},
+		{"Plain prefix", "edgex/metrics", "edgex/metrics/" + metricName, false},
+		{"Templated prefix", "edgex/{env}/metrics/{service}", "edgex/production/metrics/" + expectedServiceName + "/" + metricName, false},
+		{"Unresolved placeholder", "edgex/{tenant}/metrics", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			telemetryConfig := &config.TelemetryInfo{
+				Metrics:            map[string]bool{metricName: true},
+				PublishTopicPrefix: test.Prefix,
+			}
+
+			reg := gometrics.NewRegistry()
+			require.NoError(t, reg.Register(metricName, gometrics.NewCounter()))
+
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, test.ExpectedTopic).Return(nil)
+
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.MessagingClientName: func(get di.Get) interface{} {
+					return mockClient
+				},
+			})
+
+			target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, expectedServiceName, dic, telemetryConfig,
+				WithTopicSubstitutions(map[string]string{"env": "production"}))
+			err := target.Report(reg, nil)
+
+			if test.ExpectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "{tenant}")
+				mockClient.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExpandTopicTemplate(t *testing.T) {
+	substitutions := map[string]string{"env": "production", "service": "core-data", "empty": ""}
+
+	tests := []struct {
+		Name        string
+		Template    string
+		Expected    string
+		ExpectError bool
+	}{
+		{"No placeholders", "edgex/metrics", "edgex/metrics", false},
+		{"Placeholders", "edgex/{env}/{service}", "edgex/production/core-data", false},
+		{"Repeated placeholder", "{env}/{env}", "production/production", false},
+		{"Unknown placeholder", "edgex/{tenant}", "", true},
+		{"Empty value", "edgex/{empty}", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			actual, err := ExpandTopicTemplate(test.Template, substitutions)
+			if test.ExpectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.Expected, actual)
+		})
+	}
+}
+
+func TestMergeMetricTags(t *testing.T) {
+	serviceTags := []dtos.MetricTag{
+		{Name: serviceNameTagKey, Value: "test-service"},
+		{Name: "gateway", Value: "my-gateway"},
+	}
+
+	tests := []struct {
+		Name       string
+		MetricTags []dtos.MetricTag
+		Expected   []dtos.MetricTag
+	}{
+		{"No metric tags", nil, serviceTags},
+		{"Additional metric tag", []dtos.MetricTag{{Name: "pipeline", Value: "my-pipeline"}}, []dtos.MetricTag{
+			{Name: serviceNameTagKey, Value: "test-service"},
+			{Name: "gateway", Value: "my-gateway"},
+			{Name: "pipeline", Value: "my-pipeline"},
+		}},
+		{"Metric tag overrides service tag", []dtos.MetricTag{{Name: serviceNameTagKey, Value: "aggregate-service"}}, []dtos.MetricTag{
+			{Name: "gateway", Value: "my-gateway"},
+			{Name: serviceNameTagKey, Value: "aggregate-service"},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			actual := mergeMetricTags(serviceTags, test.MetricTags)
+			assert.Equal(t, test.Expected, actual)
+		})
+	}
+
+	// The service tags must not be modified by merging
+	assert.Equal(t, "test-service", serviceTags[0].Value)
+}
+
+func TestMessageBusReporter_Report_CounterDeltas(t *testing.T) {
+	counterName := "test-counter"
+
+	tests := []struct {
+		Name           string
+		CounterDeltas  bool
+		Counts         []int64
+		ExpectedDeltas []float64
+	}{
+		{"Deltas", true, []int64{5, 3}, []float64{5, 3}},
+		{"Counter reset", true, []int64{5, -3}, []float64{5, 2}},
+		{"Deltas disabled", false, []int64{5, 3}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			telemetryConfig := &config.TelemetryInfo{
+				Metrics:       map[string]bool{counterName: true},
+				CounterDeltas: test.CounterDeltas,
+			}
+
+			counter := gometrics.NewCounter()
+			reg := gometrics.NewRegistry()
+			require.NoError(t, reg.Register(counterName, counter))
+
+			var actual []dtos.Metric
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				message, ok := args.Get(0).(types.MessageEnvelope)
+				require.True(t, ok)
+				metric := dtos.Metric{}
+				err := json.Unmarshal(message.Payload, &metric)
+				require.NoError(t, err)
+				actual = append(actual, metric)
+			})
+
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.MessagingClientName: func(get di.Get) interface{} {
+					return mockClient
+				},
+			})
+
+			target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", dic, telemetryConfig)
+			for _, count := range test.Counts {
+				counter.Inc(count)
+				require.NoError(t, target.Report(reg, nil))
+			}
+
+			require.Len(t, actual, len(test.Counts))
+			for index, metric := range actual {
+				if test.ExpectedDeltas == nil {
+					require.Len(t, metric.Fields, 1)
+					continue
+				}
+
+				require.Len(t, metric.Fields, 2)
+				assert.Equal(t, counterCountName, metric.Fields[0].Name)
+				assert.Equal(t, counterDeltaName, metric.Fields[1].Name)
+				assert.Equal(t, test.ExpectedDeltas[index], metric.Fields[1].Value)
+			}
+		})
+	}
+}
+
+func TestMessageBusReporter_Report_RetryPolicy(t *testing.T) {
+	metricName := "test-counter"
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{metricName: true},
+	}
+
+	tests := []struct {
+		Name            string
+		Policy          *RetryPolicy
+		Failures        int
+		ExpectError     bool
+		ExpectedPublish int
+	}{
+		{"Retry succeeds", &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond}, 2, false, 3},
+		{"Retries exhausted", &RetryPolicy{MaxAttempts: 2, BaseBackoff: time.Millisecond}, 2, true, 2},
+		{"Retries bounded by max elapsed", &RetryPolicy{MaxAttempts: 10, BaseBackoff: time.Millisecond * 20, MaxElapsed: time.Millisecond * 30}, 5, true, 2},
+		{"No retry by default", nil, 1, true, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			reg := gometrics.NewRegistry()
+			require.NoError(t, reg.Register(metricName, gometrics.NewCounter()))
+
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(errors.New("broker unavailable")).Times(test.Failures)
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.MessagingClientName: func(get di.Get) interface{} {
+					return mockClient
+				},
+			})
+
+			var options []ReporterOption
+			if test.Policy != nil {
+				options = append(options, WithRetryPolicy(*test.Policy))
+			}
+
+			target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", dic, telemetryConfig, options...)
+			count, err := target.ReportWithCount(reg, nil)
+
+			mockClient.AssertNumberOfCalls(t, "Publish", test.ExpectedPublish)
+			if test.ExpectError {
+				require.Error(t, err)
+				assert.Equal(t, 0, count)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, count)
+		})
+	}
+}
+
+func TestMessageBusReporter_Report_CorrelationID(t *testing.T) {
+	metricNames := []string{"metric-one", "metric-two", "metric-three"}
+
+	tests := []struct {
+		Name             string
+		Options          []ReporterOption
+		ExpectSharedID   bool
+		ExpectedIDsCount int
+	}{
+		{"Per message", nil, false, 3},
+		{"Per report", []ReporterOption{WithReportCorrelationID()}, true, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			telemetryConfig := &config.TelemetryInfo{
+				Metrics: make(map[string]bool),
+			}
+
+			reg := gometrics.NewRegistry()
+			for _, name := range metricNames {
+				telemetryConfig.Metrics[name] = true
+				require.NoError(t, reg.Register(name, gometrics.NewCounter()))
+			}
+
+			correlationIDs := make(map[string]bool)
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				message, ok := args.Get(0).(types.MessageEnvelope)
+				require.True(t, ok)
+				require.NotEmpty(t, message.CorrelationID)
+				correlationIDs[message.CorrelationID] = true
+			})
+
+			target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", nil, telemetryConfig, test.Options...)
+			target.(MessageClientSetter).SetMessageClient(mockClient)
+
+			require.NoError(t, target.Report(reg, nil))
+			mockClient.AssertNumberOfCalls(t, "Publish", len(metricNames))
+			assert.Len(t, correlationIDs, test.ExpectedIDsCount)
+
+			if !test.ExpectSharedID {
+				return
+			}
+
+			// The next report has a new correlation ID
+			require.NoError(t, target.Report(reg, nil))
+			assert.Len(t, correlationIDs, 2)
+		})
+	}
+}
+
+func TestMessageBusReporter_ReportWithContext_Cancelled(t *testing.T) {
+	metricNames := []string{"metric-one", "metric-two", "metric-three"}
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: make(map[string]bool),
+	}
+
+	reg := gometrics.NewRegistry()
+	for _, name := range metricNames {
+		telemetryConfig.Metrics[name] = true
+		require.NoError(t, reg.Register(name, gometrics.NewCounter()))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Simulates the shutdown signal arriving while the first metric is being published
+	mockClient := &mocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(_ mock.Arguments) {
+		cancel()
+	})
+
+	target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", nil, telemetryConfig)
+	target.(MessageClientSetter).SetMessageClient(mockClient)
+
+	count, err := target.ReportWithContext(ctx, reg, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "report cancelled after publishing 1 metrics, skipped remaining 2 metrics")
+	assert.Equal(t, 1, count)
+	mockClient.AssertNumberOfCalls(t, "Publish", 1)
+}
+
+func TestMessageBusReporter_Report_MaxMetricsPerReport(t *testing.T) {
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{
+			"disabled":                  false,
+			ReporterPublishedMetricName: true,
+		},
+		MaxMetricsPerReport: 3,
+	}
+
+	reg := gometrics.NewRegistry()
+	for index := 0; index < 7; index++ {
+		name := fmt.Sprintf("metric-%d", index)
+		telemetryConfig.Metrics[name] = true
+		require.NoError(t, reg.Register(name, gometrics.NewCounter()))
+	}
+	require.NoError(t, reg.Register("disabled", gometrics.NewCounter()))
+	require.NoError(t, reg.Register(ReporterPublishedMetricName, gometrics.NewCounter()))
+
+	var published []string
+	mockClient := &mocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		topic, ok := args.Get(1).(string)
+		require.True(t, ok)
+		published = append(published, topic[strings.LastIndex(topic, "/")+1:])
+	})
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.MessagingClientName: func(get di.Get) interface{} {
+			return mockClient
+		},
+	})
+
+	target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", dic, telemetryConfig)
+
+	expectedReports := [][]string{
+		{"metric-0", "metric-1", "metric-2"},
+		{"metric-3", "metric-4", "metric-5"},
+		{"metric-6", "metric-0", "metric-1"},
+		{"metric-2", "metric-3", "metric-4"},
+	}
+
+	reportedCounts := make(map[string]int)
+	for _, expected := range expectedReports {
+		published = nil
+		count, err := target.ReportWithCount(reg, nil)
+		require.NoError(t, err)
+
+		// The reporter health metrics are always reported and don't count towards the cap
+		assert.Equal(t, len(expected)+1, count)
+		assert.ElementsMatch(t, append(expected, ReporterPublishedMetricName), published)
+		for _, name := range expected {
+			reportedCounts[name]++
+		}
+	}
+
+	// No metric is permanently omitted
+	for index := 0; index < 7; index++ {
+		assert.NotZero(t, reportedCounts[fmt.Sprintf("metric-%d", index)])
+	}
+
+	// All the metrics are reported once the cap is raised above the number of enabled metrics
+	telemetryConfig.MaxMetricsPerReport = 10
+	published = nil
+	count, err := target.ReportWithCount(reg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 8, count)
+	assert.NotContains(t, published, "disabled")
+}
+
+func TestMessageBusReporter_Report_SortedMetrics(t *testing.T) {
+	names := []string{"zeta", "alpha", "mu", "beta", "omega", "gamma"}
+	expected := []string{"alpha", "beta", "gamma", "mu", "omega", "zeta"}
+
+	tests := []struct {
+		Name         string
+		BatchPublish bool
+	}{
+		{"Individual", false},
+		{"Batch", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			telemetryConfig := &config.TelemetryInfo{
+				Metrics:      make(map[string]bool),
+				BatchPublish: test.BatchPublish,
+			}
+
+			reg := gometrics.NewRegistry()
+			for _, name := range names {
+				telemetryConfig.Metrics[name] = true
+				require.NoError(t, reg.Register(name, gometrics.NewCounter()))
+			}
+
+			var actual []string
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				message, ok := args.Get(0).(types.MessageEnvelope)
+				require.True(t, ok)
+
+				var metrics []dtos.Metric
+				if test.BatchPublish {
+					require.NoError(t, json.Unmarshal(message.Payload, &metrics))
+				} else {
+					metric := dtos.Metric{}
+					require.NoError(t, json.Unmarshal(message.Payload, &metric))
+					metrics = append(metrics, metric)
+				}
+
+				for _, metric := range metrics {
+					actual = append(actual, metric.Name)
+				}
+			})
+
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.MessagingClientName: func(get di.Get) interface{} {
+					return mockClient
+				},
+			})
+
+			target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", dic,
+				telemetryConfig, WithSortedMetrics())
+
+			// The registry's iteration order varies, so the order is checked over several reports
+			for report := 0; report < 5; report++ {
+				actual = nil
+				require.NoError(t, target.Report(reg, nil))
+				assert.Equal(t, expected, actual)
+			}
+		})
+	}
+}
+
+func TestMessageBusReporter_Report_Transform(t *testing.T) {
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{"good": true, "bad": true},
+	}
+
+	reg := gometrics.NewRegistry()
+	require.NoError(t, reg.Register("good", gometrics.NewCounter()))
+	require.NoError(t, reg.Register("bad", gometrics.NewCounter()))
+
+	var actual []dtos.Metric
+	mockClient := &mocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		message, ok := args.Get(0).(types.MessageEnvelope)
+		require.True(t, ok)
+		metric := dtos.Metric{}
+		require.NoError(t, json.Unmarshal(message.Payload, &metric))
+		actual = append(actual, metric)
+	})
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.MessagingClientName: func(get di.Get) interface{} {
+			return mockClient
+		},
+	})
+
+	transform := func(metric dtos.Metric) (dtos.Metric, error) {
+		if metric.Name == "bad" {
+			return metric, errors.New("tenant unknown")
+		}
+
+		metric.Tags = append(metric.Tags, dtos.MetricTag{Name: "tenant", Value: "tenant-1"})
+		metric.Fields = append(metric.Fields, dtos.MetricField{Name: "schema-version", Value: "2"})
+		return metric, nil
+	}
+
+	target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", dic,
+		telemetryConfig, WithTransform(transform))
+
+	count, err := target.ReportWithCount(reg, nil)
+	require.Error(t, err)
+	assert.Equal(t, 1, count)
+
+	var errs *multierror.Error
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs.Errors, 1)
+	assert.Contains(t, errs.Errors[0].Error(), "unable to transform metric 'bad': tenant unknown")
+
+	require.Len(t, actual, 1)
+	assert.Equal(t, "good", actual[0].Name)
+	assert.Contains(t, actual[0].Tags, dtos.MetricTag{Name: "tenant", Value: "tenant-1"})
+	assert.Contains(t, actual[0].Tags, dtos.MetricTag{Name: serviceNameTagKey, Value: "test-service"})
+	assert.Contains(t, actual[0].Fields, dtos.MetricField{Name: "schema-version", Value: "2"})
+}
+
+func TestMessageBusReporter_Report_FailureCallback(t *testing.T) {
+	telemetryConfig := &config.TelemetryInfo{
+		Metrics: map[string]bool{"good": true, "bad": true},
+	}
+
+	reg := gometrics.NewRegistry()
+	require.NoError(t, reg.Register("good", gometrics.NewCounter()))
+	require.NoError(t, reg.Register("bad", gometrics.NewCounter()))
+
+	goodTopic := common.BuildTopic(common.DefaultBaseTopic, common.MetricsPublishTopic, "test-service", "good")
+	badTopic := common.BuildTopic(common.DefaultBaseTopic, common.MetricsPublishTopic, "test-service", "bad")
+	publishErr := errors.New("payload too large")
+
+	// The bad metric fails 4 times, succeeds once and then fails again
+	mockClient := &mocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, goodTopic).Return(nil)
+	mockClient.On("Publish", mock.Anything, badTopic).Return(publishErr).Times(4)
+	mockClient.On("Publish", mock.Anything, badTopic).Return(nil).Once()
+	mockClient.On("Publish", mock.Anything, badTopic).Return(publishErr)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.MessagingClientName: func(get di.Get) interface{} {
+			return mockClient
+		},
+	})
+
+	type failure struct {
+		name     string
+		failures int
+		err      error
+	}
+	var actual []failure
+	callback := func(name string, failures int, err error) {
+		actual = append(actual, failure{name, failures, err})
+	}
+
+	target := NewMessageBusReporter(logger.NewMockClient(), common.DefaultBaseTopic, "test-service", dic,
+		telemetryConfig, WithFailureCallback(3, callback))
+
+	// Reports 1 and 2 fail without reaching the threshold
+	for report := 1; report <= 2; report++ {
+		require.Error(t, target.Report(reg, nil))
+	}
+	assert.Empty(t, actual)
+
+	// Report 3 reaches the threshold
+	require.Error(t, target.Report(reg, nil))
+	require.Len(t, actual, 1)
+	assert.Equal(t, "bad", actual[0].name)
+	assert.Equal(t, 3, actual[0].failures)
+	assert.ErrorContains(t, actual[0].err, publishErr.Error())
+
+	// Report 4 fails beyond the threshold without calling back again and report 5 succeeds, resetting the count
+	require.Error(t, target.Report(reg, nil))
+	require.NoError(t, target.Report(reg, nil))
+	assert.Len(t, actual, 1)
+
+	// The threshold is reached again after 3 more consecutive failures
+	for report := 1; report <= 3; report++ {
+		require.Error(t, target.Report(reg, nil))
+	}
+	require.Len(t, actual, 2)
+	assert.Equal(t, "bad", actual[1].name)
+	assert.Equal(t, 3, actual[1].failures)
+}
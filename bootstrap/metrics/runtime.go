@@ -0,0 +1,110 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package metrics
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const (
+	// RuntimeGoroutinesMetricName is the name of the Gauge of the number of goroutines
+	RuntimeGoroutinesMetricName = "RuntimeGoroutines"
+	// RuntimeHeapAllocMetricName is the name of the Gauge of the bytes of allocated heap objects
+	RuntimeHeapAllocMetricName = "RuntimeHeapAlloc"
+	// RuntimeHeapInuseMetricName is the name of the Gauge of the bytes in in-use heap spans
+	RuntimeHeapInuseMetricName = "RuntimeHeapInuse"
+	// RuntimeHeapObjectsMetricName is the name of the Gauge of the number of allocated heap objects
+	RuntimeHeapObjectsMetricName = "RuntimeHeapObjects"
+	// RuntimeSysMetricName is the name of the Gauge of the total bytes of memory obtained from the OS
+	RuntimeSysMetricName = "RuntimeSys"
+	// RuntimeNumGCMetricName is the name of the Gauge of the number of completed GC cycles
+	RuntimeNumGCMetricName = "RuntimeNumGC"
+	// RuntimeGCPauseTotalMetricName is the name of the Gauge of the cumulative nanoseconds of GC stop-the-world pauses
+	RuntimeGCPauseTotalMetricName = "RuntimeGCPauseTotal"
+	// RuntimeGCLastPauseMetricName is the name of the Gauge of the nanoseconds of the most recent GC pause
+	RuntimeGCLastPauseMetricName = "RuntimeGCLastPause"
+)
+
+// ManagerOption is a function which sets an optional behavior of the Metrics Manager
+type ManagerOption func(*manager)
+
+// WithRuntimeMetrics registers the Go runtime metrics, i.e. the goroutine count, heap size and GC pauses, as Gauges
+// in the Metrics Manager's registry. The runtime stats are read once each time the metrics are reported, and the
+// runtime metrics are reported without having to be enabled individually in the Telemetry Metrics.
+func WithRuntimeMetrics() ManagerOption {
+	return func(m *manager) {
+		m.runtimeMetrics = newRuntimeMetrics()
+		for name, gauge := range m.runtimeMetrics.gauges {
+			_ = m.registry.Register(name, gauge)
+			m.startTimes.set(name, time.Now())
+			m.overrides.set(name, true)
+		}
+		m.runtimeMetrics.refresh()
+	}
+}
+
+// runtimeMetrics holds the Gauges updated from a snapshot of the Go runtime stats
+type runtimeMetrics struct {
+	gauges   map[string]gometrics.Gauge
+	memStats runtime.MemStats
+	mutex    sync.Mutex
+}
+
+func newRuntimeMetrics() *runtimeMetrics {
+	names := []string{
+		RuntimeGoroutinesMetricName,
+		RuntimeHeapAllocMetricName,
+		RuntimeHeapInuseMetricName,
+		RuntimeHeapObjectsMetricName,
+		RuntimeSysMetricName,
+		RuntimeNumGCMetricName,
+		RuntimeGCPauseTotalMetricName,
+		RuntimeGCLastPauseMetricName,
+	}
+
+	r := &runtimeMetrics{
+		gauges: make(map[string]gometrics.Gauge, len(names)),
+	}
+	for _, name := range names {
+		r.gauges[name] = gometrics.NewGauge()
+	}
+
+	return r
+}
+
+// refresh takes a new snapshot of the runtime stats and updates the Gauges from it
+func (r *runtimeMetrics) refresh() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	runtime.ReadMemStats(&r.memStats)
+
+	var lastPause uint64
+	if r.memStats.NumGC > 0 {
+		lastPause = r.memStats.PauseNs[(r.memStats.NumGC+255)%256]
+	}
+
+	r.gauges[RuntimeGoroutinesMetricName].Update(int64(runtime.NumGoroutine()))
+	r.gauges[RuntimeHeapAllocMetricName].Update(int64(r.memStats.HeapAlloc))
+	r.gauges[RuntimeHeapInuseMetricName].Update(int64(r.memStats.HeapInuse))
+	r.gauges[RuntimeHeapObjectsMetricName].Update(int64(r.memStats.HeapObjects))
+	r.gauges[RuntimeSysMetricName].Update(int64(r.memStats.Sys))
+	r.gauges[RuntimeNumGCMetricName].Update(int64(r.memStats.NumGC))
+	r.gauges[RuntimeGCPauseTotalMetricName].Update(int64(r.memStats.PauseTotalNs))
+	r.gauges[RuntimeGCLastPauseMetricName].Update(int64(lastPause))
+}
@@ -0,0 +1,108 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package metrics
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	gometrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+var runtimeMetricNames = []string{
+	RuntimeGoroutinesMetricName,
+	RuntimeHeapAllocMetricName,
+	RuntimeHeapInuseMetricName,
+	RuntimeHeapObjectsMetricName,
+	RuntimeSysMetricName,
+	RuntimeNumGCMetricName,
+	RuntimeGCPauseTotalMetricName,
+	RuntimeGCLastPauseMetricName,
+}
+
+func TestWithRuntimeMetrics(t *testing.T) {
+	target := NewManager(logger.NewMockClient(), time.Second*5, nil, WithRuntimeMetrics()).(*manager)
+
+	// Ensure there has been a GC cycle so the GC metrics are set
+	runtime.GC()
+	target.runtimeMetrics.refresh()
+
+	for _, name := range runtimeMetricNames {
+		gauge := target.GetGauge(name)
+		require.NotNil(t, gauge, name)
+		assert.Greater(t, gauge.Value(), int64(0), name)
+
+		// The runtime metrics are reported without being configured in the Telemetry Metrics
+		reportName, enabled := target.overrides.getEnabledMetricName(name, &config.TelemetryInfo{})
+		assert.True(t, enabled, name)
+		assert.Equal(t, name, reportName)
+	}
+}
+
+func TestWithoutRuntimeMetrics(t *testing.T) {
+	target := NewManager(logger.NewMockClient(), time.Second*5, nil).(*manager)
+
+	assert.Nil(t, target.runtimeMetrics)
+	for _, name := range runtimeMetricNames {
+		assert.False(t, target.IsRegistered(name), name)
+	}
+}
+
+func TestManager_Run_RuntimeMetrics(t *testing.T) {
+	mockReporter := &mocks.MetricsReporter{}
+	target := NewManager(logger.NewMockClient(), time.Millisecond*10, mockReporter, WithRuntimeMetrics()).(*manager)
+
+	// Reset the snapshot so that the reported values must come from the refresh on the report tick
+	for _, gauge := range target.runtimeMetrics.gauges {
+		gauge.Update(0)
+	}
+
+	reported := make(chan gometrics.Registry, 10)
+	mockReporter.On("ReportWithContext", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			select {
+			case reported <- args.Get(1).(gometrics.Registry):
+			default:
+			}
+		}).Return(0, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	target.Run(ctx, wg)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	select {
+	case registry := <-reported:
+		for _, name := range []string{RuntimeGoroutinesMetricName, RuntimeHeapAllocMetricName, RuntimeSysMetricName} {
+			gauge, ok := registry.Get(name).(gometrics.Gauge)
+			require.True(t, ok, name)
+			assert.Greater(t, gauge.Value(), int64(0), name)
+		}
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "metrics not reported")
+	}
+}
@@ -0,0 +1,75 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"errors"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const (
+	// DefaultReservoirSize is the reservoir size of the sample used by the Timers created with gometrics.NewTimer
+	DefaultReservoirSize = 1028
+	// DefaultExpDecayAlpha is the alpha of the sample used by the Timers created with gometrics.NewTimer, which biases
+	// the sample to roughly the last 5 minutes of values
+	DefaultExpDecayAlpha = 0.015
+)
+
+// The sample of a Timer or Histogram holds the subset of the recorded values the percentiles, min, max, mean and
+// standard deviation are calculated from, so it determines how well they reflect the service's traffic:
+//
+//   - gometrics.NewExpDecaySample(reservoirSize, alpha) is forward-decaying, so it is biased towards the recent values
+//     and the percentiles follow changes in the traffic. A higher alpha biases it more strongly to the recent values.
+//     This is the sample used by gometrics.NewTimer, with the DefaultReservoirSize and DefaultExpDecayAlpha, but is
+//     more expensive to update as each update takes a lock and maintains a heap.
+//   - gometrics.NewUniformSample(reservoirSize) is a uniform random sample of all the values recorded since the metric
+//     was created, so the percentiles are for the service's lifetime and respond ever more slowly to changes in the
+//     traffic. It is cheaper to update so suits the high-throughput metrics whose distribution is steady.
+//
+// A larger reservoir gives more accurate percentiles, i.e. for the p99 of high-throughput traffic, at the cost of
+// memory and of the time taken to calculate the percentiles each time the metrics are reported.
+
+// RegisterTimerWithSample creates and registers a Timer which records its durations in the sample, so the sample type
+// and reservoir size can be chosen to match the service's traffic. The sample must not be shared with other metrics.
+func (m *manager) RegisterTimerWithSample(name string, sample gometrics.Sample, tags map[string]string) (gometrics.Timer, error) {
+	if sample == nil {
+		return nil, errors.New("timer sample must be set")
+	}
+
+	timer := gometrics.NewCustomTimer(gometrics.NewHistogram(sample), gometrics.NewMeter())
+	if err := m.Register(name, timer, tags); err != nil {
+		timer.Stop()
+		return nil, err
+	}
+
+	return timer, nil
+}
+
+// RegisterHistogramWithSample creates and registers a Histogram which records its values in the sample, so the sample
+// type and reservoir size can be chosen to match the service's traffic. The sample must not be shared with other
+// metrics.
+func (m *manager) RegisterHistogramWithSample(name string, sample gometrics.Sample, tags map[string]string) (gometrics.Histogram, error) {
+	if sample == nil {
+		return nil, errors.New("histogram sample must be set")
+	}
+
+	histogram := gometrics.NewHistogram(sample)
+	if err := m.Register(name, histogram, tags); err != nil {
+		return nil, err
+	}
+
+	return histogram, nil
+}
@@ -0,0 +1,90 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	gometrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_RegisterTimerWithSample(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Sample gometrics.Sample
+	}{
+		{"Uniform", gometrics.NewUniformSample(10)},
+		{"Exponentially decaying", gometrics.NewExpDecaySample(10, DefaultExpDecayAlpha)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			m := NewManager(logger.NewMockClient(), time.Second, NewNullReporter())
+
+			timer, err := m.RegisterTimerWithSample("MyTimer", test.Sample, nil)
+			require.NoError(t, err)
+			defer timer.Stop()
+			assert.Same(t, timer, m.GetTimer("MyTimer"))
+
+			for index := 1; index <= 100; index++ {
+				timer.Update(time.Duration(index) * time.Millisecond)
+			}
+
+			// The durations are recorded in the chosen sample, which is capped at its reservoir size
+			assert.Equal(t, int64(100), timer.Count())
+			assert.Equal(t, int64(100), test.Sample.Count())
+			assert.Equal(t, 10, test.Sample.Size())
+			assert.Equal(t, test.Sample.Max(), timer.Max())
+		})
+	}
+}
+
+func TestManager_RegisterHistogramWithSample(t *testing.T) {
+	m := NewManager(logger.NewMockClient(), time.Second, NewNullReporter())
+	sample := gometrics.NewUniformSample(5)
+
+	histogram, err := m.RegisterHistogramWithSample("MyHistogram", sample, map[string]string{"tag": "value"})
+	require.NoError(t, err)
+	assert.True(t, m.IsRegistered("MyHistogram"))
+
+	for index := int64(1); index <= 20; index++ {
+		histogram.Update(index)
+	}
+
+	assert.Equal(t, int64(20), histogram.Count())
+	assert.Equal(t, int64(20), sample.Count())
+	assert.Equal(t, 5, sample.Size())
+}
+
+func TestManager_RegisterWithSample_Errors(t *testing.T) {
+	m := NewManager(logger.NewMockClient(), time.Second, NewNullReporter())
+
+	_, err := m.RegisterTimerWithSample("MyTimer", nil, nil)
+	assert.Error(t, err)
+	_, err = m.RegisterHistogramWithSample("MyHistogram", nil, nil)
+	assert.Error(t, err)
+	assert.False(t, m.IsRegistered("MyTimer"))
+	assert.False(t, m.IsRegistered("MyHistogram"))
+
+	// Duplicate names are rejected
+	_, err = m.RegisterTimerWithSample("Duplicate", gometrics.NewUniformSample(10), nil)
+	require.NoError(t, err)
+	_, err = m.RegisterTimerWithSample("Duplicate", gometrics.NewUniformSample(10), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,69 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// startTimes holds the time each metric was registered, which is the start of the interval the cumulative values of
+// Counters, Meters, Timers and Histograms are accumulated over. Exporters such as OpenTelemetry need the start time
+// to report the values with the correct (start, end) interval or to convert them to delta temporality. It is safe for
+// concurrent use since the metrics are registered by the service while the reporter reads the start times from the
+// Metrics Manager's Run go routine.
+type startTimes struct {
+	times map[string]time.Time
+	mutex sync.RWMutex
+}
+
+// startTimeReporter is implemented by the reporters which report the start time of the cumulative metrics
+type startTimeReporter interface {
+	setStartTimes(startTimes *startTimes)
+}
+
+func newStartTimes() *startTimes {
+	return &startTimes{
+		times: make(map[string]time.Time),
+	}
+}
+
+func (s *startTimes) set(name string, startTime time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.times[name] = startTime
+}
+
+func (s *startTimes) clear(name string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.times, name)
+}
+
+// get returns the time the named metric was registered and whether it has been registered
+func (s *startTimes) get(name string) (time.Time, bool) {
+	// Reporters not created via a Metrics Manager have no start times
+	if s == nil {
+		return time.Time{}, false
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	startTime, found := s.times[name]
+	return startTime, found
+}
@@ -0,0 +1,71 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// serviceTags holds the service level tags which are swapped atomically when the Telemetry Tags are updated via the
+// Configuration Provider, so the reporter never reads the configured Tags while they are being changed.
+type serviceTags struct {
+	tags  map[string]string
+	isSet bool
+	mutex sync.RWMutex
+}
+
+// taggableReporter is implemented by the reporters which support updating the service level tags at runtime
+type taggableReporter interface {
+	setServiceTags(tags *serviceTags)
+}
+
+func newServiceTags() *serviceTags {
+	return &serviceTags{}
+}
+
+// set swaps the tag set for a copy of the tags and returns the previous tag set
+func (s *serviceTags) set(tags map[string]string) map[string]string {
+	copied := make(map[string]string, len(tags))
+	for name, value := range tags {
+		copied[name] = value
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	previous := s.tags
+	s.tags = copied
+	s.isSet = true
+	return previous
+}
+
+// get returns the service level tags, which are the configured Tags until they have been set
+func (s *serviceTags) get(telemetryConfig *config.TelemetryInfo) map[string]string {
+	// Reporters not created via a Metrics Manager have no runtime tags
+	if s == nil {
+		return telemetryConfig.Tags
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if !s.isSet {
+		return telemetryConfig.Tags
+	}
+
+	return s.tags
+}
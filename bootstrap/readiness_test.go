@@ -0,0 +1,114 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/controller"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/health"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+func TestRunBootstrapHandlers_NotReadyUntilComplete(t *testing.T) {
+	t.Setenv("EDGEX_SECURITY_SECRET_STORE", "false")
+
+	readiness := health.NewReadiness()
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return &mocks.Configuration{}
+		},
+		container.ReadinessInterfaceName: func(get di.Get) interface{} {
+			return readiness
+		},
+	})
+
+	router := echo.New()
+	readyz := func() (int, controller.ReadinessResponse) {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, controller.ReadinessRoute, nil))
+
+		response := controller.ReadinessResponse{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		return recorder.Code, response
+	}
+
+	// The first handler starts serving the readiness probe before the second has registered its readiness check
+	var duringBootstrap int
+	var failures map[string]string
+	httpServerHandler := func(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+		_ = controller.NewCommonController(dic, router, "test-service", "1.0.0")
+		return true
+	}
+	probingHandler := func(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, _ *di.Container) bool {
+		var response controller.ReadinessResponse
+		duringBootstrap, response = readyz()
+		failures = response.Failures
+		return true
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	timer := startup.NewTimer(60, 1)
+	watchdog := startStartupWatchdog(timer.Deadline(), timer.RemainingUntilDeadline(), cancel, logger.NewMockClient())
+
+	handlers := []interfaces.BootstrapHandler{httpServerHandler, probingHandler}
+	require.True(t, runBootstrapHandlers(ctx, cancel, &sync.WaitGroup{}, timer, dic, handlers, watchdog))
+
+	assert.Equal(t, http.StatusServiceUnavailable, duringBootstrap)
+	assert.Contains(t, failures, BootstrapReadinessCheckName)
+
+	afterBootstrap, _ := readyz()
+	assert.Equal(t, http.StatusOK, afterBootstrap)
+}
+
+func TestRunBootstrapHandlers_NotReadyWhenFailed(t *testing.T) {
+	readiness := health.NewReadiness()
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ReadinessInterfaceName: func(get di.Get) interface{} {
+			return readiness
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	timer := startup.NewTimer(60, 1)
+	watchdog := startStartupWatchdog(timer.Deadline(), timer.RemainingUntilDeadline(), cancel, logger.NewMockClient())
+
+	failingHandler := func(context.Context, *sync.WaitGroup, startup.Timer, *di.Container) bool { return false }
+	handlers := []interfaces.BootstrapHandler{succeedingHandler, failingHandler}
+	require.False(t, runBootstrapHandlers(ctx, cancel, &sync.WaitGroup{}, timer, dic, handlers, watchdog))
+
+	assert.Contains(t, readiness.Check(), BootstrapReadinessCheckName)
+}
@@ -0,0 +1,77 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package registration
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-registry/v3/registry"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// NewUnregisterFunc returns the function which un-registers the service from the Registry on shutdown. When the
+// Registry's DrainGracePeriod is set the service is first put in maintenance mode, which fails its health so upstreams
+// stop routing to it, and then the grace period is waited before un-registering. The service is only un-registered
+// once however many times the function is called.
+func NewUnregisterFunc(
+	registryClient registry.Client,
+	serviceConfig interfaces.Configuration,
+	serviceKey string,
+	lc logger.LoggingClient,
+	dic *di.Container) (func(), error) {
+	bootstrapConfig := serviceConfig.GetBootstrap()
+
+	var gracePeriod time.Duration
+	var agent *consulAgent
+	if len(bootstrapConfig.Registry.DrainGracePeriod) > 0 {
+		var err error
+		gracePeriod, err = time.ParseDuration(bootstrapConfig.Registry.DrainGracePeriod)
+		if err != nil || gracePeriod < 0 {
+			return nil, fmt.Errorf("Registry DrainGracePeriod '%s' is invalid", bootstrapConfig.Registry.DrainGracePeriod)
+		}
+
+		if gracePeriod > 0 {
+			agent, err = newConsulAgent(bootstrapConfig, serviceKey, "",
+				newAccessTokenCallback(serviceKey, bootstrapConfig.Registry.Type, lc, dic))
+			if err != nil {
+				return nil, fmt.Errorf("unable to drain service on shutdown: %s", err.Error())
+			}
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			if agent != nil {
+				if err := agent.setMaintenance(true, "draining"); err != nil {
+					lc.Errorf("Unable to mark service as draining in the Registry: %s", err.Error())
+				} else {
+					lc.Infof("Service marked as draining in the Registry, un-registering in %s", gracePeriod.String())
+					time.Sleep(gracePeriod)
+				}
+			}
+
+			lc.Info("Un-Registering service from the Registry")
+			if err := registryClient.Unregister(); err != nil {
+				lc.Error("Unable to Un-Register service from the Registry", "error", err.Error())
+			}
+		})
+	}, nil
+}
@@ -0,0 +1,108 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package registration
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// requestIndex returns the index of the first request to the path, or -1 when there isn't one
+func (c *recordingConsul) requestIndex(path string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for index, request := range c.requests {
+		if request.Path == path {
+			return index
+		}
+	}
+	return -1
+}
+
+func newUnregisterTest(t *testing.T, gracePeriod string) (*recordingConsul, func()) {
+	consul := &recordingConsul{}
+	server := httptest.NewServer(consul)
+	t.Cleanup(server.Close)
+
+	serviceConfig := newRenewalTestConfiguration(t, server.URL, "")
+	serviceConfig.Registry.DrainGracePeriod = gracePeriod
+
+	lc := logger.NewMockClient()
+	dic := di.NewContainer(di.ServiceConstructorMap{})
+
+	registryClient, err := createRegistryClient("unit-test", serviceConfig, lc, dic)
+	require.NoError(t, err)
+
+	unregister, err := NewUnregisterFunc(registryClient, serviceConfig, "unit-test", lc, dic)
+	require.NoError(t, err)
+
+	return consul, unregister
+}
+
+func TestUnregisterFunc_Drain(t *testing.T) {
+	consul, unregister := newUnregisterTest(t, "100ms")
+
+	started := time.Now()
+	unregister()
+	assert.GreaterOrEqual(t, time.Since(started), time.Millisecond*100)
+
+	drainIndex := consul.requestIndex("/v1/agent/service/maintenance/unit-test")
+	deregisterIndex := consul.requestIndex("/v1/agent/service/deregister/unit-test")
+	require.NotEqual(t, -1, drainIndex)
+	require.NotEqual(t, -1, deregisterIndex)
+	assert.Less(t, drainIndex, deregisterIndex, "expected the service to be draining before being un-registered")
+	assert.Equal(t, "enable=true&reason=draining", consul.find("/v1/agent/service/maintenance/unit-test").Query)
+
+	// Only un-registered once
+	unregister()
+	consul.mutex.Lock()
+	count := 0
+	for _, request := range consul.requests {
+		if request.Path == "/v1/agent/service/deregister/unit-test" {
+			count++
+		}
+	}
+	consul.mutex.Unlock()
+	assert.Equal(t, 1, count)
+}
+
+func TestUnregisterFunc_NoGracePeriod(t *testing.T) {
+	for _, gracePeriod := range []string{"", "0s"} {
+		consul, unregister := newUnregisterTest(t, gracePeriod)
+
+		started := time.Now()
+		unregister()
+		assert.Less(t, time.Since(started), time.Millisecond*100)
+
+		assert.Equal(t, -1, consul.requestIndex("/v1/agent/service/maintenance/unit-test"))
+		assert.NotEqual(t, -1, consul.requestIndex("/v1/agent/service/deregister/unit-test"))
+	}
+}
+
+func TestUnregisterFunc_InvalidGracePeriod(t *testing.T) {
+	serviceConfig := newRenewalTestConfiguration(t, "http://localhost:8500", "")
+	serviceConfig.Registry.DrainGracePeriod = "bogus"
+
+	_, err := NewUnregisterFunc(nil, serviceConfig, "unit-test", logger.NewMockClient(), di.NewContainer(nil))
+	require.Error(t, err)
+}
@@ -0,0 +1,222 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package registration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	registryTypes "github.com/edgexfoundry/go-mod-registry/v3/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// The health check types which the service is registered with, see config.ServiceInfo.HealthCheckType
+const (
+	HealthCheckTypeHTTP = "http"
+	HealthCheckTypeTCP  = "tcp"
+	HealthCheckTypeTTL  = "ttl"
+)
+
+const (
+	consulRegistryType = "consul"
+	consulTokenHeader  = "X-Consul-Token"
+)
+
+// consulServiceRegistration is the body of the Consul agent's register service request
+type consulServiceRegistration struct {
+	Name    string
+	Address string
+	Port    int
+	Check   consulCheck
+}
+
+// consulCheck is the health check registered along with the service
+type consulCheck struct {
+	CheckID  string
+	Name     string
+	Notes    string
+	TCP      string `json:",omitempty"`
+	Interval string `json:",omitempty"`
+	TTL      string `json:",omitempty"`
+}
+
+// consulAgent registers the service with the health check types which the Registry client doesn't support, using the
+// Consul agent's API directly, and sends the heartbeats for TTL health checks as the interfaces.RegistryHeartbeat.
+type consulAgent struct {
+	registryUrl    string
+	serviceKey     string
+	serviceHost    string
+	servicePort    int
+	checkType      string
+	checkInterval  string
+	getAccessToken registryTypes.GetAccessTokenCallback
+	accessToken    string
+	httpClient     *http.Client
+	mutex          sync.Mutex
+}
+
+// healthCheckType returns the normalized health check type, defaulting to HTTP when not set
+func healthCheckType(serviceInfo *config.ServiceInfo) (string, error) {
+	checkType := strings.ToLower(strings.TrimSpace(serviceInfo.HealthCheckType))
+	switch checkType {
+	case "", HealthCheckTypeHTTP:
+		return HealthCheckTypeHTTP, nil
+	case HealthCheckTypeTCP, HealthCheckTypeTTL:
+		return checkType, nil
+	default:
+		return "", fmt.Errorf("invalid HealthCheckType '%s', must be '%s', '%s' or '%s'",
+			serviceInfo.HealthCheckType, HealthCheckTypeHTTP, HealthCheckTypeTCP, HealthCheckTypeTTL)
+	}
+}
+
+func newConsulAgent(
+	bootstrapConfig config.BootstrapConfiguration,
+	serviceKey string,
+	checkType string,
+	getAccessToken registryTypes.GetAccessTokenCallback) (*consulAgent, error) {
+	if !strings.EqualFold(bootstrapConfig.Registry.Type, consulRegistryType) {
+		return nil, fmt.Errorf("the '%s' Registry is not supported, only '%s'", bootstrapConfig.Registry.Type, consulRegistryType)
+	}
+
+	return &consulAgent{
+		registryUrl:    fmt.Sprintf("%s://%s:%d", config.DefaultHttpProtocol, bootstrapConfig.Registry.Host, bootstrapConfig.Registry.Port),
+		serviceKey:     serviceKey,
+		serviceHost:    bootstrapConfig.Service.Host,
+		servicePort:    bootstrapConfig.Service.Port,
+		checkType:      checkType,
+		checkInterval:  bootstrapConfig.Service.HealthCheckInterval,
+		getAccessToken: getAccessToken,
+		httpClient:     &http.Client{Timeout: time.Second * 10},
+	}, nil
+}
+
+// register registers the service for discovery along with its TCP or TTL health check
+func (a *consulAgent) register() error {
+	check := consulCheck{
+		CheckID: a.serviceKey,
+		Name:    "Health Check: " + a.serviceKey,
+		Notes:   "Check the health of the service",
+	}
+
+	switch a.checkType {
+	case HealthCheckTypeTCP:
+		check.TCP = net.JoinHostPort(a.serviceHost, strconv.Itoa(a.servicePort))
+		check.Interval = a.checkInterval
+	case HealthCheckTypeTTL:
+		check.TTL = a.checkInterval
+	}
+
+	body, err := json.Marshal(consulServiceRegistration{
+		Name:    a.serviceKey,
+		Address: a.serviceHost,
+		Port:    a.servicePort,
+		Check:   check,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.put("/v1/agent/service/register", body)
+}
+
+// Pass marks the service's TTL health check as passing
+func (a *consulAgent) Pass(note string) error {
+	return a.put("/v1/agent/check/pass/"+url.PathEscape(a.serviceKey)+"?note="+url.QueryEscape(note), nil)
+}
+
+// setMaintenance enables or disables maintenance mode for the service, which fails the service's health regardless
+// of its health check so the Registry stops routing to it.
+func (a *consulAgent) setMaintenance(enable bool, reason string) error {
+	return a.put(fmt.Sprintf("/v1/agent/service/maintenance/%s?enable=%t&reason=%s",
+		url.PathEscape(a.serviceKey), enable, url.QueryEscape(reason)), nil)
+}
+
+// Fail marks the service's TTL health check as failing
+func (a *consulAgent) Fail(note string) error {
+	return a.put("/v1/agent/check/fail/"+url.PathEscape(a.serviceKey)+"?note="+url.QueryEscape(note), nil)
+}
+
+// put sends the request to the Consul agent, retrying once with a new access token when it is forbidden
+func (a *consulAgent) put(path string, body []byte) error {
+	_, err := a.request(http.MethodPut, path, body)
+	return err
+}
+
+// request sends the request to the Consul agent and returns the response's body, retrying once with a new access
+// token when it is forbidden
+func (a *consulAgent) request(method string, path string, body []byte) ([]byte, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if len(a.accessToken) == 0 && a.getAccessToken != nil {
+		token, err := a.getAccessToken()
+		if err != nil {
+			return nil, err
+		}
+		a.accessToken = token
+	}
+
+	statusCode, response, err := a.send(method, path, body)
+	if err == nil || statusCode != http.StatusForbidden || a.getAccessToken == nil {
+		return response, err
+	}
+
+	token, tokenErr := a.getAccessToken()
+	if tokenErr != nil {
+		return nil, fmt.Errorf("%s: %s", err.Error(), tokenErr.Error())
+	}
+	a.accessToken = token
+
+	_, response, err = a.send(method, path, body)
+	return response, err
+}
+
+func (a *consulAgent) send(method string, path string, body []byte) (int, []byte, error) {
+	request, err := http.NewRequest(method, a.registryUrl+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(a.accessToken) > 0 {
+		request.Header.Set(consulTokenHeader, a.accessToken)
+	}
+
+	response, err := a.httpClient.Do(request)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	contents, err := io.ReadAll(response.Body)
+	if err != nil {
+		return response.StatusCode, nil, err
+	}
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return response.StatusCode, nil, fmt.Errorf("request to Registry '%s' failed with status %d: %s", path, response.StatusCode, strings.TrimSpace(string(contents)))
+	}
+
+	return response.StatusCode, contents, nil
+}
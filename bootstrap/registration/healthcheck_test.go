@@ -0,0 +1,185 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package registration
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+type recordedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   []byte
+}
+
+// recordingConsul records the requests made to the Consul agent
+type recordingConsul struct {
+	mutex    sync.Mutex
+	requests []recordedRequest
+}
+
+func (c *recordingConsul) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, _ := io.ReadAll(request.Body)
+
+	c.mutex.Lock()
+	c.requests = append(c.requests, recordedRequest{
+		Method: request.Method,
+		Path:   request.URL.Path,
+		Query:  request.URL.RawQuery,
+		Body:   body,
+	})
+	c.mutex.Unlock()
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+func (c *recordingConsul) find(path string) *recordedRequest {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, request := range c.requests {
+		if request.Path == path {
+			return &request
+		}
+	}
+	return nil
+}
+
+func registerWithHealthCheckType(t *testing.T, checkType string) (*recordingConsul, *di.Container, error) {
+	consul := &recordingConsul{}
+	server := httptest.NewServer(consul)
+	t.Cleanup(server.Close)
+
+	serviceConfig := newRenewalTestConfiguration(t, server.URL, "")
+	serviceConfig.Service.HealthCheckInterval = "10s"
+	serviceConfig.Service.HealthCheckType = checkType
+
+	lc := logger.NewMockClient()
+	dic := di.NewContainer(di.ServiceConstructorMap{})
+
+	_, err := RegisterWithRegistry(context.Background(), startup.NewTimer(1, 1), serviceConfig, lc, "unit-test", dic)
+	return consul, dic, err
+}
+
+func decodeServiceRegistration(t *testing.T, consul *recordingConsul) consulServiceRegistration {
+	request := consul.find("/v1/agent/service/register")
+	require.NotNil(t, request)
+	assert.Equal(t, http.MethodPut, request.Method)
+
+	var registration consulServiceRegistration
+	require.NoError(t, json.Unmarshal(request.Body, &registration))
+	assert.Equal(t, "unit-test", registration.Name)
+	assert.Equal(t, "localhost", registration.Address)
+	assert.Equal(t, 8080, registration.Port)
+	return registration
+}
+
+func TestRegisterWithRegistry_HTTPHealthCheck(t *testing.T) {
+	for _, checkType := range []string{"", "HTTP"} {
+		consul, dic, err := registerWithHealthCheckType(t, checkType)
+		require.NoError(t, err)
+
+		checkRequest := consul.find("/v1/agent/check/register")
+		require.NotNil(t, checkRequest)
+		assert.Contains(t, string(checkRequest.Body), "http://localhost:8080/api/v3/ping")
+		assert.Nil(t, container.RegistryHeartbeatFrom(dic.Get))
+		assert.NotNil(t, container.RegistryInstancesFrom(dic.Get))
+	}
+}
+
+func TestRegisterWithRegistry_TCPHealthCheck(t *testing.T) {
+	consul, dic, err := registerWithHealthCheckType(t, HealthCheckTypeTCP)
+	require.NoError(t, err)
+
+	registration := decodeServiceRegistration(t, consul)
+	assert.Equal(t, "localhost:8080", registration.Check.TCP)
+	assert.Equal(t, "10s", registration.Check.Interval)
+	assert.Empty(t, registration.Check.TTL)
+
+	assert.Nil(t, consul.find("/v1/agent/check/register"))
+	assert.Nil(t, container.RegistryHeartbeatFrom(dic.Get))
+}
+
+func TestRegisterWithRegistry_TTLHealthCheck(t *testing.T) {
+	consul, dic, err := registerWithHealthCheckType(t, HealthCheckTypeTTL)
+	require.NoError(t, err)
+
+	registration := decodeServiceRegistration(t, consul)
+	assert.Equal(t, "10s", registration.Check.TTL)
+	assert.Empty(t, registration.Check.TCP)
+
+	// The initial heartbeat is sent once registered
+	require.NotNil(t, consul.find("/v1/agent/check/pass/unit-test"))
+
+	heartbeat := container.RegistryHeartbeatFrom(dic.Get)
+	require.NotNil(t, heartbeat)
+
+	require.NoError(t, heartbeat.Fail("overloaded"))
+	failRequest := consul.find("/v1/agent/check/fail/unit-test")
+	require.NotNil(t, failRequest)
+	assert.Equal(t, "note=overloaded", failRequest.Query)
+}
+
+func TestRegisterWithRegistry_InvalidHealthCheckType(t *testing.T) {
+	_, _, err := registerWithHealthCheckType(t, "grpc")
+	require.Error(t, err)
+}
+
+func TestConsulAgentReloadsAccessToken(t *testing.T) {
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		token := request.Header.Get(consulTokenHeader)
+		tokens = append(tokens, token)
+		if token != "token-2" {
+			writer.WriteHeader(http.StatusForbidden)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serviceConfig := newRenewalTestConfiguration(t, server.URL, "")
+	serviceConfig.Service.HealthCheckInterval = "10s"
+
+	count := 0
+	getAccessToken := func() (string, error) {
+		count++
+		if count == 1 {
+			return "token-1", nil
+		}
+		return "token-2", nil
+	}
+
+	agent, err := newConsulAgent(serviceConfig.GetBootstrap(), "unit-test", HealthCheckTypeTTL, getAccessToken)
+	require.NoError(t, err)
+
+	require.NoError(t, agent.Pass(""))
+	assert.Equal(t, []string{"token-1", "token-2"}, tokens)
+}
@@ -0,0 +1,67 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package registration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	registryTypes "github.com/edgexfoundry/go-mod-registry/v3/pkg/types"
+)
+
+// consulServiceHealth is an entry of the Consul health service response, one per instance of the service
+type consulServiceHealth struct {
+	Node struct {
+		Address string
+	}
+	Service struct {
+		ID      string
+		Service string
+		Address string
+		Port    int
+	}
+}
+
+// HealthyInstances returns the endpoints of the service's instances which are passing their health checks
+func (a *consulAgent) HealthyInstances(serviceKey string) ([]registryTypes.ServiceEndpoint, error) {
+	response, err := a.request(http.MethodGet, "/v1/health/service/"+url.PathEscape(serviceKey)+"?passing=true", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []consulServiceHealth
+	if err := json.Unmarshal(response, &entries); err != nil {
+		return nil, fmt.Errorf("unable to decode the instances of '%s' from the Registry: %s", serviceKey, err.Error())
+	}
+
+	endpoints := make([]registryTypes.ServiceEndpoint, 0, len(entries))
+	for _, entry := range entries {
+		// The service's address defaults to its node's when it isn't registered with its own
+		host := entry.Service.Address
+		if len(host) == 0 {
+			host = entry.Node.Address
+		}
+
+		endpoints = append(endpoints, registryTypes.ServiceEndpoint{
+			ServiceId: entry.Service.ID,
+			Host:      host,
+			Port:      entry.Service.Port,
+		})
+	}
+
+	return endpoints, nil
+}
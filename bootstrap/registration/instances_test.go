@@ -0,0 +1,58 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package registration
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	registryTypes "github.com/edgexfoundry/go-mod-registry/v3/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsulAgentHealthyInstances(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		assert.Equal(t, "passing=true", request.URL.RawQuery)
+
+		if request.URL.Path != "/v1/health/service/"+common.CoreDataServiceKey {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = writer.Write([]byte(`[
+			{"Node": {"Address": "10.0.0.1"}, "Service": {"ID": "core-data-1", "Service": "core-data", "Address": "core-data-1", "Port": 59880}},
+			{"Node": {"Address": "10.0.0.2"}, "Service": {"ID": "core-data-2", "Service": "core-data", "Address": "", "Port": 59880}}
+		]`))
+	}))
+	defer server.Close()
+
+	serviceConfig := newRenewalTestConfiguration(t, server.URL, "")
+	agent, err := newConsulAgent(serviceConfig.GetBootstrap(), "unit-test", HealthCheckTypeHTTP, nil)
+	require.NoError(t, err)
+
+	instances, err := agent.HealthyInstances(common.CoreDataServiceKey)
+	require.NoError(t, err)
+	assert.Equal(t, []registryTypes.ServiceEndpoint{
+		{ServiceId: "core-data-1", Host: "core-data-1", Port: 59880},
+		{ServiceId: "core-data-2", Host: "10.0.0.2", Port: 59880},
+	}, instances)
+
+	_, err = agent.HealthyInstances(common.CoreMetaDataServiceKey)
+	require.Error(t, err)
+}
@@ -0,0 +1,196 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package registration
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	registryTypes "github.com/edgexfoundry/go-mod-registry/v3/pkg/types"
+	"github.com/edgexfoundry/go-mod-registry/v3/registry"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+const (
+	// KubernetesRegistryType is the Registry Type which discovers the client services from the Kubernetes
+	// Service/Endpoints using the cluster DNS rather than from Consul.
+	KubernetesRegistryType = "kubernetes"
+
+	kubernetesDefaultNamespace = "default"
+	kubernetesPortName         = "http"
+	kubernetesPortProtocol     = "tcp"
+)
+
+// kubernetesNamespaceFile holds the namespace of the pod in which the service is running
+var kubernetesNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// EndpointsSource resolves the host and port at which a client service is reachable
+type EndpointsSource interface {
+	Resolve(serviceKey string) (host string, port int, err error)
+}
+
+// dnsEndpointsSource resolves the client services from the Kubernetes cluster DNS. The port is taken from the Service's
+// SRV record for its 'http' port, falling back to the client's configured port when the Service has no such port.
+type dnsEndpointsSource struct {
+	namespace    string
+	defaultPorts map[string]int
+	lookupSRV    func(service, proto, name string) (string, []*net.SRV, error)
+	lookupHost   func(host string) ([]string, error)
+}
+
+// NewDNSEndpointsSource returns an EndpointsSource which resolves the client services as Kubernetes Services in the
+// namespace using the cluster DNS, i.e. '<serviceKey>.<namespace>.svc'. The default ports are the ports used for the
+// client services whose Service doesn't have an 'http' port.
+func NewDNSEndpointsSource(namespace string, defaultPorts map[string]int) EndpointsSource {
+	return &dnsEndpointsSource{
+		namespace:    namespace,
+		defaultPorts: defaultPorts,
+		lookupSRV:    net.LookupSRV,
+		lookupHost:   net.LookupHost,
+	}
+}
+
+func (s *dnsEndpointsSource) Resolve(serviceKey string) (string, int, error) {
+	host := fmt.Sprintf("%s.%s.svc", serviceKey, s.namespace)
+
+	_, records, err := s.lookupSRV(kubernetesPortName, kubernetesPortProtocol, host)
+	if err == nil && len(records) > 0 {
+		return host, int(records[0].Port), nil
+	}
+
+	port, ok := s.defaultPorts[serviceKey]
+	if !ok || port == 0 {
+		return "", 0, fmt.Errorf("no '%s' port found for Kubernetes Service '%s' and no port configured", kubernetesPortName, host)
+	}
+
+	if _, err := s.lookupHost(host); err != nil {
+		return "", 0, fmt.Errorf("unable to resolve Kubernetes Service '%s': %s", host, err.Error())
+	}
+
+	return host, port, nil
+}
+
+// kubernetesClient is the registry.Client which discovers the client services from Kubernetes. Kubernetes routes to
+// the service's pods itself, so registering, un-registering and health checks are left to the platform.
+type kubernetesClient struct {
+	source      EndpointsSource
+	serviceKeys []string
+}
+
+// NewKubernetesClient returns the registry.Client which resolves the client services from the EndpointsSource. The
+// service keys are the client services returned by GetAllServiceEndpoints.
+func NewKubernetesClient(source EndpointsSource, serviceKeys []string) registry.Client {
+	return &kubernetesClient{
+		source:      source,
+		serviceKeys: serviceKeys,
+	}
+}
+
+// Register does nothing as the service is discovered from its Kubernetes Service
+func (k *kubernetesClient) Register() error {
+	return nil
+}
+
+// Unregister does nothing as the service is discovered from its Kubernetes Service
+func (k *kubernetesClient) Unregister() error {
+	return nil
+}
+
+// RegisterCheck does nothing as Kubernetes checks the service's health using the pod's probes
+func (k *kubernetesClient) RegisterCheck(_ string, _ string, _ string, _ string, _ string) error {
+	return nil
+}
+
+// IsAlive always returns true as the cluster DNS is part of the platform
+func (k *kubernetesClient) IsAlive() bool {
+	return true
+}
+
+func (k *kubernetesClient) GetServiceEndpoint(serviceKey string) (registryTypes.ServiceEndpoint, error) {
+	host, port, err := k.source.Resolve(serviceKey)
+	if err != nil {
+		return registryTypes.ServiceEndpoint{}, err
+	}
+
+	return registryTypes.ServiceEndpoint{
+		ServiceId: serviceKey,
+		Host:      host,
+		Port:      port,
+	}, nil
+}
+
+func (k *kubernetesClient) GetAllServiceEndpoints() ([]registryTypes.ServiceEndpoint, error) {
+	var endpoints []registryTypes.ServiceEndpoint
+	for _, serviceKey := range k.serviceKeys {
+		endpoint, err := k.GetServiceEndpoint(serviceKey)
+		if err != nil {
+			continue
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints, nil
+}
+
+// IsServiceAvailable checks the service resolves, Kubernetes only has ready pods behind a Service's endpoints
+func (k *kubernetesClient) IsServiceAvailable(serviceKey string) (bool, error) {
+	if _, _, err := k.source.Resolve(serviceKey); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// isKubernetesRegistry returns whether the client services are discovered from Kubernetes rather than the Registry
+func isKubernetesRegistry(registryInfo *config.RegistryInfo) bool {
+	return strings.EqualFold(strings.TrimSpace(registryInfo.Type), KubernetesRegistryType)
+}
+
+// createKubernetesClient creates the registry.Client which discovers the service's configured clients from Kubernetes
+func createKubernetesClient(bootstrapConfig config.BootstrapConfiguration, lc logger.LoggingClient) (registry.Client, error) {
+	namespace := bootstrapConfig.Registry.Namespace
+	if len(namespace) == 0 {
+		contents, err := os.ReadFile(kubernetesNamespaceFile)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("unable to read the pod's Kubernetes namespace: %s", err.Error())
+		}
+
+		namespace = strings.TrimSpace(string(contents))
+		if len(namespace) == 0 {
+			namespace = kubernetesDefaultNamespace
+		}
+	}
+
+	defaultPorts := make(map[string]int)
+	var serviceKeys []string
+	if bootstrapConfig.Clients != nil {
+		for serviceKey, clientInfo := range *bootstrapConfig.Clients {
+			if clientInfo == nil {
+				continue
+			}
+			defaultPorts[serviceKey] = clientInfo.Port
+			serviceKeys = append(serviceKeys, serviceKey)
+		}
+	}
+
+	lc.Infof("Using Registry (%s) from namespace '%s'", KubernetesRegistryType, namespace)
+
+	return NewKubernetesClient(NewDNSEndpointsSource(namespace, defaultPorts), serviceKeys), nil
+}
@@ -0,0 +1,181 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package registration
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	registryTypes "github.com/edgexfoundry/go-mod-registry/v3/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// fakeEndpointsSource resolves the service keys to the host:port they're mapped to
+type fakeEndpointsSource map[string]string
+
+func (f fakeEndpointsSource) Resolve(serviceKey string) (string, int, error) {
+	address, ok := f[serviceKey]
+	if !ok {
+		return "", 0, fmt.Errorf("no Kubernetes Service found for '%s'", serviceKey)
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", 0, err
+	}
+
+	portNumber, err := strconv.Atoi(port)
+	return host, portNumber, err
+}
+
+func TestKubernetesClient(t *testing.T) {
+	source := fakeEndpointsSource{
+		common.CoreDataServiceKey:     "core-data.edgex.svc:59880",
+		common.CoreMetaDataServiceKey: "core-metadata.edgex.svc:59881",
+	}
+	client := NewKubernetesClient(source, []string{common.CoreDataServiceKey, common.CoreMetaDataServiceKey, common.CoreCommandServiceKey})
+
+	assert.True(t, client.IsAlive())
+	assert.NoError(t, client.Register())
+	assert.NoError(t, client.RegisterCheck("id", "name", "notes", "url", "10s"))
+	assert.NoError(t, client.Unregister())
+
+	endpoint, err := client.GetServiceEndpoint(common.CoreDataServiceKey)
+	require.NoError(t, err)
+	assert.Equal(t, registryTypes.ServiceEndpoint{ServiceId: common.CoreDataServiceKey, Host: "core-data.edgex.svc", Port: 59880}, endpoint)
+
+	_, err = client.GetServiceEndpoint(common.CoreCommandServiceKey)
+	assert.Error(t, err)
+
+	available, err := client.IsServiceAvailable(common.CoreMetaDataServiceKey)
+	require.NoError(t, err)
+	assert.True(t, available)
+
+	available, err = client.IsServiceAvailable(common.CoreCommandServiceKey)
+	assert.Error(t, err)
+	assert.False(t, available)
+
+	endpoints, err := client.GetAllServiceEndpoints()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []registryTypes.ServiceEndpoint{
+		{ServiceId: common.CoreDataServiceKey, Host: "core-data.edgex.svc", Port: 59880},
+		{ServiceId: common.CoreMetaDataServiceKey, Host: "core-metadata.edgex.svc", Port: 59881},
+	}, endpoints)
+}
+
+func TestDNSEndpointsSource(t *testing.T) {
+	srvPorts := map[string]uint16{"core-data.edgex.svc": 59880}
+	hosts := map[string]bool{"core-data.edgex.svc": true, "core-metadata.edgex.svc": true}
+
+	source := NewDNSEndpointsSource("edgex", map[string]int{
+		common.CoreDataServiceKey:     1,
+		common.CoreMetaDataServiceKey: 59881,
+		common.CoreCommandServiceKey:  59882,
+	}).(*dnsEndpointsSource)
+	source.lookupSRV = func(service, proto, name string) (string, []*net.SRV, error) {
+		assert.Equal(t, "http", service)
+		assert.Equal(t, "tcp", proto)
+		port, ok := srvPorts[name]
+		if !ok {
+			return "", nil, errors.New("no such host")
+		}
+		return "", []*net.SRV{{Target: name, Port: port}}, nil
+	}
+	source.lookupHost = func(host string) ([]string, error) {
+		if !hosts[host] {
+			return nil, errors.New("no such host")
+		}
+		return []string{"10.0.0.1"}, nil
+	}
+
+	tests := []struct {
+		name         string
+		serviceKey   string
+		expectedHost string
+		expectedPort int
+		expectError  bool
+	}{
+		{"valid - port from SRV record", common.CoreDataServiceKey, "core-data.edgex.svc", 59880, false},
+		{"valid - configured port", common.CoreMetaDataServiceKey, "core-metadata.edgex.svc", 59881, false},
+		{"invalid - service not found", common.CoreCommandServiceKey, "", 0, true},
+		{"invalid - no port", common.SupportNotificationsServiceKey, "", 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			host, port, err := source.Resolve(test.serviceKey)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedHost, host)
+			assert.Equal(t, test.expectedPort, port)
+		})
+	}
+}
+
+func TestCreateRegistryClientKubernetes(t *testing.T) {
+	namespaceFile := filepath.Join(t.TempDir(), "namespace")
+	require.NoError(t, os.WriteFile(namespaceFile, []byte("edgex\n"), 0600))
+
+	defaultNamespaceFile := kubernetesNamespaceFile
+	kubernetesNamespaceFile = namespaceFile
+	defer func() { kubernetesNamespaceFile = defaultNamespaceFile }()
+
+	lc := logger.NewMockClient()
+	dic := di.NewContainer(di.ServiceConstructorMap{})
+
+	tests := []struct {
+		name              string
+		namespace         string
+		expectedNamespace string
+	}{
+		{"configured namespace", "edge", "edge"},
+		{"pod's namespace", "", "edgex"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// The Registry host and port aren't needed when discovering from Kubernetes
+			serviceConfig := unitTestConfiguration{
+				Registry: config.RegistryInfo{
+					Type:      "Kubernetes",
+					Namespace: test.namespace,
+				},
+			}
+
+			client, err := createRegistryClient("unit-test", serviceConfig, lc, dic)
+			require.NoError(t, err)
+			require.IsType(t, &kubernetesClient{}, client)
+
+			source, ok := client.(*kubernetesClient).source.(*dnsEndpointsSource)
+			require.True(t, ok)
+			assert.Equal(t, test.expectedNamespace, source.namespace)
+		})
+	}
+}
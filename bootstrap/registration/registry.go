@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
@@ -35,6 +37,32 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 )
 
+// newAccessTokenCallback returns the callback which retrieves a new Registry access token from the SecretProvider.
+// Returns nil when the SecretProvider is not configured to be used, in which case no access token is required.
+func newAccessTokenCallback(
+	serviceKey string,
+	registryType string,
+	lc logger.LoggingClient,
+	dic *di.Container) registryTypes.GetAccessTokenCallback {
+	secretProvider := container.SecretProviderExtFrom(dic.Get)
+	if secretProvider == nil {
+		return nil
+	}
+
+	return func() (string, error) {
+		token, err := secretProvider.GetAccessToken(registryType, serviceKey)
+		if err != nil {
+			return "", fmt.Errorf(
+				"failed to get Registry (%s) access token: %s",
+				registryType,
+				err.Error())
+		}
+
+		lc.Infof("Using Registry access token of length %d", len(token))
+		return token, nil
+	}
+}
+
 // createRegistryClient creates and returns a registry.Client instance.
 func createRegistryClient(
 	serviceKey string,
@@ -43,27 +71,17 @@ func createRegistryClient(
 	dic *di.Container) (registry.Client, error) {
 	bootstrapConfig := serviceConfig.GetBootstrap()
 
+	// Kubernetes discovers the services itself, so there is no Registry to connect to or access token to use
+	if isKubernetesRegistry(bootstrapConfig.Registry) {
+		return createKubernetesClient(bootstrapConfig, lc)
+	}
+
 	var err error
 	var accessToken string
-	var getAccessToken registryTypes.GetAccessTokenCallback
 
 	secretProvider := container.SecretProviderExtFrom(dic.Get)
-	// secretProvider will be nil if not configured to be used. In that case, no access token required.
-	if secretProvider != nil {
-		// Define the callback function to retrieve the Access Token
-		getAccessToken = func() (string, error) {
-			accessToken, err = secretProvider.GetAccessToken(bootstrapConfig.Registry.Type, serviceKey)
-			if err != nil {
-				return "", fmt.Errorf(
-					"failed to get Registry (%s) access token: %s",
-					bootstrapConfig.Registry.Type,
-					err.Error())
-			}
-
-			lc.Infof("Using Registry access token of length %d", len(accessToken))
-			return accessToken, nil
-		}
-
+	getAccessToken := newAccessTokenCallback(serviceKey, bootstrapConfig.Registry.Type, lc, dic)
+	if getAccessToken != nil {
 		accessToken, err = getAccessToken()
 		if err != nil {
 			return nil, err
@@ -91,7 +109,48 @@ func createRegistryClient(
 
 	lc.Info(fmt.Sprintf("Using Registry (%s) from %s", registryConfig.Type, registryConfig.GetRegistryUrl()))
 
-	return registry.NewRegistryClient(registryConfig)
+	client, err := registry.NewRegistryClient(registryConfig)
+	if err != nil || getAccessToken == nil || len(bootstrapConfig.Registry.TokenRenewInterval) == 0 {
+		return client, err
+	}
+
+	interval, err := time.ParseDuration(bootstrapConfig.Registry.TokenRenewInterval)
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("Registry TokenRenewInterval '%s' is invalid", bootstrapConfig.Registry.TokenRenewInterval)
+	}
+
+	// The client is replaced by one created with the renewed access token, see StartAccessTokenRenewal
+	newClient := func(accessToken string) (registry.Client, error) {
+		renewedConfig := registryConfig
+		renewedConfig.AccessToken = accessToken
+		return registry.NewRegistryClient(renewedConfig)
+	}
+
+	return newRenewingClient(client, newClient, getAccessToken, interval), nil
+}
+
+// CheckRegistry creates the Registry client from the configuration and checks the Registry is available, without
+// registering the service. Used to validate the service's configuration and dependencies.
+func CheckRegistry(
+	config interfaces.Configuration,
+	lc logger.LoggingClient,
+	serviceKey string,
+	dic *di.Container) error {
+
+	if _, err := healthCheckType(config.GetBootstrap().Service); err != nil {
+		return err
+	}
+
+	registryClient, err := createRegistryClient(serviceKey, config, lc, dic)
+	if err != nil {
+		return fmt.Errorf("createRegistryClient failed: %v", err.Error())
+	}
+
+	if !registryClient.IsAlive() {
+		return errors.New("registry is not available")
+	}
+
+	return nil
 }
 
 // RegisterWithRegistry connects to the registry and registers the service with the Registry
@@ -103,18 +162,72 @@ func RegisterWithRegistry(
 	serviceKey string,
 	dic *di.Container) (registry.Client, error) {
 
+	bootstrapConfig := config.GetBootstrap()
+	checkType, err := healthCheckType(bootstrapConfig.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	// The Registry client only registers HTTP health checks, so the other types are registered using the agent
+	var agent *consulAgent
+	if checkType != HealthCheckTypeHTTP {
+		if len(bootstrapConfig.Service.HealthCheckInterval) == 0 {
+			return nil, fmt.Errorf("HealthCheckInterval must be set for the '%s' HealthCheckType", checkType)
+		}
+
+		agent, err = newConsulAgent(bootstrapConfig, serviceKey, checkType,
+			newAccessTokenCallback(serviceKey, bootstrapConfig.Registry.Type, lc, dic))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var registryWithRegistry = func(registryClient registry.Client) error {
 		if !registryClient.IsAlive() {
 			return errors.New("registry is not available")
 		}
 
-		if err := registryClient.Register(); err != nil {
+		if agent == nil {
+			err = registryClient.Register()
+		} else {
+			err = agent.register()
+		}
+		if err != nil {
 			return fmt.Errorf("could not register service with Registry: %v", err.Error())
 		}
 
+		if checkType == HealthCheckTypeTTL {
+			// The service is healthy once registered, it then must send the heartbeats on its own cadence
+			if err := agent.Pass("registered"); err != nil {
+				return fmt.Errorf("could not send initial heartbeat to Registry: %v", err.Error())
+			}
+
+			dic.Update(di.ServiceConstructorMap{
+				container.RegistryHeartbeatInterfaceName: func(get di.Get) interface{} {
+					return agent
+				},
+			})
+		}
+
+		lc.Infof("Registered service with the Registry using the '%s' health check", checkType)
 		return nil
 	}
 
+	// Consul can have multiple instances of the client services, which are listed for load balancing across them
+	if strings.EqualFold(bootstrapConfig.Registry.Type, consulRegistryType) {
+		instances, err := newConsulAgent(bootstrapConfig, serviceKey, checkType,
+			newAccessTokenCallback(serviceKey, bootstrapConfig.Registry.Type, lc, dic))
+		if err != nil {
+			return nil, err
+		}
+
+		dic.Update(di.ServiceConstructorMap{
+			container.RegistryInstancesInterfaceName: func(get di.Get) interface{} {
+				return instances
+			},
+		})
+	}
+
 	registryClient, err := createRegistryClient(serviceKey, config, lc, dic)
 	if err != nil {
 		return nil, fmt.Errorf("createRegistryClient failed: %v", err.Error())
@@ -0,0 +1,134 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package registration
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	registryTypes "github.com/edgexfoundry/go-mod-registry/v3/pkg/types"
+	"github.com/edgexfoundry/go-mod-registry/v3/registry"
+)
+
+// renewingClient is a registry.Client which is periodically replaced by a new client using a newly generated access
+// token, so the Registry client doesn't fail once the access token it was created with expires.
+type renewingClient struct {
+	client         registry.Client
+	newClient      func(accessToken string) (registry.Client, error)
+	getAccessToken registryTypes.GetAccessTokenCallback
+	interval       time.Duration
+	mutex          sync.RWMutex
+}
+
+func newRenewingClient(
+	client registry.Client,
+	newClient func(accessToken string) (registry.Client, error),
+	getAccessToken registryTypes.GetAccessTokenCallback,
+	interval time.Duration) *renewingClient {
+	return &renewingClient{
+		client:         client,
+		newClient:      newClient,
+		getAccessToken: getAccessToken,
+		interval:       interval,
+	}
+}
+
+// StartAccessTokenRenewal starts renewing the Registry client's access token, when configured by the Registry's
+// TokenRenewInterval, until the context is cancelled. Failures to renew are logged and retried at the next interval.
+func StartAccessTokenRenewal(ctx context.Context, wg *sync.WaitGroup, registryClient registry.Client, lc logger.LoggingClient) {
+	renewing, ok := registryClient.(*renewingClient)
+	if !ok {
+		return
+	}
+
+	lc.Infof("Renewing Registry access token every %s", renewing.interval.String())
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(renewing.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := renewing.renew(); err != nil {
+					lc.Errorf("failed to renew Registry access token: %s", err.Error())
+					continue
+				}
+				lc.Debug("Registry access token renewed")
+			}
+		}
+	}()
+}
+
+// renew generates a new access token and replaces the client with one using it
+func (r *renewingClient) renew() error {
+	accessToken, err := r.getAccessToken()
+	if err != nil {
+		return err
+	}
+
+	client, err := r.newClient(accessToken)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.client = client
+	return nil
+}
+
+func (r *renewingClient) current() registry.Client {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.client
+}
+
+func (r *renewingClient) Register() error {
+	return r.current().Register()
+}
+
+func (r *renewingClient) Unregister() error {
+	return r.current().Unregister()
+}
+
+func (r *renewingClient) RegisterCheck(id string, name string, notes string, url string, interval string) error {
+	return r.current().RegisterCheck(id, name, notes, url, interval)
+}
+
+func (r *renewingClient) IsAlive() bool {
+	return r.current().IsAlive()
+}
+
+func (r *renewingClient) GetServiceEndpoint(serviceId string) (registryTypes.ServiceEndpoint, error) {
+	return r.current().GetServiceEndpoint(serviceId)
+}
+
+func (r *renewingClient) GetAllServiceEndpoints() ([]registryTypes.ServiceEndpoint, error) {
+	return r.current().GetAllServiceEndpoints()
+}
+
+func (r *renewingClient) IsServiceAvailable(serviceId string) (bool, error) {
+	return r.current().IsServiceAvailable(serviceId)
+}
@@ -0,0 +1,161 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package registration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// mockConsul records the access token used by each request
+type mockConsul struct {
+	mutex  sync.Mutex
+	tokens []string
+}
+
+func (m *mockConsul) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	m.mutex.Lock()
+	m.tokens = append(m.tokens, request.Header.Get("X-Consul-Token"))
+	m.mutex.Unlock()
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+func (m *mockConsul) lastToken() string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if len(m.tokens) == 0 {
+		return ""
+	}
+	return m.tokens[len(m.tokens)-1]
+}
+
+func newRenewalTestConfiguration(t *testing.T, consulUrl string, renewInterval string) unitTestConfiguration {
+	parsed, err := url.Parse(consulUrl)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(parsed.Port())
+	require.NoError(t, err)
+
+	return unitTestConfiguration{
+		Service: config.ServiceInfo{
+			Host: "localhost",
+			Port: 8080,
+		},
+		Registry: config.RegistryInfo{
+			Host:               parsed.Hostname(),
+			Port:               port,
+			Type:               "consul",
+			TokenRenewInterval: renewInterval,
+		},
+	}
+}
+
+func TestAccessTokenRenewal(t *testing.T) {
+	consul := &mockConsul{}
+	server := httptest.NewServer(consul)
+	defer server.Close()
+
+	// The token's TTL, the renewal must occur well within it
+	tokenTTL := time.Millisecond * 500
+
+	var renewals []time.Time
+	var renewalsMutex sync.Mutex
+	secretProvider := &mocks.SecretProviderExt{}
+	secretProvider.On("GetAccessToken", "consul", "unit-test").Return(func(string, string) (string, error) {
+		renewalsMutex.Lock()
+		defer renewalsMutex.Unlock()
+		renewals = append(renewals, time.Now())
+		return fmt.Sprintf("token-%d", len(renewals)), nil
+	}, nil)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.SecretProviderExtName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+	})
+
+	lc := logger.NewMockClient()
+	serviceConfig := newRenewalTestConfiguration(t, server.URL, "50ms")
+	registryClient, err := createRegistryClient("unit-test", serviceConfig, lc, dic)
+	require.NoError(t, err)
+	require.IsType(t, &renewingClient{}, registryClient)
+
+	started := time.Now()
+	require.NoError(t, registryClient.Unregister())
+	assert.Equal(t, "token-1", consul.lastToken())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	StartAccessTokenRenewal(ctx, wg, registryClient, lc)
+
+	require.Eventually(t, func() bool {
+		renewalsMutex.Lock()
+		defer renewalsMutex.Unlock()
+		return len(renewals) >= 3
+	}, time.Second, time.Millisecond*10)
+
+	renewalsMutex.Lock()
+	assert.Less(t, renewals[1].Sub(started), tokenTTL, "expected the first renewal before the token's TTL")
+	renewalsMutex.Unlock()
+
+	// The client uses the renewed token
+	require.NoError(t, registryClient.Unregister())
+	assert.NotEqual(t, "token-1", consul.lastToken())
+
+	// The renewal stops once the context is cancelled
+	cancel()
+	wg.Wait()
+}
+
+func TestAccessTokenRenewal_NotConfigured(t *testing.T) {
+	lc := logger.NewMockClient()
+
+	secretProvider := &mocks.SecretProviderExt{}
+	secretProvider.On("GetAccessToken", "consul", "unit-test").Return("token", nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.SecretProviderExtName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+	})
+
+	registryClient, err := createRegistryClient("unit-test", newRenewalTestConfiguration(t, "http://localhost:8500", ""), lc, dic)
+	require.NoError(t, err)
+	assert.NotEqual(t, fmt.Sprintf("%T", &renewingClient{}), fmt.Sprintf("%T", registryClient))
+
+	// Nothing is started for a client which isn't renewed
+	wg := &sync.WaitGroup{}
+	StartAccessTokenRenewal(context.Background(), wg, registryClient, lc)
+	wg.Wait()
+
+	_, err = createRegistryClient("unit-test", newRenewalTestConfiguration(t, "http://localhost:8500", "bogus"), lc, dic)
+	require.Error(t, err)
+}
@@ -0,0 +1,134 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+const (
+	// AWSSecretNameTypePlaceholder is replaced with the database Type in the secret name template
+	AWSSecretNameTypePlaceholder = "{Type}"
+	// AWSSecretNameNamePlaceholder is replaced with the database Name in the secret name template
+	AWSSecretNameNamePlaceholder = "{Name}"
+	// AWSSecretNameHostPlaceholder is replaced with the database Host in the secret name template
+	AWSSecretNameHostPlaceholder = "{Host}"
+	// AWSSecretNamePortPlaceholder is replaced with the database Port in the secret name template
+	AWSSecretNamePortPlaceholder = "{Port}"
+
+	defaultAWSRequestTimeout = time.Second * 30
+)
+
+// AWSSecretsManagerClient is the subset of the AWS Secrets Manager API used by the AWSCredentialsProvider.
+//
+// No implementation is provided by this module, so the AWS SDK isn't a dependency of every service using it. The
+// service which runs in AWS implements it by wrapping the AWS SDK's secretsmanager.Client, created for the region the
+// provider is configured with from the default credential chain, i.e. the instance or task role, such as:
+//
+//	func (c *secretsManagerClient) GetSecretString(ctx context.Context, _ string, secretName string) (string, error) {
+//		output, err := c.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(secretName)})
+//		if err != nil {
+//			return "", err
+//		}
+//		return aws.ToString(output.SecretString), nil
+//	}
+type AWSSecretsManagerClient interface {
+	// GetSecretString retrieves the current SecretString value for the named secret in the specified region
+	GetSecretString(ctx context.Context, region string, secretName string) (string, error)
+}
+
+// AWSCredentialsProvider implements the CredentialsProvider interface by retrieving the database credentials from
+// AWS Secrets Manager. The secret must be a JSON object with the "username" and "password" keys, which is the format
+// AWS Secrets Manager uses for database credentials.
+//
+// The IAM identity the service runs as requires the following permissions:
+//   - secretsmanager:GetSecretValue on the ARNs of the database secrets, i.e.
+//     arn:aws:secretsmanager:<region>:<account-id>:secret:edgex/*
+//   - kms:Decrypt on the KMS key used to encrypt the secrets, only when a customer managed key is used rather than
+//     the default aws/secretsmanager key
+type AWSCredentialsProvider struct {
+	client             AWSSecretsManagerClient
+	region             string
+	secretNameTemplate string
+	timeout            time.Duration
+}
+
+// NewAWSCredentialsProvider creates a new AWSCredentialsProvider for the region. The secret name template determines
+// the name of the secret retrieved for a database, i.e. "edgex/{Type}/{Name}", where the {Type}, {Name}, {Host} and
+// {Port} placeholders are replaced with the values from the config.Database passed to GetDatabaseCredentials.
+func NewAWSCredentialsProvider(client AWSSecretsManagerClient, region string, secretNameTemplate string) interfaces.CredentialsProvider {
+	return &AWSCredentialsProvider{
+		client:             client,
+		region:             region,
+		secretNameTemplate: secretNameTemplate,
+		timeout:            defaultAWSRequestTimeout,
+	}
+}
+
+// GetDatabaseCredentials retrieves the credentials for the database from AWS Secrets Manager.
+func (p *AWSCredentialsProvider) GetDatabaseCredentials(database config.Database) (config.Credentials, error) {
+	secretName := p.buildSecretName(database)
+	if len(strings.TrimSpace(secretName)) == 0 {
+		return config.Credentials{}, errors.New("AWS secret name is empty, check the secret name template")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	secretString, err := p.client.GetSecretString(ctx, p.region, secretName)
+	if err != nil {
+		return config.Credentials{}, fmt.Errorf("failed to get secret '%s' from AWS Secrets Manager in region '%s': %w", secretName, p.region, err)
+	}
+
+	secretValues := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(secretString), &secretValues); err != nil {
+		return config.Credentials{}, fmt.Errorf("AWS secret '%s' is not a valid JSON object: %w", secretName, err)
+	}
+
+	username, ok := secretValues[UsernameKey].(string)
+	if !ok || len(username) == 0 {
+		return config.Credentials{}, fmt.Errorf("AWS secret '%s' is missing the '%s' value", secretName, UsernameKey)
+	}
+
+	password, ok := secretValues[PasswordKey].(string)
+	if !ok || len(password) == 0 {
+		return config.Credentials{}, fmt.Errorf("AWS secret '%s' is missing the '%s' value", secretName, PasswordKey)
+	}
+
+	return config.Credentials{
+		Username: username,
+		Password: password,
+	}, nil
+}
+
+func (p *AWSCredentialsProvider) buildSecretName(database config.Database) string {
+	replacer := strings.NewReplacer(
+		AWSSecretNameTypePlaceholder, database.Type,
+		AWSSecretNameNamePlaceholder, database.Name,
+		AWSSecretNameHostPlaceholder, database.Host,
+		AWSSecretNamePortPlaceholder, strconv.Itoa(database.Port),
+	)
+
+	return replacer.Replace(p.secretNameTemplate)
+}
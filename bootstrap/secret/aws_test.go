@@ -0,0 +1,86 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+type mockAWSSecretsManagerClient struct {
+	mock.Mock
+}
+
+func (m *mockAWSSecretsManagerClient) GetSecretString(ctx context.Context, region string, secretName string) (string, error) {
+	ret := m.Called(ctx, region, secretName)
+	return ret.String(0), ret.Error(1)
+}
+
+func TestAWSCredentialsProvider_GetDatabaseCredentials(t *testing.T) {
+	region := "us-east-1"
+	database := config.Database{
+		Type: "postgres",
+		Host: "localhost",
+		Port: 5432,
+		Name: "core-data",
+	}
+
+	tests := []struct {
+		Name               string
+		Template           string
+		ExpectedSecretName string
+		SecretString       string
+		ClientError        error
+		Expected           config.Credentials
+		ExpectedError      string
+	}{
+		{"Valid", "edgex/{Type}/{Name}", "edgex/postgres/core-data", `{"username":"edgex","password":"secret","engine":"postgres"}`, nil, config.Credentials{Username: "edgex", Password: "secret"}, ""},
+		{"Valid - host and port", "{Host}-{Port}", "localhost-5432", `{"username":"edgex","password":"secret"}`, nil, config.Credentials{Username: "edgex", Password: "secret"}, ""},
+		{"Empty secret name", " ", "", "", nil, config.Credentials{}, "secret name is empty"},
+		{"Client error", "edgex/{Name}", "edgex/core-data", "", errors.New("AccessDeniedException"), config.Credentials{}, "AccessDeniedException"},
+		{"Invalid JSON", "edgex/{Name}", "edgex/core-data", "not-json", nil, config.Credentials{}, "not a valid JSON object"},
+		{"Missing username", "edgex/{Name}", "edgex/core-data", `{"password":"secret"}`, nil, config.Credentials{}, "missing the 'username' value"},
+		{"Missing password", "edgex/{Name}", "edgex/core-data", `{"username":"edgex"}`, nil, config.Credentials{}, "missing the 'password' value"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mockClient := &mockAWSSecretsManagerClient{}
+			if len(test.ExpectedSecretName) > 0 {
+				mockClient.On("GetSecretString", mock.Anything, region, test.ExpectedSecretName).Return(test.SecretString, test.ClientError)
+			}
+
+			target := NewAWSCredentialsProvider(mockClient, region, test.Template)
+			actual, err := target.GetDatabaseCredentials(database)
+
+			if len(test.ExpectedError) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.ExpectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.Expected, actual)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,426 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	gometrics "github.com/rcrowley/go-metrics"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+)
+
+const (
+	// AzureSecretNameTag is the Key Vault secret tag which holds the EdgeX secret name the Key Vault secret is mapped
+	// from, since the mapping to a Key Vault secret name is not reversible
+	AzureSecretNameTag = "edgex-secret-name"
+	// AzureKeyVaultSecretStoreType is the SecretStore Type which selects the AzureKeyVaultProvider. The vault URL is
+	// "https://" followed by the SecretStore Host, i.e. "my-vault.vault.azure.net", and the StoreName is used as the
+	// secret name prefix.
+	AzureKeyVaultSecretStoreType = "azurekeyvault"
+
+	defaultAzureRequestTimeout = time.Second * 30
+	// azureMaxSecretNameLength is the maximum length of a Key Vault secret name
+	azureMaxSecretNameLength = 127
+)
+
+// ErrAzureKeyVaultSecretNotFound must be returned, or wrapped, by the AzureKeyVaultClient when the requested secret
+// doesn't exist in the Key Vault, i.e. the SDK returned a ResponseError with the 404 status code.
+var ErrAzureKeyVaultSecretNotFound = errors.New("secret not found in Azure Key Vault")
+
+// ErrAzureKeyVaultSecretNameCollision is returned when the Key Vault secret an EdgeX secret name maps to holds a
+// different EdgeX secret, i.e. "mqtt/bus" and "mqtt-bus" both map to the same Key Vault secret name.
+var ErrAzureKeyVaultSecretNameCollision = errors.New("Azure Key Vault secret holds a different EdgeX secret")
+
+// azureInvalidNameCharsRegex matches the characters which aren't allowed in a Key Vault secret name
+var azureInvalidNameCharsRegex = regexp.MustCompile(`[^0-9a-zA-Z-]+`)
+
+// AzureKeyVaultSecret is a Key Vault secret's value and the properties used by the AzureKeyVaultProvider
+type AzureKeyVaultSecret struct {
+	// Name is the Key Vault secret name
+	Name string
+	// Value is the value of the latest version of the secret
+	Value string
+	// Tags are the secret's tags
+	Tags map[string]string
+	// Updated is the time the secret was last updated
+	Updated time.Time
+}
+
+// AzureKeyVaultClient is the subset of the Azure Key Vault secrets API used by the AzureKeyVaultProvider, which is
+// implemented using the Azure SDK by the client created with NewAzureKeyVaultClient.
+type AzureKeyVaultClient interface {
+	// GetSecret retrieves the latest version of the named secret, returning ErrAzureKeyVaultSecretNotFound if the
+	// secret doesn't exist
+	GetSecret(ctx context.Context, name string) (AzureKeyVaultSecret, error)
+	// SetSecret creates a new version of the named secret with the value and tags
+	SetSecret(ctx context.Context, name string, value string, tags map[string]string) (AzureKeyVaultSecret, error)
+	// ListSecrets lists the properties, i.e. everything but the Value, of all the secrets in the vault
+	ListSecrets(ctx context.Context) ([]AzureKeyVaultSecret, error)
+}
+
+// AzureKeyVaultProvider implements the SecretProvider interface backed by Azure Key Vault. Each EdgeX secret, which
+// is a set of key/value pairs, is stored as a single Key Vault secret whose value is the JSON object of the pairs.
+// The Key Vault secret name is the service's secret name prefix followed by the EdgeX secret name, with any
+// characters not allowed in a Key Vault secret name replaced by '-', i.e. the "redisdb" secret for core-data is
+// stored as "core-data-redisdb". The EdgeX secret name is kept in the AzureSecretNameTag tag, since different EdgeX
+// secret names may map to the same Key Vault secret name. A Key Vault secret tagged with a different EdgeX secret name
+// is never read or overwritten, while an untagged one, i.e. created directly in the vault, is taken as is.
+//
+// The identity the service runs as requires the "Key Vault Secrets Officer" role on the vault, or the get, set and
+// list secret permissions when the vault uses access policies.
+type AzureKeyVaultProvider struct {
+	lc                        logger.LoggingClient
+	client                    AzureKeyVaultClient
+	secretNamePrefix          string
+	timeout                   time.Duration
+	lastUpdated               time.Time
+	registeredSecretCallbacks map[string]func(secretName string)
+	securitySecretsRequested  gometrics.Counter
+	securitySecretsStored     gometrics.Counter
+	httpRoundTripper          http.RoundTripper
+	zeroTrustEnabled          bool
+	mutex                     sync.RWMutex
+}
+
+// NewAzureKeyVaultProvider creates a new AzureKeyVaultProvider. The secret name prefix, typically the service key,
+// separates the secrets of the services sharing the vault and may be empty when each service has its own vault.
+func NewAzureKeyVaultProvider(lc logger.LoggingClient, client AzureKeyVaultClient, secretNamePrefix string) *AzureKeyVaultProvider {
+	return &AzureKeyVaultProvider{
+		lc:                        lc,
+		client:                    client,
+		secretNamePrefix:          secretNamePrefix,
+		timeout:                   defaultAzureRequestTimeout,
+		lastUpdated:               time.Now(),
+		registeredSecretCallbacks: make(map[string]func(secretName string)),
+		securitySecretsRequested:  gometrics.NewCounter(),
+		securitySecretsStored:     gometrics.NewCounter(),
+		httpRoundTripper:          http.DefaultTransport,
+	}
+}
+
+// Ensure the AzureKeyVaultProvider satisfies the SecretProviderExt contract
+var _ interfaces.SecretProviderExt = (*AzureKeyVaultProvider)(nil)
+
+// GetSecret retrieves the secrets at the secretName from Azure Key Vault. If no keys are provided then all the keys
+// associated with the specified secretName will be returned.
+func (p *AzureKeyVaultProvider) GetSecret(secretName string, keys ...string) (map[string]string, error) {
+	vaultName, err := p.vaultSecretName(secretName)
+	if err != nil {
+		return nil, err
+	}
+
+	p.securitySecretsRequested.Inc(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	vaultSecret, err := p.getVaultSecret(ctx, secretName, vaultName)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string]string)
+	if err := json.Unmarshal([]byte(vaultSecret.Value), &secrets); err != nil {
+		return nil, fmt.Errorf("Azure Key Vault secret '%s' is not a valid JSON object of strings: %w", vaultName, err)
+	}
+
+	// The secret may have been updated outside of this provider, i.e. rotated in the vault
+	p.updateLastUpdated(vaultSecret.Updated)
+
+	if len(keys) == 0 {
+		return secrets, nil
+	}
+
+	results := make(map[string]string, len(keys))
+	var missingKeys []string
+	for _, key := range keys {
+		value, exists := secrets[key]
+		if !exists {
+			missingKeys = append(missingKeys, key)
+			continue
+		}
+		results[key] = value
+	}
+
+	if len(missingKeys) > 0 {
+		return nil, fmt.Errorf("no value for the keys: [%s] exists in secret '%s'", strings.Join(missingKeys, ","), secretName)
+	}
+
+	return results, nil
+}
+
+// GetSecrets retrieves all the keys of the secrets at each of the secretNames from Azure Key Vault, which has no batch
+// API so they are retrieved in turn. The secrets which were retrieved are returned along with an error aggregating
+// the failures, if any.
+func (p *AzureKeyVaultProvider) GetSecrets(secretNames ...string) (map[string]map[string]string, error) {
+	return getSecretsSequentially(p.GetSecret, secretNames)
+}
+
+// StoreSecret stores the secrets at the secretName in Azure Key Vault as a new version of the Key Vault secret,
+// replacing all the previously stored keys. Storing fails if the Key Vault secret holds a different EdgeX secret.
+func (p *AzureKeyVaultProvider) StoreSecret(secretName string, secrets map[string]string) error {
+	vaultName, err := p.vaultSecretName(secretName)
+	if err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(secrets)
+	if err != nil {
+		return fmt.Errorf("failed to marshal secret '%s': %w", secretName, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	if _, err := p.getVaultSecret(ctx, secretName, vaultName); err != nil && !errors.Is(err, ErrAzureKeyVaultSecretNotFound) {
+		return err
+	}
+
+	vaultSecret, err := p.client.SetSecret(ctx, vaultName, string(value), map[string]string{AzureSecretNameTag: secretName})
+	if err != nil {
+		return fmt.Errorf("failed to store secret '%s' in Azure Key Vault secret '%s': %w", secretName, vaultName, err)
+	}
+
+	p.securitySecretsStored.Inc(1)
+
+	updated := vaultSecret.Updated
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	p.updateLastUpdated(updated)
+	p.invokeSecretUpdatedCallback(secretName)
+
+	return nil
+}
+
+// SecretsLastUpdated returns the last time secrets were updated, either stored via this provider or found to have
+// been updated in the vault when retrieved.
+func (p *AzureKeyVaultProvider) SecretsLastUpdated() time.Time {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.lastUpdated
+}
+
+// SecretsUpdated sets the secrets last updated time to the current time.
+func (p *AzureKeyVaultProvider) SecretsUpdated() {
+	p.updateLastUpdated(time.Now())
+}
+
+// GetAccessToken returns an empty access token since Azure Key Vault doesn't issue access tokens for other services.
+func (p *AzureKeyVaultProvider) GetAccessToken(_ string, _ string) (string, error) {
+	return "", nil
+}
+
+// ListSecretNames returns the names of the EdgeX secrets stored in Azure Key Vault for the secret name prefix.
+func (p *AzureKeyVaultProvider) ListSecretNames() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	vaultSecrets, err := p.client.ListSecrets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets in Azure Key Vault: %w", err)
+	}
+
+	var results []string
+	for _, vaultSecret := range vaultSecrets {
+		secretName, ok := vaultSecret.Tags[AzureSecretNameTag]
+		if !ok {
+			continue
+		}
+
+		// Only the secrets mapped from this provider's prefix belong to the service
+		if vaultName, err := p.vaultSecretName(secretName); err != nil || vaultName != vaultSecret.Name {
+			continue
+		}
+
+		results = append(results, secretName)
+	}
+
+	return results, nil
+}
+
+// HasSecret returns true if Azure Key Vault contains a secret for the secretName.
+func (p *AzureKeyVaultProvider) HasSecret(secretName string) (bool, error) {
+	vaultName, err := p.vaultSecretName(secretName)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	_, err = p.getVaultSecret(ctx, secretName, vaultName)
+	if errors.Is(err, ErrAzureKeyVaultSecretNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RegisterSecretUpdatedCallback registers a callback for a secret. If you specify secret.WildcardName
+// as the secretName, then the callback will be called for any updated secret. Callbacks set for a specific
+// secretName are given a higher precedence over wildcard ones, and will be called instead of the wildcard one
+// if both are present.
+func (p *AzureKeyVaultProvider) RegisterSecretUpdatedCallback(secretName string, callback func(secretName string)) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, ok := p.registeredSecretCallbacks[secretName]; ok {
+		return fmt.Errorf("there is a callback already registered for secretName '%v'", secretName)
+	}
+
+	p.registeredSecretCallbacks[secretName] = callback
+	return nil
+}
+
+// DeregisterSecretUpdatedCallback removes a secret's registered callback secretName.
+func (p *AzureKeyVaultProvider) DeregisterSecretUpdatedCallback(secretName string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	delete(p.registeredSecretCallbacks, secretName)
+}
+
+// SecretUpdatedAtSecretName performs updates and callbacks for an updated secret or secretName.
+func (p *AzureKeyVaultProvider) SecretUpdatedAtSecretName(secretName string) {
+	p.securitySecretsStored.Inc(1)
+	p.updateLastUpdated(time.Now())
+	p.invokeSecretUpdatedCallback(secretName)
+}
+
+// RegisterSealStateChangedCallback does nothing since Azure Key Vault has no seal state, so the callback is never
+// called.
+func (p *AzureKeyVaultProvider) RegisterSealStateChangedCallback(_ func(sealed bool)) error {
+	return nil
+}
+
+// GetMetricsToRegister returns all metric objects that needs to be registered.
+func (p *AzureKeyVaultProvider) GetMetricsToRegister() map[string]interface{} {
+	return map[string]interface{}{
+		secretsRequestedMetricName: p.securitySecretsRequested,
+		secretsStoredMetricName:    p.securitySecretsStored,
+	}
+}
+
+// GetSelfJWT returns an empty JWT since Azure Key Vault doesn't issue identity-based secret store tokens
+func (p *AzureKeyVaultProvider) GetSelfJWT() (string, error) {
+	return "", nil
+}
+
+// IsJWTValid always reports the JWT as valid, the same as when security is disabled, since Azure Key Vault has no
+// identity-based secret store tokens to validate the JWT against
+func (p *AzureKeyVaultProvider) IsJWTValid(_ string) (bool, error) {
+	return true, nil
+}
+
+func (p *AzureKeyVaultProvider) HttpTransport() http.RoundTripper {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.httpRoundTripper
+}
+
+func (p *AzureKeyVaultProvider) SetHttpTransport(rt http.RoundTripper) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.httpRoundTripper = rt
+}
+
+func (p *AzureKeyVaultProvider) IsZeroTrustEnabled() bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.zeroTrustEnabled
+}
+
+func (p *AzureKeyVaultProvider) EnableZeroTrust() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.zeroTrustEnabled = true
+}
+
+// vaultSecretName maps the EdgeX secret name to the Key Vault secret name
+func (p *AzureKeyVaultProvider) vaultSecretName(secretName string) (string, error) {
+	name := secretName
+	if len(p.secretNamePrefix) > 0 {
+		name = p.secretNamePrefix + "-" + secretName
+	}
+
+	name = strings.Trim(azureInvalidNameCharsRegex.ReplaceAllString(name, "-"), "-")
+	if len(name) == 0 {
+		return "", fmt.Errorf("secret name '%s' can not be mapped to an Azure Key Vault secret name", secretName)
+	}
+	if len(name) > azureMaxSecretNameLength {
+		return "", fmt.Errorf("Azure Key Vault secret name '%s' for secret '%s' exceeds the maximum length of %d", name, secretName, azureMaxSecretNameLength)
+	}
+
+	return name, nil
+}
+
+// getVaultSecret retrieves the Key Vault secret the secretName maps to, failing if it holds a different EdgeX secret
+func (p *AzureKeyVaultProvider) getVaultSecret(ctx context.Context, secretName string, vaultName string) (AzureKeyVaultSecret, error) {
+	vaultSecret, err := p.client.GetSecret(ctx, vaultName)
+	if err != nil {
+		return AzureKeyVaultSecret{}, fmt.Errorf("failed to get secret '%s' from Azure Key Vault secret '%s': %w", secretName, vaultName, err)
+	}
+
+	if taggedName, ok := vaultSecret.Tags[AzureSecretNameTag]; ok && taggedName != secretName {
+		return AzureKeyVaultSecret{}, fmt.Errorf("secret '%s' maps to Azure Key Vault secret '%s' which holds secret '%s': %w",
+			secretName, vaultName, taggedName, ErrAzureKeyVaultSecretNameCollision)
+	}
+
+	return vaultSecret, nil
+}
+
+// updateLastUpdated advances the last updated time if the updated time is later
+func (p *AzureKeyVaultProvider) updateLastUpdated(updated time.Time) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if updated.After(p.lastUpdated) {
+		p.lastUpdated = updated
+	}
+}
+
+// invokeSecretUpdatedCallback calls the callback registered for the secretName, or the wildcard callback if none
+func (p *AzureKeyVaultProvider) invokeSecretUpdatedCallback(secretName string) {
+	p.mutex.RLock()
+	callback, ok := p.registeredSecretCallbacks[secretName]
+	if !ok {
+		callback, ok = p.registeredSecretCallbacks[WildcardName]
+	}
+	p.mutex.RUnlock()
+
+	if ok {
+		p.lc.Debugf("invoking callback registered for secretName: '%s'", secretName)
+		callback(secretName)
+	}
+}
@@ -0,0 +1,203 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAzureKeyVaultClient struct {
+	mock.Mock
+}
+
+func (m *mockAzureKeyVaultClient) GetSecret(ctx context.Context, name string) (AzureKeyVaultSecret, error) {
+	ret := m.Called(ctx, name)
+	return ret.Get(0).(AzureKeyVaultSecret), ret.Error(1)
+}
+
+func (m *mockAzureKeyVaultClient) SetSecret(ctx context.Context, name string, value string, tags map[string]string) (AzureKeyVaultSecret, error) {
+	ret := m.Called(ctx, name, value, tags)
+	return ret.Get(0).(AzureKeyVaultSecret), ret.Error(1)
+}
+
+func (m *mockAzureKeyVaultClient) ListSecrets(ctx context.Context) ([]AzureKeyVaultSecret, error) {
+	ret := m.Called(ctx)
+	return ret.Get(0).([]AzureKeyVaultSecret), ret.Error(1)
+}
+
+func TestAzureKeyVaultProvider_GetSecret(t *testing.T) {
+	vaultSecret := AzureKeyVaultSecret{
+		Name:  "core-data-redisdb",
+		Value: `{"username":"edgex","password":"secret"}`,
+	}
+
+	tests := []struct {
+		Name          string
+		SecretName    string
+		Keys          []string
+		VaultName     string
+		VaultSecret   AzureKeyVaultSecret
+		ClientError   error
+		Expected      map[string]string
+		ExpectedError string
+	}{
+		{"Valid - all keys", "redisdb", nil, "core-data-redisdb", vaultSecret, nil, map[string]string{"username": "edgex", "password": "secret"}, ""},
+		{"Valid - some keys", "redisdb", []string{"password"}, "core-data-redisdb", vaultSecret, nil, map[string]string{"password": "secret"}, ""},
+		{"Valid - path mapped to name", "mqtt/bus_credentials", nil, "core-data-mqtt-bus-credentials", vaultSecret, nil, map[string]string{"username": "edgex", "password": "secret"}, ""},
+		{"Missing keys", "redisdb", []string{"password", "token"}, "core-data-redisdb", vaultSecret, nil, nil, "no value for the keys: [token]"},
+		{"Not found", "redisdb", nil, "core-data-redisdb", AzureKeyVaultSecret{}, ErrAzureKeyVaultSecretNotFound, nil, "secret not found in Azure Key Vault"},
+		{"Invalid JSON", "redisdb", nil, "core-data-redisdb", AzureKeyVaultSecret{Value: "not-json"}, nil, nil, "not a valid JSON object"},
+		{"Valid - tagged", "mqtt/bus", nil, "core-data-mqtt-bus", AzureKeyVaultSecret{Value: "{}", Tags: map[string]string{AzureSecretNameTag: "mqtt/bus"}}, nil, map[string]string{}, ""},
+		{"Name collision", "mqtt_bus", nil, "core-data-mqtt-bus", AzureKeyVaultSecret{Value: "{}", Tags: map[string]string{AzureSecretNameTag: "mqtt/bus"}}, nil, nil, "holds secret 'mqtt/bus'"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			client := &mockAzureKeyVaultClient{}
+			client.On("GetSecret", mock.Anything, test.VaultName).Return(test.VaultSecret, test.ClientError)
+			target := NewAzureKeyVaultProvider(logger.NewMockClient(), client, "core-data")
+
+			actual, err := target.GetSecret(test.SecretName, test.Keys...)
+			client.AssertExpectations(t)
+			if len(test.ExpectedError) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.ExpectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.Expected, actual)
+		})
+	}
+}
+
+func TestAzureKeyVaultProvider_StoreSecret(t *testing.T) {
+	updated := time.Now().Add(time.Minute)
+	client := &mockAzureKeyVaultClient{}
+	client.On("GetSecret", mock.Anything, "core-data-redisdb").Return(AzureKeyVaultSecret{}, ErrAzureKeyVaultSecretNotFound)
+	client.On("GetSecret", mock.Anything, "core-data-mqtt").Return(AzureKeyVaultSecret{Tags: map[string]string{AzureSecretNameTag: "mqtt"}}, nil)
+	client.On("GetSecret", mock.Anything, "core-data-mqtt-bus").Return(AzureKeyVaultSecret{Tags: map[string]string{AzureSecretNameTag: "mqtt/bus"}}, nil)
+	client.On("GetSecret", mock.Anything, "core-data-denied").Return(AzureKeyVaultSecret{}, errors.New("Forbidden"))
+	client.On("SetSecret", mock.Anything, "core-data-redisdb", `{"password":"secret","username":"edgex"}`,
+		map[string]string{AzureSecretNameTag: "redisdb"}).Return(AzureKeyVaultSecret{Name: "core-data-redisdb", Updated: updated}, nil)
+	client.On("SetSecret", mock.Anything, "core-data-mqtt", mock.Anything, mock.Anything).Return(AzureKeyVaultSecret{}, errors.New("Forbidden"))
+
+	target := NewAzureKeyVaultProvider(logger.NewMockClient(), client, "core-data")
+
+	var updatedSecretNames []string
+	require.NoError(t, target.RegisterSecretUpdatedCallback(WildcardName, func(secretName string) {
+		updatedSecretNames = append(updatedSecretNames, secretName)
+	}))
+
+	err := target.StoreSecret("redisdb", map[string]string{"username": "edgex", "password": "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"redisdb"}, updatedSecretNames)
+	assert.Equal(t, updated, target.SecretsLastUpdated())
+
+	err = target.StoreSecret("mqtt", map[string]string{"username": "edgex"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Forbidden")
+	assert.Len(t, updatedSecretNames, 1, "callback should not be called when storing fails")
+
+	// The Key Vault secret holding a different EdgeX secret is not overwritten
+	err = target.StoreSecret("mqtt-bus", map[string]string{"username": "edgex"})
+	require.ErrorIs(t, err, ErrAzureKeyVaultSecretNameCollision)
+
+	err = target.StoreSecret("denied", map[string]string{"username": "edgex"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Forbidden")
+
+	assert.Len(t, updatedSecretNames, 1, "callback should not be called when storing fails")
+	client.AssertExpectations(t)
+	client.AssertNumberOfCalls(t, "SetSecret", 2)
+}
+
+func TestAzureKeyVaultProvider_SecretsLastUpdated(t *testing.T) {
+	client := &mockAzureKeyVaultClient{}
+	target := NewAzureKeyVaultProvider(logger.NewMockClient(), client, "")
+	created := target.SecretsLastUpdated()
+	assert.False(t, created.IsZero())
+
+	// An older secret doesn't move the last updated time back
+	client.On("GetSecret", mock.Anything, "old").Return(AzureKeyVaultSecret{Value: "{}", Updated: created.Add(-time.Hour)}, nil)
+	_, err := target.GetSecret("old")
+	require.NoError(t, err)
+	assert.Equal(t, created, target.SecretsLastUpdated())
+
+	// A secret updated in the vault since, i.e. rotated, advances the last updated time
+	rotated := created.Add(time.Hour)
+	client.On("GetSecret", mock.Anything, "rotated").Return(AzureKeyVaultSecret{Value: "{}", Updated: rotated}, nil)
+	_, err = target.GetSecret("rotated")
+	require.NoError(t, err)
+	assert.Equal(t, rotated, target.SecretsLastUpdated())
+}
+
+func TestAzureKeyVaultProvider_HasSecret(t *testing.T) {
+	client := &mockAzureKeyVaultClient{}
+	client.On("GetSecret", mock.Anything, "core-data-redisdb").Return(AzureKeyVaultSecret{Value: "{}"}, nil)
+	client.On("GetSecret", mock.Anything, "core-data-missing").Return(AzureKeyVaultSecret{}, fmt.Errorf("404: %w", ErrAzureKeyVaultSecretNotFound))
+	client.On("GetSecret", mock.Anything, "core-data-denied").Return(AzureKeyVaultSecret{}, errors.New("Forbidden"))
+	client.On("GetSecret", mock.Anything, "core-data-mqtt-bus").Return(AzureKeyVaultSecret{Tags: map[string]string{AzureSecretNameTag: "mqtt/bus"}}, nil)
+	target := NewAzureKeyVaultProvider(logger.NewMockClient(), client, "core-data")
+
+	exists, err := target.HasSecret("redisdb")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = target.HasSecret("missing")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = target.HasSecret("denied")
+	require.Error(t, err)
+
+	_, err = target.HasSecret("mqtt_bus")
+	require.ErrorIs(t, err, ErrAzureKeyVaultSecretNameCollision)
+}
+
+func TestAzureKeyVaultProvider_ListSecretNames(t *testing.T) {
+	client := &mockAzureKeyVaultClient{}
+	client.On("ListSecrets", mock.Anything).Return([]AzureKeyVaultSecret{
+		{Name: "core-data-redisdb", Tags: map[string]string{AzureSecretNameTag: "redisdb"}},
+		{Name: "core-data-mqtt-bus", Tags: map[string]string{AzureSecretNameTag: "mqtt/bus"}},
+		{Name: "core-metadata-redisdb", Tags: map[string]string{AzureSecretNameTag: "redisdb"}},
+		{Name: "unrelated"},
+	}, nil)
+	target := NewAzureKeyVaultProvider(logger.NewMockClient(), client, "core-data")
+
+	actual, err := target.ListSecretNames()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"redisdb", "mqtt/bus"}, actual)
+}
+
+func TestAzureKeyVaultProvider_VaultSecretName(t *testing.T) {
+	target := NewAzureKeyVaultProvider(logger.NewMockClient(), &mockAzureKeyVaultClient{}, "")
+
+	_, err := target.vaultSecretName("///")
+	require.Error(t, err)
+
+	name, err := target.vaultSecretName("redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, "redisdb", name)
+}
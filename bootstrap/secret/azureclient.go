@@ -0,0 +1,175 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+)
+
+// azureKeyVaultClient implements the AzureKeyVaultClient interface using the Azure SDK's azsecrets.Client
+type azureKeyVaultClient struct {
+	client *azsecrets.Client
+}
+
+// NewAzureKeyVaultClient creates an AzureKeyVaultClient for the vault at the vault URL, i.e.
+// "https://my-vault.vault.azure.net/". The client authenticates as the service's managed identity when running in
+// Azure, falling back to the DefaultAzureCredential chain, i.e. the environment or Azure CLI credentials, when not.
+// The credentials acquire and refresh the access tokens, so they are never handled by the AzureKeyVaultProvider.
+func NewAzureKeyVaultClient(vaultUrl string) (AzureKeyVaultClient, error) {
+	credential, err := newAzureCredential()
+	if err != nil {
+		return nil, err
+	}
+
+	return newAzureKeyVaultClient(vaultUrl, credential, nil)
+}
+
+func newAzureKeyVaultClient(vaultUrl string, credential azcore.TokenCredential, options *azsecrets.ClientOptions) (AzureKeyVaultClient, error) {
+	client, err := azsecrets.NewClient(vaultUrl, credential, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Azure Key Vault client for '%s': %w", vaultUrl, err)
+	}
+
+	return &azureKeyVaultClient{client: client}, nil
+}
+
+// newAzureCredential creates the credential preferring the managed identity, which requires no secrets to be deployed
+// with the service, and falling back to the DefaultAzureCredential chain if the managed identity is not available.
+func newAzureCredential() (azcore.TokenCredential, error) {
+	managedIdentity, err := azidentity.NewManagedIdentityCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Azure managed identity credential: %w", err)
+	}
+
+	defaultCredential, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Azure default credential: %w", err)
+	}
+
+	credential, err := azidentity.NewChainedTokenCredential([]azcore.TokenCredential{managedIdentity, defaultCredential}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Azure credential: %w", err)
+	}
+
+	return credential, nil
+}
+
+// GetSecret retrieves the latest version of the named secret, returning ErrAzureKeyVaultSecretNotFound if the secret
+// doesn't exist
+func (c *azureKeyVaultClient) GetSecret(ctx context.Context, name string) (AzureKeyVaultSecret, error) {
+	resp, err := c.client.GetSecret(ctx, name, "", nil)
+	if err != nil {
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound {
+			return AzureKeyVaultSecret{}, fmt.Errorf("%w: %s", ErrAzureKeyVaultSecretNotFound, err.Error())
+		}
+		return AzureKeyVaultSecret{}, err
+	}
+
+	result := AzureKeyVaultSecret{
+		Name:    name,
+		Tags:    fromAzureTags(resp.Tags),
+		Updated: azureUpdated(resp.Attributes),
+	}
+	if resp.Value != nil {
+		result.Value = *resp.Value
+	}
+
+	return result, nil
+}
+
+// SetSecret creates a new version of the named secret with the value and tags
+func (c *azureKeyVaultClient) SetSecret(ctx context.Context, name string, value string, tags map[string]string) (AzureKeyVaultSecret, error) {
+	parameters := azsecrets.SetSecretParameters{
+		Value: &value,
+		Tags:  toAzureTags(tags),
+	}
+
+	resp, err := c.client.SetSecret(ctx, name, parameters, nil)
+	if err != nil {
+		return AzureKeyVaultSecret{}, err
+	}
+
+	return AzureKeyVaultSecret{
+		Name:    name,
+		Value:   value,
+		Tags:    fromAzureTags(resp.Tags),
+		Updated: azureUpdated(resp.Attributes),
+	}, nil
+}
+
+// ListSecrets lists the properties, i.e. everything but the Value, of all the secrets in the vault
+func (c *azureKeyVaultClient) ListSecrets(ctx context.Context) ([]AzureKeyVaultSecret, error) {
+	var results []AzureKeyVaultSecret
+
+	pager := c.client.NewListSecretPropertiesPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, properties := range page.Value {
+			if properties == nil || properties.ID == nil {
+				continue
+			}
+
+			results = append(results, AzureKeyVaultSecret{
+				Name:    properties.ID.Name(),
+				Tags:    fromAzureTags(properties.Tags),
+				Updated: azureUpdated(properties.Attributes),
+			})
+		}
+	}
+
+	return results, nil
+}
+
+func toAzureTags(tags map[string]string) map[string]*string {
+	results := make(map[string]*string, len(tags))
+	for key, value := range tags {
+		value := value
+		results[key] = &value
+	}
+
+	return results
+}
+
+func fromAzureTags(tags map[string]*string) map[string]string {
+	results := make(map[string]string, len(tags))
+	for key, value := range tags {
+		if value != nil {
+			results[key] = *value
+		}
+	}
+
+	return results
+}
+
+func azureUpdated(attributes *azsecrets.SecretAttributes) time.Time {
+	if attributes == nil || attributes.Updated == nil {
+		return time.Time{}
+	}
+
+	return *attributes.Updated
+}
@@ -0,0 +1,120 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAzureCredential struct{}
+
+func (c fakeAzureCredential) GetToken(_ context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "test-token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+// newTestAzureKeyVaultClient creates an AzureKeyVaultClient for a Key Vault served by the handler. Requests without
+// the bearer token are challenged first, the same as Key Vault does, so the client acquires the token.
+func newTestAzureKeyVaultClient(t *testing.T, handler http.HandlerFunc) AzureKeyVaultClient {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.Header().Set("WWW-Authenticate", `Bearer authorization="https://login.microsoftonline.com/tenant", resource="https://vault.azure.net"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	options := &azsecrets.ClientOptions{
+		ClientOptions:                        azcore.ClientOptions{Transport: server.Client()},
+		DisableChallengeResourceVerification: true,
+	}
+	client, err := newAzureKeyVaultClient(server.URL, fakeAzureCredential{}, options)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestAzureKeyVaultClient_GetSecret(t *testing.T) {
+	client := newTestAzureKeyVaultClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/secrets/core-data-redisdb/":
+			_, _ = w.Write([]byte(`{"value":"{\"password\":\"secret\"}","id":"https://vault/secrets/core-data-redisdb/1",` +
+				`"attributes":{"updated":1700000000},"tags":{"edgex-secret-name":"redisdb"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"SecretNotFound","message":"not found"}}`))
+		}
+	})
+
+	actual, err := client.GetSecret(context.Background(), "core-data-redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, "core-data-redisdb", actual.Name)
+	assert.Equal(t, `{"password":"secret"}`, actual.Value)
+	assert.Equal(t, map[string]string{AzureSecretNameTag: "redisdb"}, actual.Tags)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), actual.Updated.UTC())
+
+	_, err = client.GetSecret(context.Background(), "core-data-missing")
+	require.ErrorIs(t, err, ErrAzureKeyVaultSecretNotFound)
+}
+
+func TestAzureKeyVaultClient_SetSecret(t *testing.T) {
+	var received map[string]any
+	client := newTestAzureKeyVaultClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/secrets/core-data-redisdb", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		_, _ = w.Write([]byte(`{"id":"https://vault/secrets/core-data-redisdb/2","attributes":{"updated":1700000100},` +
+			`"tags":{"edgex-secret-name":"redisdb"}}`))
+	})
+
+	actual, err := client.SetSecret(context.Background(), "core-data-redisdb", `{"password":"new"}`, map[string]string{AzureSecretNameTag: "redisdb"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"password":"new"}`, received["value"])
+	assert.Equal(t, map[string]any{AzureSecretNameTag: "redisdb"}, received["tags"])
+	assert.Equal(t, `{"password":"new"}`, actual.Value)
+	assert.Equal(t, time.Unix(1700000100, 0).UTC(), actual.Updated.UTC())
+}
+
+func TestAzureKeyVaultClient_ListSecrets(t *testing.T) {
+	client := newTestAzureKeyVaultClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// The secrets are listed over two pages
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"value":[{"id":"https://vault/secrets/core-data-mqtt-bus","tags":{"edgex-secret-name":"mqtt/bus"}}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"value":[{"id":"https://vault/secrets/core-data-redisdb","tags":{"edgex-secret-name":"redisdb"}}],` +
+			`"nextLink":"https://` + r.Host + `/secrets?page=2"}`))
+	})
+
+	actual, err := client.ListSecrets(context.Background())
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+	assert.Equal(t, "core-data-redisdb", actual[0].Name)
+	assert.Equal(t, "redisdb", actual[0].Tags[AzureSecretNameTag])
+	assert.Equal(t, "core-data-mqtt-bus", actual[1].Name)
+	assert.Equal(t, "mqtt/bus", actual[1].Tags[AzureSecretNameTag])
+}
@@ -14,7 +14,12 @@
 
 package secret
 
-import "os"
+import (
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/go-multierror"
+)
 
 const (
 	EnvSecretStore = "EDGEX_SECURITY_SECRET_STORE"
@@ -29,3 +34,30 @@ func IsSecurityEnabled() bool {
 	env := os.Getenv(EnvSecretStore)
 	return env != "false" // Any other value is considered secure mode enabled
 }
+
+// getSecretsSequentially retrieves all the keys of each of the secrets in turn, returning the secrets which were
+// retrieved along with an error aggregating the failures, if any
+func getSecretsSequentially(getSecret func(secretName string, keys ...string) (map[string]string, error), secretNames []string) (map[string]map[string]string, error) {
+	results := make(map[string]map[string]string, len(secretNames))
+	var errs error
+
+	for _, secretName := range secretNames {
+		secrets, err := getSecret(secretName)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to get secret '%s': %w", secretName, err))
+			continue
+		}
+		results[secretName] = secrets
+	}
+
+	return results, errs
+}
+
+// copySecrets returns a copy of the secrets so changes by the caller don't change the stored secrets
+func copySecrets(secrets map[string]string) map[string]string {
+	copied := make(map[string]string, len(secrets))
+	for key, value := range secrets {
+		copied[key] = value
+	}
+	return copied
+}
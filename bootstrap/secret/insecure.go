@@ -107,6 +107,12 @@ func (p *InsecureProvider) GetSecret(secretName string, keys ...string) (map[str
 	return results, nil
 }
 
+// GetSecrets retrieves all the keys of the secrets at each of the secretNames from the Insecure Secrets secret store.
+// The secrets which were retrieved are returned along with an error aggregating the failures, if any.
+func (p *InsecureProvider) GetSecrets(secretNames ...string) (map[string]map[string]string, error) {
+	return getSecretsSequentially(p.GetSecret, secretNames)
+}
+
 // StoreSecret attempts to store the secrets in the ConfigurationProvider's InsecureSecrets. If no ConfigurationProvider
 // is in use, it will return an error.
 //
@@ -226,6 +232,12 @@ func (p *InsecureProvider) DeregisterSecretUpdatedCallback(secretName string) {
 	delete(p.registeredSecretCallbacks, secretName)
 }
 
+// RegisterSealStateChangedCallback does nothing since insecure secrets are not held in a SecretStore which can be
+// sealed, so the callback is never called.
+func (p *InsecureProvider) RegisterSealStateChangedCallback(_ func(sealed bool)) error {
+	return nil
+}
+
 // GetMetricsToRegister returns all metric objects that needs to be registered.
 func (p *InsecureProvider) GetMetricsToRegister() map[string]interface{} {
 	return map[string]interface{}{
@@ -53,6 +53,9 @@ func (self *jwtSecretProvider) AddAuthenticationData(req *http.Request) error {
 	return nil
 }
 func (self *jwtSecretProvider) RoundTripper() http.RoundTripper {
+	if self.roundTripper_a != nil {
+		return self.roundTripper_a
+	}
 	// Do nothing to the request; used for unit tests
 	return self.secretProvider.HttpTransport()
 }
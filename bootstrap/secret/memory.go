@@ -0,0 +1,260 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	gometrics "github.com/rcrowley/go-metrics"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+)
+
+var _ interfaces.SecretProviderExt = (*InMemorySecretProvider)(nil)
+
+// InMemorySecretProvider implements the SecretProviderExt interface with the secrets held in memory. It is intended
+// as a simple, deterministic fake of the secret provider for tests of code which depends on it, removing the need to
+// stub the SecretStore or to set up InsecureSecrets.
+type InMemorySecretProvider struct {
+	secrets                   map[string]map[string]string
+	lastUpdated               time.Time
+	registeredSecretCallbacks map[string]func(secretName string)
+	securitySecretsRequested  gometrics.Counter
+	securitySecretsStored     gometrics.Counter
+	httpRoundTripper          http.RoundTripper
+	zeroTrustEnabled          bool
+	mutex                     sync.RWMutex
+}
+
+// NewInMemorySecretProvider creates a new InMemorySecretProvider holding a copy of the initial secrets, which are
+// keyed by secretName.
+func NewInMemorySecretProvider(initial map[string]map[string]string) *InMemorySecretProvider {
+	secrets := make(map[string]map[string]string, len(initial))
+	for secretName, secret := range initial {
+		secrets[secretName] = copySecrets(secret)
+	}
+
+	return &InMemorySecretProvider{
+		secrets:                   secrets,
+		lastUpdated:               time.Now(),
+		registeredSecretCallbacks: make(map[string]func(secretName string)),
+		securitySecretsRequested:  gometrics.NewCounter(),
+		securitySecretsStored:     gometrics.NewCounter(),
+		httpRoundTripper:          http.DefaultTransport,
+	}
+}
+
+// GetSecret retrieves the secrets at the secretName. If no keys are provided then all the keys associated with the
+// specified secretName are returned.
+func (p *InMemorySecretProvider) GetSecret(secretName string, keys ...string) (map[string]string, error) {
+	p.securitySecretsRequested.Inc(1)
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	secret, exists := p.secrets[secretName]
+	if !exists {
+		return nil, fmt.Errorf("secretName (%s) doesn't exist in secret store", secretName)
+	}
+
+	if len(keys) == 0 {
+		return copySecrets(secret), nil
+	}
+
+	results := make(map[string]string)
+	var missingKeys []string
+	for _, key := range keys {
+		value, exists := secret[key]
+		if !exists {
+			missingKeys = append(missingKeys, key)
+			continue
+		}
+		results[key] = value
+	}
+
+	if len(missingKeys) > 0 {
+		return nil, fmt.Errorf("no value for the keys: [%s] exists in secret '%s'", strings.Join(missingKeys, ","), secretName)
+	}
+
+	return results, nil
+}
+
+// GetSecrets retrieves all the keys of the secrets at each of the secretNames. The secrets which were retrieved are
+// returned along with an error aggregating the failures, if any.
+func (p *InMemorySecretProvider) GetSecrets(secretNames ...string) (map[string]map[string]string, error) {
+	return getSecretsSequentially(p.GetSecret, secretNames)
+}
+
+// StoreSecret stores the secrets at the secretName, merging them with any secrets already there, and then performs
+// the updates and callbacks for the updated secret.
+func (p *InMemorySecretProvider) StoreSecret(secretName string, secrets map[string]string) error {
+	p.mutex.Lock()
+	existing, exists := p.secrets[secretName]
+	if !exists {
+		existing = make(map[string]string, len(secrets))
+		p.secrets[secretName] = existing
+	}
+	for key, value := range secrets {
+		existing[key] = value
+	}
+	p.mutex.Unlock()
+
+	p.SecretUpdatedAtSecretName(secretName)
+	return nil
+}
+
+// SecretsUpdated sets the secrets last updated time to the current time.
+func (p *InMemorySecretProvider) SecretsUpdated() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.lastUpdated = time.Now()
+}
+
+// SecretsLastUpdated returns the last time secrets were updated
+func (p *InMemorySecretProvider) SecretsLastUpdated() time.Time {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.lastUpdated
+}
+
+// GetAccessToken returns an empty access token since there is no SecretStore to issue one.
+func (p *InMemorySecretProvider) GetAccessToken(_ string, _ string) (string, error) {
+	return "", nil
+}
+
+// HasSecret returns true if there are secrets at the specified secretName.
+func (p *InMemorySecretProvider) HasSecret(secretName string) (bool, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	_, exists := p.secrets[secretName]
+	return exists, nil
+}
+
+// ListSecretNames returns the sorted list of secretNames which have secrets.
+func (p *InMemorySecretProvider) ListSecretNames() ([]string, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	results := make([]string, 0, len(p.secrets))
+	for secretName := range p.secrets {
+		results = append(results, secretName)
+	}
+	sort.Strings(results)
+
+	return results, nil
+}
+
+// RegisterSecretUpdatedCallback registers a callback for a secret. If you specify secret.WildcardName
+// as the secretName, then the callback will be called for any updated secret. Callbacks set for a specific
+// secretName are given a higher precedence over wildcard ones, and will be called instead of the wildcard one
+// if both are present.
+func (p *InMemorySecretProvider) RegisterSecretUpdatedCallback(secretName string, callback func(secretName string)) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, ok := p.registeredSecretCallbacks[secretName]; ok {
+		return fmt.Errorf("there is a callback already registered for secretName '%v'", secretName)
+	}
+
+	p.registeredSecretCallbacks[secretName] = callback
+	return nil
+}
+
+// SecretUpdatedAtSecretName performs updates and callbacks for an updated secret or secretName.
+func (p *InMemorySecretProvider) SecretUpdatedAtSecretName(secretName string) {
+	p.securitySecretsStored.Inc(1)
+
+	p.mutex.Lock()
+	p.lastUpdated = time.Now()
+	callback, ok := p.registeredSecretCallbacks[secretName]
+	if !ok {
+		callback, ok = p.registeredSecretCallbacks[WildcardName]
+	}
+	p.mutex.Unlock()
+
+	// The callback is invoked outside the lock so it is able to call back into the provider
+	if ok {
+		callback(secretName)
+	}
+}
+
+// DeregisterSecretUpdatedCallback removes a secret's registered callback secretName.
+func (p *InMemorySecretProvider) DeregisterSecretUpdatedCallback(secretName string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	delete(p.registeredSecretCallbacks, secretName)
+}
+
+// RegisterSealStateChangedCallback does nothing since the secrets are not held in a SecretStore which can be
+// sealed, so the callback is never called.
+func (p *InMemorySecretProvider) RegisterSealStateChangedCallback(_ func(sealed bool)) error {
+	return nil
+}
+
+// GetMetricsToRegister returns all metric objects that needs to be registered.
+func (p *InMemorySecretProvider) GetMetricsToRegister() map[string]interface{} {
+	return map[string]interface{}{
+		secretsRequestedMetricName: p.securitySecretsRequested,
+		secretsStoredMetricName:    p.securitySecretsStored,
+	}
+}
+
+// GetSelfJWT returns an empty JWT since there is no identity-based secret store token
+func (p *InMemorySecretProvider) GetSelfJWT() (string, error) {
+	return "", nil
+}
+
+// IsJWTValid always reports the JWT as valid, the same as when security is disabled, since there is no secret store
+// to validate the JWT against
+func (p *InMemorySecretProvider) IsJWTValid(_ string) (bool, error) {
+	return true, nil
+}
+
+func (p *InMemorySecretProvider) HttpTransport() http.RoundTripper {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.httpRoundTripper
+}
+
+func (p *InMemorySecretProvider) SetHttpTransport(rt http.RoundTripper) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.httpRoundTripper = rt
+}
+
+func (p *InMemorySecretProvider) IsZeroTrustEnabled() bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.zeroTrustEnabled
+}
+
+func (p *InMemorySecretProvider) EnableZeroTrust() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.zeroTrustEnabled = true
+}
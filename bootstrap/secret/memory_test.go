@@ -0,0 +1,110 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemorySecretProvider_GetSecret(t *testing.T) {
+	initial := map[string]map[string]string{
+		"redisdb": {"username": "edgex", "password": "secret"},
+	}
+	target := NewInMemorySecretProvider(initial)
+
+	// Changes to the initial secrets don't change the provider
+	initial["redisdb"]["password"] = "changed"
+
+	actual, err := target.GetSecret("redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "edgex", "password": "secret"}, actual)
+
+	actual, err = target.GetSecret("redisdb", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "secret"}, actual)
+
+	_, err = target.GetSecret("redisdb", "token")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no value for the keys: [token]")
+
+	_, err = target.GetSecret("bogus")
+	require.Error(t, err)
+
+	exists, err := target.HasSecret("redisdb")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = target.HasSecret("bogus")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestInMemorySecretProvider_StoreSecret(t *testing.T) {
+	target := NewInMemorySecretProvider(map[string]map[string]string{
+		"redisdb": {"username": "edgex", "password": "secret"},
+	})
+	initialUpdated := target.SecretsLastUpdated()
+	time.Sleep(time.Millisecond)
+
+	require.NoError(t, target.StoreSecret("redisdb", map[string]string{"password": "updated"}))
+	require.NoError(t, target.StoreSecret("mqtt", map[string]string{"password": "mqtt"}))
+
+	actual, err := target.GetSecret("redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "edgex", "password": "updated"}, actual)
+
+	actual, err = target.GetSecret("mqtt")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "mqtt"}, actual)
+
+	assert.True(t, target.SecretsLastUpdated().After(initialUpdated))
+
+	names, err := target.ListSecretNames()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mqtt", "redisdb"}, names)
+}
+
+func TestInMemorySecretProvider_SecretUpdatedCallback(t *testing.T) {
+	target := NewInMemorySecretProvider(nil)
+
+	var updated []string
+	require.NoError(t, target.RegisterSecretUpdatedCallback("redisdb", func(secretName string) {
+		// The stored secret is available from within the callback
+		secrets, err := target.GetSecret(secretName)
+		require.NoError(t, err)
+		updated = append(updated, secretName+":"+secrets["password"])
+	}))
+	require.Error(t, target.RegisterSecretUpdatedCallback("redisdb", func(string) {}))
+
+	var wildcard []string
+	require.NoError(t, target.RegisterSecretUpdatedCallback(WildcardName, func(secretName string) {
+		wildcard = append(wildcard, secretName)
+	}))
+
+	require.NoError(t, target.StoreSecret("redisdb", map[string]string{"password": "secret"}))
+	require.NoError(t, target.StoreSecret("mqtt", map[string]string{"password": "mqtt"}))
+	assert.Equal(t, []string{"redisdb:secret"}, updated)
+	assert.Equal(t, []string{"mqtt"}, wildcard)
+
+	// Once deregistered the wildcard callback is used instead
+	target.DeregisterSecretUpdatedCallback("redisdb")
+	require.NoError(t, target.StoreSecret("redisdb", map[string]string{"password": "updated"}))
+	assert.Equal(t, []string{"redisdb:secret"}, updated)
+	assert.Equal(t, []string{"mqtt", "redisdb"}, wildcard)
+}
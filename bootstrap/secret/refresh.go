@@ -0,0 +1,155 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// CredentialsChangedCallback is called when the refreshed credentials for a database have changed
+type CredentialsChangedCallback func(database config.Database, credentials config.Credentials)
+
+type cachedCredentials struct {
+	credentials config.Credentials
+	fetched     time.Time
+}
+
+// RefreshingCredentialsProvider wraps a CredentialsProvider, caching the credentials and re-fetching them once the
+// TTL has expired. This supports credentials that expire or are rotated, such as Vault dynamic secrets, with the
+// registered callback notified when the credentials change so the service can reconnect to the database.
+// When a refresh fails the last good credentials are kept.
+type RefreshingCredentialsProvider struct {
+	lc        logger.LoggingClient
+	provider  interfaces.CredentialsProvider
+	ttl       time.Duration
+	cache     map[config.Database]cachedCredentials
+	callbacks []CredentialsChangedCallback
+	mutex     sync.RWMutex
+}
+
+// NewRefreshingCredentialsProvider creates a new RefreshingCredentialsProvider which re-fetches the credentials from
+// the wrapped provider when they are older than the TTL
+func NewRefreshingCredentialsProvider(lc logger.LoggingClient, provider interfaces.CredentialsProvider, ttl time.Duration) *RefreshingCredentialsProvider {
+	return &RefreshingCredentialsProvider{
+		lc:       lc,
+		provider: provider,
+		ttl:      ttl,
+		cache:    make(map[config.Database]cachedCredentials),
+	}
+}
+
+// RegisterCredentialsChangedCallback registers a callback which is called when refreshed credentials have changed
+func (p *RefreshingCredentialsProvider) RegisterCredentialsChangedCallback(callback CredentialsChangedCallback) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.callbacks = append(p.callbacks, callback)
+}
+
+// GetDatabaseCredentials returns the cached credentials for the database, re-fetching them from the wrapped provider
+// when they are older than the TTL.
+func (p *RefreshingCredentialsProvider) GetDatabaseCredentials(database config.Database) (config.Credentials, error) {
+	p.mutex.RLock()
+	cached, exists := p.cache[database]
+	p.mutex.RUnlock()
+
+	if exists && time.Since(cached.fetched) < p.ttl {
+		return cached.credentials, nil
+	}
+
+	return p.refresh(database)
+}
+
+// Run periodically refreshes the credentials for all the databases that have been requested, so that the
+// registered callbacks are notified of changes without waiting for the next call to GetDatabaseCredentials.
+func (p *RefreshingCredentialsProvider) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(p.ttl)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				p.lc.Info("Exited Credentials refresh Run...")
+				return
+
+			case <-ticker.C:
+				p.mutex.RLock()
+				databases := make([]config.Database, 0, len(p.cache))
+				for database := range p.cache {
+					databases = append(databases, database)
+				}
+				p.mutex.RUnlock()
+
+				for _, database := range databases {
+					// Errors are logged by refresh and the last good credentials kept
+					_, _ = p.refresh(database)
+				}
+			}
+		}
+	}()
+
+	p.lc.Infof("Credentials refresh started with a TTL of %s", p.ttl.String())
+}
+
+// refresh fetches the credentials from the wrapped provider, notifying the callbacks if they have changed.
+// When the fetch fails the last good credentials are returned if available.
+func (p *RefreshingCredentialsProvider) refresh(database config.Database) (config.Credentials, error) {
+	credentials, err := p.provider.GetDatabaseCredentials(database)
+
+	p.mutex.Lock()
+	cached, exists := p.cache[database]
+
+	if err != nil {
+		p.mutex.Unlock()
+
+		if !exists {
+			return config.Credentials{}, err
+		}
+
+		p.lc.Errorf("failed to refresh credentials for database '%s', using last good credentials: %v", database.Name, err)
+		return cached.credentials, nil
+	}
+
+	p.cache[database] = cachedCredentials{
+		credentials: credentials,
+		fetched:     time.Now(),
+	}
+
+	changed := exists && cached.credentials != credentials
+	callbacks := make([]CredentialsChangedCallback, len(p.callbacks))
+	copy(callbacks, p.callbacks)
+	p.mutex.Unlock()
+
+	if changed {
+		p.lc.Infof("Credentials for database '%s' have changed", database.Name)
+		for _, callback := range callbacks {
+			callback(database, credentials)
+		}
+	}
+
+	return credentials, nil
+}
@@ -0,0 +1,132 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// fakeCredentialsProvider returns new credentials on each call, or an error when failing is set
+type fakeCredentialsProvider struct {
+	calls   int
+	failing bool
+	mutex   sync.Mutex
+}
+
+func (f *fakeCredentialsProvider) GetDatabaseCredentials(_ config.Database) (config.Credentials, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.calls++
+	if f.failing {
+		return config.Credentials{}, errors.New("secret store unavailable")
+	}
+
+	return config.Credentials{
+		Username: "edgex",
+		Password: fmt.Sprintf("password%d", f.calls),
+	}, nil
+}
+
+func (f *fakeCredentialsProvider) setFailing(failing bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.failing = failing
+}
+
+func TestRefreshingCredentialsProvider_GetDatabaseCredentials(t *testing.T) {
+	database := config.Database{Type: "postgres", Name: "core-data"}
+	fake := &fakeCredentialsProvider{}
+	target := NewRefreshingCredentialsProvider(logger.NewMockClient(), fake, time.Millisecond*50)
+
+	var changed []config.Credentials
+	target.RegisterCredentialsChangedCallback(func(_ config.Database, credentials config.Credentials) {
+		changed = append(changed, credentials)
+	})
+
+	actual, err := target.GetDatabaseCredentials(database)
+	require.NoError(t, err)
+	assert.Equal(t, "password1", actual.Password)
+
+	// Cached until the TTL expires
+	actual, err = target.GetDatabaseCredentials(database)
+	require.NoError(t, err)
+	assert.Equal(t, "password1", actual.Password)
+	assert.Equal(t, 1, fake.calls)
+	assert.Empty(t, changed)
+
+	time.Sleep(time.Millisecond * 60)
+	actual, err = target.GetDatabaseCredentials(database)
+	require.NoError(t, err)
+	assert.Equal(t, "password2", actual.Password)
+	require.Len(t, changed, 1)
+	assert.Equal(t, "password2", changed[0].Password)
+
+	// Failed refresh keeps the last good credentials
+	fake.setFailing(true)
+	time.Sleep(time.Millisecond * 60)
+	actual, err = target.GetDatabaseCredentials(database)
+	require.NoError(t, err)
+	assert.Equal(t, "password2", actual.Password)
+	assert.Len(t, changed, 1)
+}
+
+func TestRefreshingCredentialsProvider_GetDatabaseCredentials_Error(t *testing.T) {
+	fake := &fakeCredentialsProvider{failing: true}
+	target := NewRefreshingCredentialsProvider(logger.NewMockClient(), fake, time.Minute)
+
+	_, err := target.GetDatabaseCredentials(config.Database{Name: "core-data"})
+	require.Error(t, err)
+}
+
+func TestRefreshingCredentialsProvider_Run(t *testing.T) {
+	database := config.Database{Type: "postgres", Name: "core-data"}
+	fake := &fakeCredentialsProvider{}
+	target := NewRefreshingCredentialsProvider(logger.NewMockClient(), fake, time.Millisecond*20)
+
+	mutex := sync.Mutex{}
+	var changed []config.Credentials
+	target.RegisterCredentialsChangedCallback(func(_ config.Database, credentials config.Credentials) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		changed = append(changed, credentials)
+	})
+
+	_, err := target.GetDatabaseCredentials(database)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	target.Run(ctx, wg)
+	time.Sleep(time.Millisecond * 100)
+	cancel()
+	wg.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.NotEmpty(t, changed)
+}
@@ -0,0 +1,200 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+)
+
+const (
+	// DefaultSealCheckInterval is the interval the SecretStore's seal state is polled at while unsealed
+	DefaultSealCheckInterval = time.Second * 10
+	// maxSealCheckInterval bounds the backoff of the polling while the SecretStore is sealed or unreachable
+	maxSealCheckInterval = time.Minute * 5
+
+	// SecretStoreReadinessCheckName is the name of the readiness check which fails while the SecretStore is sealed
+	SecretStoreReadinessCheckName = "SecretStore"
+)
+
+// SealStateChangedCallback is called with the new seal state when the SecretStore becomes sealed or unsealed
+type SealStateChangedCallback func(sealed bool)
+
+// SecretStoreHealthChecker checks the health of the SecretStore, returning the HTTP status code of the check. It is
+// satisfied by the go-mod-secrets SecretStoreClient.
+type SecretStoreHealthChecker interface {
+	HealthCheck() (int, error)
+}
+
+// SealMonitor polls the health of the SecretStore and calls the registered callbacks when the SecretStore becomes
+// sealed or unsealed, so services can enter a degraded mode while secrets can't be retrieved. The SecretStore is
+// presumed unsealed when monitoring starts since the secret client was successfully created. Polling backs off while
+// the SecretStore is sealed or unreachable to avoid flooding the logs.
+type SealMonitor struct {
+	lc        logger.LoggingClient
+	checker   SecretStoreHealthChecker
+	interval  time.Duration
+	backoff   time.Duration
+	sealed    bool
+	started   bool
+	callbacks []SealStateChangedCallback
+	mutex     sync.Mutex
+}
+
+// NewSealMonitor creates a new SealMonitor which polls the health of the SecretStore at the interval
+func NewSealMonitor(lc logger.LoggingClient, checker SecretStoreHealthChecker, interval time.Duration) *SealMonitor {
+	return &SealMonitor{
+		lc:       lc,
+		checker:  checker,
+		interval: interval,
+		backoff:  interval,
+	}
+}
+
+// RegisterCallback registers a callback which is called when the seal state changes
+func (m *SealMonitor) RegisterCallback(callback SealStateChangedCallback) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.callbacks = append(m.callbacks, callback)
+}
+
+// Start starts polling the health of the SecretStore until the context is cancelled. Subsequent calls do nothing.
+func (m *SealMonitor) Start(ctx context.Context) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.started {
+		return
+	}
+	m.started = true
+
+	go func() {
+		timer := time.NewTimer(m.interval)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+				timer.Reset(m.poll())
+			}
+		}
+	}()
+}
+
+// poll checks the seal state of the SecretStore, calling the callbacks if it has changed, and returns the time to
+// wait before the next poll
+func (m *SealMonitor) poll() time.Duration {
+	code, err := m.checker.HealthCheck()
+
+	var sealed bool
+	switch code {
+	case http.StatusServiceUnavailable, http.StatusNotImplemented:
+		// Vault returns 503 when sealed and 501 when not initialized, neither of which can serve secrets
+		sealed = true
+	case http.StatusOK, http.StatusTooManyRequests, 472, 473:
+		// Active, standby, DR secondary and performance standby nodes are all unsealed
+		sealed = false
+	default:
+		// The seal state is unknown when the SecretStore can't be reached, so back off without a state change
+		m.lc.Debugf("Unable to determine SecretStore seal state, status code %d: %v", code, err)
+		return m.increaseBackoff()
+	}
+
+	m.mutex.Lock()
+	changed := sealed != m.sealed
+	m.sealed = sealed
+	callbacks := append([]SealStateChangedCallback{}, m.callbacks...)
+	m.mutex.Unlock()
+
+	if changed {
+		if sealed {
+			m.lc.Warn("SecretStore has been sealed, secrets can not be retrieved until it is unsealed")
+		} else {
+			m.lc.Info("SecretStore has been unsealed")
+		}
+
+		for _, callback := range callbacks {
+			callback(sealed)
+		}
+	}
+
+	if sealed {
+		return m.increaseBackoff()
+	}
+
+	m.backoff = m.interval
+	return m.interval
+}
+
+// increaseBackoff doubles the time to wait before the next poll, up to maxSealCheckInterval
+func (m *SealMonitor) increaseBackoff() time.Duration {
+	m.backoff *= 2
+	if m.backoff > maxSealCheckInterval {
+		m.backoff = maxSealCheckInterval
+	}
+	return m.backoff
+}
+
+// newSecretStoreRequester creates the HTTP requester for the SecretStore management client, using TLS when a root CA
+// certificate is configured
+func newSecretStoreRequester(secretConfig types.SecretConfig, lc logger.LoggingClient) (pkg.Caller, error) {
+	if len(secretConfig.RootCaCertPath) == 0 {
+		return pkg.NewRequester(lc).Insecure(), nil
+	}
+
+	caReader, err := os.Open(secretConfig.RootCaCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open root CA certificate '%s': %w", secretConfig.RootCaCertPath, err)
+	}
+
+	// The requester closes the reader once the certificate has been read
+	return pkg.NewRequester(lc).WithTLS(caReader, secretConfig.ServerName), nil
+}
+
+// registerSealedReadinessCheck registers the readiness check which fails while the SecretStore is sealed. The check
+// isn't registered when the seal state of the provider's SecretStore can't be monitored.
+func registerSealedReadinessCheck(provider interfaces.SecretProvider, readiness interfaces.Readiness, lc logger.LoggingClient) {
+	if readiness == nil {
+		return
+	}
+
+	var sealed atomic.Bool
+	if err := provider.RegisterSealStateChangedCallback(sealed.Store); err != nil {
+		lc.Warnf("SecretStore readiness check is not available: %s", err.Error())
+		return
+	}
+
+	readiness.RegisterCheck(SecretStoreReadinessCheckName, func() error {
+		if sealed.Load() {
+			return errors.New("SecretStore is sealed")
+		}
+		return nil
+	})
+}
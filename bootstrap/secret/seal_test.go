@@ -0,0 +1,143 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHealthChecker returns the status codes in order, repeating the last one once exhausted
+type fakeHealthChecker struct {
+	codes []int
+	calls int
+	mutex sync.Mutex
+}
+
+func (f *fakeHealthChecker) HealthCheck() (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	index := f.calls
+	if index >= len(f.codes) {
+		index = len(f.codes) - 1
+	}
+	f.calls++
+
+	code := f.codes[index]
+	if code == 0 {
+		return 0, errors.New("connection refused")
+	}
+	return code, nil
+}
+
+func TestSealMonitor_Poll(t *testing.T) {
+	interval := time.Second
+	checker := &fakeHealthChecker{codes: []int{
+		http.StatusOK,
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+		http.StatusOK,
+		0,
+		http.StatusServiceUnavailable,
+	}}
+	target := NewSealMonitor(logger.NewMockClient(), checker, interval)
+
+	var changes []bool
+	target.RegisterCallback(func(sealed bool) {
+		changes = append(changes, sealed)
+	})
+
+	// Unsealed is presumed at startup, so no change
+	assert.Equal(t, interval, target.poll())
+	assert.Empty(t, changes)
+
+	// Sealed, with polling backed off while it remains sealed
+	assert.Equal(t, interval*2, target.poll())
+	assert.Equal(t, []bool{true}, changes)
+	assert.Equal(t, interval*4, target.poll())
+	assert.Equal(t, []bool{true}, changes)
+
+	// Unsealed, with polling back to the interval
+	assert.Equal(t, interval, target.poll())
+	assert.Equal(t, []bool{true, false}, changes)
+
+	// Unreachable leaves the state unchanged, but backs off
+	assert.Equal(t, interval*2, target.poll())
+	assert.Equal(t, []bool{true, false}, changes)
+
+	// Sealed again
+	target.poll()
+	assert.Equal(t, []bool{true, false, true}, changes)
+}
+
+func TestSealMonitor_Backoff(t *testing.T) {
+	checker := &fakeHealthChecker{codes: []int{http.StatusServiceUnavailable}}
+	target := NewSealMonitor(logger.NewMockClient(), checker, time.Minute)
+
+	var next time.Duration
+	for i := 0; i < 10; i++ {
+		next = target.poll()
+	}
+	assert.Equal(t, maxSealCheckInterval, next)
+}
+
+func TestSealMonitor_Start(t *testing.T) {
+	checker := &fakeHealthChecker{codes: []int{
+		http.StatusServiceUnavailable,
+		http.StatusOK,
+		http.StatusServiceUnavailable,
+	}}
+	target := NewSealMonitor(logger.NewMockClient(), checker, time.Millisecond*5)
+
+	changes := make(chan bool, 10)
+	target.RegisterCallback(func(sealed bool) {
+		changes <- sealed
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	target.Start(ctx)
+	// Subsequent starts are ignored
+	target.Start(ctx)
+
+	for _, expected := range []bool{true, false, true} {
+		select {
+		case actual := <-changes:
+			assert.Equal(t, expected, actual)
+		case <-time.After(time.Second):
+			require.Fail(t, "timed out waiting for seal state change")
+		}
+	}
+}
+
+func TestSecureProvider_RegisterSealStateChangedCallback(t *testing.T) {
+	target := NewSecureProvider(context.Background(), secretStoreConfig(t), logger.NewMockClient(), nil, nil, "core-data")
+	require.Error(t, target.RegisterSealStateChangedCallback(func(_ bool) {}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	target.ctx = ctx
+	target.SetSealMonitor(NewSealMonitor(logger.NewMockClient(), &fakeHealthChecker{codes: []int{http.StatusOK}}, time.Minute))
+	require.NoError(t, target.RegisterSealStateChangedCallback(func(_ bool) {}))
+}
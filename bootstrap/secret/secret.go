@@ -18,6 +18,7 @@ package secret
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"path"
 	"strings"
 	"time"
@@ -73,70 +74,38 @@ func NewSecretProvider(
 			return nil, err
 		}
 
-		for startupTimer.HasNotElapsed() {
-			var secretConfig types.SecretConfig
-
-			lc.Info("Reading secret store configuration and authentication token")
-
-			tokenLoader := container.AuthTokenLoaderFrom(dic.Get)
-			if tokenLoader == nil {
-				tokenLoader = authtokenloader.NewAuthTokenLoader(fileioperformer.NewDefaultFileIoPerformer())
-			}
-
-			runtimeTokenLoader := container.RuntimeTokenProviderFrom(dic.Get)
-			if runtimeTokenLoader == nil {
-				runtimeTokenLoader = runtimetokenprovider.NewRuntimeTokenProvider(ctx, lc,
-					secretStoreConfig.RuntimeTokenProvider)
+		var cacheTTL time.Duration
+		if len(secretStoreConfig.CacheTTL) > 0 {
+			cacheTTL, err = time.ParseDuration(secretStoreConfig.CacheTTL)
+			if err != nil {
+				return nil, fmt.Errorf("SecretStore CacheTTL '%s' is invalid time duration: %s", secretStoreConfig.CacheTTL, err.Error())
 			}
 
-			// We need to create securityRuntimeSecretTokenDuration here because we want to measure the time taken
-			// to get the secret config, but the secureProvider instance is created after this step.
-			securityRuntimeSecretTokenDuration := gometrics.NewTimer()
-			secretConfig, err = getSecretConfig(secretStoreConfig, tokenLoader, runtimeTokenLoader, serviceKey, lc, securityRuntimeSecretTokenDuration)
-			if err == nil {
-				secureProvider := NewSecureProvider(ctx, secretStoreConfig, lc, tokenLoader, runtimeTokenLoader, serviceKey)
-				secureProvider.securityRuntimeSecretTokenDuration = securityRuntimeSecretTokenDuration
-				var secretClient secrets.SecretClient
-
-				lc.Info("Attempting to create secret client")
-
-				tokenCallbackFunc := secureProvider.DefaultTokenExpiredCallback
-				if secretConfig.RuntimeTokenProvider.Enabled {
-					tokenCallbackFunc = secureProvider.RuntimeTokenExpiredCallback
-				}
-
-				secretClient, err = secrets.NewSecretsClient(ctx, secretConfig, lc, tokenCallbackFunc)
-				if err == nil {
-					secureProvider.SetClient(secretClient)
-					provider = secureProvider
-					lc.Info("Created SecretClient")
-
-					lc.Debugf("SecretsFile is '%s'", secretConfig.SecretsFile)
-
-					if len(strings.TrimSpace(secretConfig.SecretsFile)) == 0 {
-						lc.Infof("SecretsFile not set, skipping seeding of service secrets.")
-						break
-					}
-
-					provider = secureProvider
-					lc.Info("Created SecretClient")
-
-					err = secureProvider.LoadServiceSecrets(secretStoreConfig)
-					if err != nil {
-						return nil, err
-					}
-					break
-				}
-			}
+			lc.Infof("Secrets retrieved from the SecretStore are cached for %s", cacheTTL.String())
+		}
 
-			lc.Warn(fmt.Sprintf("Retryable failure while creating SecretClient: %s", err.Error()))
-			startupTimer.SleepForInterval()
+		if namespace := strings.TrimSpace(secretStoreConfig.Namespace); len(namespace) > 0 {
+			lc.Infof("Using SecretStore namespace '%s'", namespace)
 		}
 
+		switch secretStoreConfig.Type {
+		case AzureKeyVaultSecretStoreType:
+			provider, err = newAzureKeyVaultSecretProvider(secretStoreConfig, lc)
+		default:
+			provider, err = newSecureSecretProvider(ctx, secretStoreConfig, cacheTTL, startupTimer, dic, serviceKey, lc)
+		}
 		if err != nil {
-			return nil, fmt.Errorf("unable to create SecretClient: %s", err.Error())
+			return nil, err
 		}
 
+		registerSealedReadinessCheck(provider, container.ReadinessFrom(dic.Get), lc)
+
+		dic.Update(di.ServiceConstructorMap{
+			container.SecretStoreConfigName: func(get di.Get) interface{} {
+				return secretStoreConfig
+			},
+		})
+
 	case false:
 		provider = NewInsecureProvider(configuration, lc, dic)
 	}
@@ -155,6 +124,114 @@ func NewSecretProvider(
 	return provider, nil
 }
 
+// newSecureSecretProvider creates the SecureProvider backed by the Vault based SecretStore, retrying until the
+// SecretStore is available or the startup timer elapses.
+func newSecureSecretProvider(
+	ctx context.Context,
+	secretStoreConfig *config.SecretStoreInfo,
+	cacheTTL time.Duration,
+	startupTimer startup.Timer,
+	dic *di.Container,
+	serviceKey string,
+	lc logger.LoggingClient) (interfaces.SecretProviderExt, error) {
+	var provider interfaces.SecretProviderExt
+	var err error
+
+	for startupTimer.HasNotElapsed() {
+		var secretConfig types.SecretConfig
+
+		lc.Info("Reading secret store configuration and authentication token")
+
+		tokenLoader := container.AuthTokenLoaderFrom(dic.Get)
+		if tokenLoader == nil {
+			tokenLoader = authtokenloader.NewAuthTokenLoader(fileioperformer.NewDefaultFileIoPerformer())
+		}
+
+		runtimeTokenLoader := container.RuntimeTokenProviderFrom(dic.Get)
+		if runtimeTokenLoader == nil {
+			runtimeTokenLoader = runtimetokenprovider.NewRuntimeTokenProvider(ctx, lc,
+				secretStoreConfig.RuntimeTokenProvider)
+		}
+
+		// We need to create securityRuntimeSecretTokenDuration here because we want to measure the time taken
+		// to get the secret config, but the secureProvider instance is created after this step.
+		securityRuntimeSecretTokenDuration := gometrics.NewTimer()
+		secretConfig, err = getSecretConfig(secretStoreConfig, tokenLoader, runtimeTokenLoader, serviceKey, lc, securityRuntimeSecretTokenDuration)
+		if err == nil {
+			secureProvider := NewSecureProvider(ctx, secretStoreConfig, lc, tokenLoader, runtimeTokenLoader, serviceKey)
+			secureProvider.securityRuntimeSecretTokenDuration = securityRuntimeSecretTokenDuration
+			secureProvider.SetCacheTTL(cacheTTL)
+			var secretClient secrets.SecretClient
+
+			lc.Info("Attempting to create secret client")
+
+			tokenCallbackFunc := secureProvider.DefaultTokenExpiredCallback
+			if secretConfig.RuntimeTokenProvider.Enabled {
+				tokenCallbackFunc = secureProvider.RuntimeTokenExpiredCallback
+			}
+
+			secretClient, err = secrets.NewSecretsClient(ctx, secretConfig, lc, tokenCallbackFunc)
+			if err == nil {
+				secureProvider.SetClient(secretClient)
+
+				if requester, requesterErr := newSecretStoreRequester(secretConfig, lc); requesterErr != nil {
+					lc.Warnf("SecretStore seal state monitoring is not available: %s", requesterErr.Error())
+				} else if storeClient, storeErr := secrets.NewSecretStoreClient(secretConfig, lc, requester); storeErr != nil {
+					lc.Warnf("SecretStore seal state monitoring is not available: %s", storeErr.Error())
+				} else {
+					secureProvider.SetSealMonitor(NewSealMonitor(lc, storeClient, DefaultSealCheckInterval))
+				}
+
+				provider = secureProvider
+				lc.Info("Created SecretClient")
+
+				lc.Debugf("SecretsFile is '%s'", secretConfig.SecretsFile)
+
+				if len(strings.TrimSpace(secretConfig.SecretsFile)) == 0 {
+					lc.Infof("SecretsFile not set, skipping seeding of service secrets.")
+					return provider, nil
+				}
+
+				provider = secureProvider
+				lc.Info("Created SecretClient")
+
+				err = secureProvider.LoadServiceSecrets(secretStoreConfig)
+				if err != nil {
+					return nil, err
+				}
+				return provider, nil
+			}
+		}
+
+		lc.Warn(fmt.Sprintf("Retryable failure while creating SecretClient: %s", err.Error()))
+		startupTimer.SleepForInterval()
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to create SecretClient: %s", err.Error())
+	}
+
+	return provider, nil
+}
+
+// newAzureKeyVaultSecretProvider creates the AzureKeyVaultProvider for the vault at the SecretStore Host, using the
+// StoreName as the secret name prefix.
+func newAzureKeyVaultSecretProvider(secretStoreConfig *config.SecretStoreInfo, lc logger.LoggingClient) (interfaces.SecretProviderExt, error) {
+	vaultUrl := (&url.URL{Scheme: "https", Host: secretStoreConfig.Host}).String()
+	lc.Infof("Using Azure Key Vault at '%s'", vaultUrl)
+
+	client, err := NewAzureKeyVaultClient(vaultUrl)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create SecretClient: %s", err.Error())
+	}
+
+	if len(strings.TrimSpace(secretStoreConfig.SecretsFile)) > 0 {
+		lc.Warnf("Seeding of service secrets from SecretsFile is not supported for Azure Key Vault, skipping '%s'", secretStoreConfig.SecretsFile)
+	}
+
+	return NewAzureKeyVaultProvider(lc, client, secretStoreConfig.StoreName), nil
+}
+
 // BuildSecretStoreConfig is public helper function that builds the SecretStore configuration
 // from default values and  environment override.
 func BuildSecretStoreConfig(serviceKey string, envVars *environment.Variables, lc logger.LoggingClient) (*config.SecretStoreInfo, error) {
@@ -188,7 +265,7 @@ func getSecretConfig(secretStoreInfo *config.SecretStoreInfo,
 		BasePath:             addEdgeXSecretNamePrefix(secretStoreInfo.StoreName),
 		SecretsFile:          secretStoreInfo.SecretsFile,
 		Protocol:             secretStoreInfo.Protocol,
-		Namespace:            secretStoreInfo.Namespace,
+		Namespace:            strings.TrimSpace(secretStoreInfo.Namespace),
 		RootCaCertPath:       secretStoreInfo.RootCaCertPath,
 		ServerName:           secretStoreInfo.ServerName,
 		Authentication:       secretStoreInfo.Authentication,
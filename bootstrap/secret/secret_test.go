@@ -34,6 +34,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 
 	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/token/authtokenloader/mocks"
+	"github.com/edgexfoundry/go-mod-secrets/v3/secrets"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -44,6 +45,7 @@ const expectedPassword = "password"
 const expectedSecretName = "redisdb"
 const expectedInsecureJWT = "" // Empty when in non-secure mode
 const expectedSecureJWT = "secureJwtToken"
+const vaultNamespaceHeader = "X-Vault-Namespace"
 
 // nolint: gosec
 var testTokenResponse = `{"auth":{"accessor":"9OvxnrjgV0JTYMeBreak7YJ9","client_token":"s.oPJ8uuJCkTRb2RDdcNova8wg","entity_id":"","lease_duration":3600,"metadata":{"edgex-service-name":"edgex-core-data"},"orphan":true,"policies":["default","edgex-service-edgex-core-data"],"renewable":true,"token_policies":["default","edgex-service-edgex-core-data"],"token_type":"service"},"data":null,"lease_duration":0,"lease_id":"","renewable":false,"request_id":"ee749ee1-c8bf-6fa9-3ed5-644181fc25b0","warnings":null,"wrap_info":null}`
@@ -147,6 +149,27 @@ func TestNewSecretProvider(t *testing.T) {
 	}
 }
 
+func TestNewSecretProvider_AzureKeyVault(t *testing.T) {
+	t.Setenv(EnvSecretStore, "true")
+	t.Setenv("SECRETSTORE_TYPE", AzureKeyVaultSecretStoreType)
+	t.Setenv("SECRETSTORE_HOST", "my-vault.vault.azure.net")
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+
+	envVars := environment.NewVariables(logger.NewMockClient())
+	actual, err := NewSecretProvider(nil, envVars, context.Background(), startup.NewStartUpTimer("UnitTest"), dic, "testServiceKey")
+	require.NoError(t, err)
+
+	azureProvider, ok := actual.(*AzureKeyVaultProvider)
+	require.True(t, ok)
+	assert.Equal(t, "testServiceKey", azureProvider.secretNamePrefix)
+	assert.Equal(t, actual, container.SecretProviderExtFrom(dic.Get))
+}
+
 func TestAddPrefix(t *testing.T) {
 	expectedPrefixPath := "/v1/secret/edgex/"
 
@@ -196,3 +219,76 @@ func TestBuildSecretStoreConfig(t *testing.T) {
 	assert.Equal(t, expectedRuntimeTokenProviderHost, target.RuntimeTokenProvider.Host)
 	assert.Equal(t, expectedRuntimeTokenProviderRequiredSecrets, target.RuntimeTokenProvider.RequiredSecrets)
 }
+
+func TestSecretStoreNamespace(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Namespace string
+	}{
+		{"With namespace", "tenant-a"},
+		{"Without namespace", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			var namespaces []string
+			var headerSent []bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.RequestURI {
+				case "/v1/auth/token/lookup-self":
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(testTokenResponse))
+				case "/v1/secret/edgex/testServiceKey/redisdb":
+					_, sent := r.Header[http.CanonicalHeaderKey(vaultNamespaceHeader)]
+					headerSent = append(headerSent, sent)
+					namespaces = append(namespaces, r.Header.Get(vaultNamespaceHeader))
+
+					if r.Method == http.MethodPost {
+						w.WriteHeader(http.StatusNoContent)
+						return
+					}
+
+					w.WriteHeader(http.StatusOK)
+					response, _ := json.Marshal(map[string]interface{}{"data": expectedSecrets})
+					_, _ = w.Write(response)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer testServer.Close()
+
+			serverUrl, _ := url.Parse(testServer.URL)
+			port, err := strconv.Atoi(serverUrl.Port())
+			require.NoError(t, err)
+
+			lc := logger.NewMockClient()
+			secretStoreInfo := bootstrapConfig.NewSecretStoreInfo("testServiceKey")
+			secretStoreInfo.Port = port
+			secretStoreInfo.Namespace = tc.Namespace
+			// No token file so the token loaders aren't used
+			secretStoreInfo.TokenFile = ""
+
+			secretConfig, err := getSecretConfig(&secretStoreInfo, nil, nil, "testServiceKey", lc, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Namespace, secretConfig.Namespace)
+			secretConfig.Authentication.AuthToken = "Test Token"
+
+			secretClient, err := secrets.NewSecretsClient(context.Background(), secretConfig, lc, nil)
+			require.NoError(t, err)
+
+			target := NewSecureProvider(context.Background(), &secretStoreInfo, lc, nil, nil, "testServiceKey")
+			target.SetClient(secretClient)
+
+			_, err = target.GetSecret(expectedSecretName)
+			require.NoError(t, err)
+			require.NoError(t, target.StoreSecret(expectedSecretName, expectedSecrets))
+
+			require.Len(t, namespaces, 2)
+			for i := range namespaces {
+				assert.Equal(t, tc.Namespace, namespaces[i])
+				// The header is omitted rather than sent empty when no namespace is configured
+				assert.Equal(t, len(tc.Namespace) > 0, headerSent[i])
+			}
+		})
+	}
+}
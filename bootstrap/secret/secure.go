@@ -58,6 +58,8 @@ type SecureProvider struct {
 	serviceKey                         string
 	secretStoreInfo                    config.SecretStoreInfo
 	secretsCache                       map[string]map[string]string // secret's secretName, key, value
+	secretsCachedAt                    map[string]time.Time         // secret's secretName, time first cached
+	cacheTTL                           time.Duration
 	cacheMutex                         *sync.RWMutex
 	lastUpdated                        time.Time
 	ctx                                context.Context
@@ -70,6 +72,7 @@ type SecureProvider struct {
 	securityGetSecretDuration          gometrics.Timer
 	httpRoundTripper                   http.RoundTripper
 	zeroTrustEnabled                   bool
+	sealMonitor                        *SealMonitor
 }
 
 // NewSecureProvider creates & initializes Provider instance for secure secrets.
@@ -83,6 +86,7 @@ func NewSecureProvider(ctx context.Context, secretStoreInfo *config.SecretStoreI
 		serviceKey:                         serviceKey,
 		secretStoreInfo:                    *secretStoreInfo,
 		secretsCache:                       make(map[string]map[string]string),
+		secretsCachedAt:                    make(map[string]time.Time),
 		cacheMutex:                         &sync.RWMutex{},
 		lastUpdated:                        time.Now(),
 		ctx:                                ctx,
@@ -97,6 +101,32 @@ func NewSecureProvider(ctx context.Context, secretStoreInfo *config.SecretStoreI
 	return provider
 }
 
+// SetSealMonitor sets the monitor used to detect changes to the SecretStore's seal state
+func (p *SecureProvider) SetSealMonitor(monitor *SealMonitor) {
+	p.sealMonitor = monitor
+}
+
+// SetCacheTTL sets how long the secrets retrieved from the secret store are cached for, after which they are
+// retrieved from the secret store again. The cached secrets are kept until secrets are stored when the TTL is 0.
+func (p *SecureProvider) SetCacheTTL(ttl time.Duration) {
+	p.cacheMutex.Lock()
+	defer p.cacheMutex.Unlock()
+
+	p.cacheTTL = ttl
+}
+
+// RegisterSealStateChangedCallback registers a callback which is called with the new seal state when the
+// SecretStore becomes sealed or unsealed. Polling of the seal state starts when the first callback is registered.
+func (p *SecureProvider) RegisterSealStateChangedCallback(callback func(sealed bool)) error {
+	if p.sealMonitor == nil {
+		return errors.New("can't register seal state callback. SecretStore seal state monitoring is not available")
+	}
+
+	p.sealMonitor.RegisterCallback(callback)
+	p.sealMonitor.Start(p.ctx)
+	return nil
+}
+
 // SetClient sets the secret client that is used to access the secure secrets
 func (p *SecureProvider) SetClient(client secrets.SecretClient) {
 	p.secretClient = client
@@ -135,6 +165,13 @@ func (p *SecureProvider) GetSecret(secretName string, keys ...string) (map[strin
 	return secureSecrets, nil
 }
 
+// GetSecrets retrieves all the keys of the secrets at each of the secretNames from the secret store. The secret client
+// has no batch retrieval, so the secrets are retrieved in turn with a request for each.
+// The secrets which were retrieved are returned along with an error aggregating the failures, if any.
+func (p *SecureProvider) GetSecrets(secretNames ...string) (map[string]map[string]string, error) {
+	return getSecretsSequentially(p.GetSecret, secretNames)
+}
+
 func (p *SecureProvider) getSecretsCache(secretName string, keys ...string) map[string]string {
 	secureSecrets := make(map[string]string)
 
@@ -147,7 +184,7 @@ func (p *SecureProvider) getSecretsCache(secretName string, keys ...string) map[
 	cachedSecrets, cacheExists := p.secretsCache[secretName]
 	value := ""
 
-	if cacheExists {
+	if cacheExists && !p.isCacheExpired(secretName) {
 		for _, key := range keys {
 			value, allKeysExistInCache = cachedSecrets[key]
 			if !allKeysExistInCache {
@@ -170,8 +207,9 @@ func (p *SecureProvider) updateSecretsCache(secretName string, secrets map[strin
 	p.cacheMutex.Lock()
 	defer p.cacheMutex.Unlock()
 
-	if _, cacheExists := p.secretsCache[secretName]; !cacheExists {
+	if _, cacheExists := p.secretsCache[secretName]; !cacheExists || p.isCacheExpired(secretName) {
 		p.secretsCache[secretName] = secrets
+		p.secretsCachedAt[secretName] = time.Now()
 	}
 
 	for key, value := range secrets {
@@ -179,6 +217,12 @@ func (p *SecureProvider) updateSecretsCache(secretName string, secrets map[strin
 	}
 }
 
+// isCacheExpired returns true when the secretName was cached longer ago than the TTL. The keys added to a cached
+// secretName expire along with it. The caller must hold the cacheMutex.
+func (p *SecureProvider) isCacheExpired(secretName string) bool {
+	return p.cacheTTL > 0 && time.Since(p.secretsCachedAt[secretName]) >= p.cacheTTL
+}
+
 // StoreSecret stores the secrets to a secret store.
 // it sets the values requested at provided keys
 // secretName specifies the type or location of the secrets to store
@@ -209,6 +253,7 @@ func (p *SecureProvider) StoreSecret(secretName string, secrets map[string]strin
 	p.cacheMutex.Lock()
 	// Clearing cache because adding a new secret(p) possibly invalidates the previous cache
 	p.secretsCache = make(map[string]map[string]string)
+	p.secretsCachedAt = make(map[string]time.Time)
 	p.cacheMutex.Unlock()
 	//indicate to the SDK that the cache has been invalidated
 	p.lastUpdated = time.Now()
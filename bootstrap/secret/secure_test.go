@@ -126,6 +126,69 @@ func TestSecureProvider_GetSecrets_Cached_Invalidated(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSecureProvider_GetSecrets_Cached_Expired(t *testing.T) {
+	expected := map[string]string{"username": "admin", "password": "sam123!"}
+	rotated := map[string]string{"username": "admin", "password": "rotated"}
+
+	mock := &mocks.SecretClient{}
+	mock.On("GetSecret", "redis", "username", "password").Return(expected, nil).Once()
+	mock.On("GetSecret", "redis", "username", "password").Return(rotated, nil).Once()
+
+	target := NewSecureProvider(context.Background(), secretStoreConfig(t), logger.MockLogger{}, nil, nil, "testService")
+	target.SetClient(mock)
+	target.SetCacheTTL(time.Millisecond * 50)
+
+	actual, err := target.GetSecret("redis", "username", "password")
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	// Served from the cache within the TTL
+	actual, err = target.GetSecret("redis", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "sam123!"}, actual)
+
+	// Retrieved from the secret store again once the TTL has passed
+	time.Sleep(time.Millisecond * 60)
+	actual, err = target.GetSecret("redis", "username", "password")
+	require.NoError(t, err)
+	assert.Equal(t, rotated, actual)
+
+	actual, err = target.GetSecret("redis", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "rotated"}, actual)
+
+	mock.AssertExpectations(t)
+}
+
+func TestSecureProvider_GetSecrets_Sequential(t *testing.T) {
+	redis := map[string]string{"username": "admin", "password": "sam123!"}
+	mqtt := map[string]string{"username": "mqtt", "password": "mqtt123!"}
+
+	mock := &mocks.SecretClient{}
+	mock.On("GetSecret", "redis").Return(redis, nil)
+	mock.On("GetSecret", "mqtt").Return(mqtt, nil)
+	mock.On("GetSecret", "missing").Return(nil, pkg.NewErrSecretsNotFound(nil))
+
+	target := NewSecureProvider(context.Background(), secretStoreConfig(t), logger.MockLogger{}, nil, nil, "testService")
+	target.SetClient(mock)
+
+	actual, err := target.GetSecrets("redis", "mqtt")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{"redis": redis, "mqtt": mqtt}, actual)
+	mock.AssertNumberOfCalls(t, "GetSecret", 2)
+
+	actual, err = target.GetSecrets("redis", "missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get secret 'missing'")
+	assert.Equal(t, map[string]map[string]string{"redis": redis}, actual)
+
+	// Without a client every secret fails
+	target.SetClient(nil)
+	actual, err = target.GetSecrets("redis")
+	require.Error(t, err)
+	assert.Empty(t, actual)
+}
+
 func TestSecureProvider_StoreSecrets_Secure(t *testing.T) {
 	input := map[string]string{"username": "admin", "password": "sam123!"}
 	mock := &mocks.SecretClient{}
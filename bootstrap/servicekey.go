@@ -0,0 +1,55 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package bootstrap
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// maxServiceKeyLength is the longest service key, as the key is used as a DNS label, i.e. for Kubernetes discovery
+const maxServiceKeyLength = 63
+
+// serviceKeyRegex matches the valid service keys, which are used in the Registry, Configuration Provider paths,
+// MessageBus topics and DNS names, so are restricted to letters, digits, '-' and '_' and can't start or end with
+// '-' or '_'
+var serviceKeyRegex = regexp.MustCompile("^[a-zA-Z0-9]([a-zA-Z0-9_-]*[a-zA-Z0-9])?$")
+
+// resolveServiceKey returns the service key to use, which is the override from the -sk/--serviceKey flag when
+// specified, otherwise the service's compiled-in key. An invalid override is an error, returned with the
+// compiled-in key.
+func resolveServiceKey(serviceKey string, override string) (string, error) {
+	if len(override) == 0 {
+		return serviceKey, nil
+	}
+
+	if err := validateServiceKey(override); err != nil {
+		return serviceKey, err
+	}
+
+	return override, nil
+}
+
+// validateServiceKey checks the service key is in the valid format
+func validateServiceKey(serviceKey string) error {
+	if len(serviceKey) > maxServiceKeyLength {
+		return fmt.Errorf("service key '%s' is longer than %d characters", serviceKey, maxServiceKeyLength)
+	}
+
+	if !serviceKeyRegex.MatchString(serviceKey) {
+		return fmt.Errorf("service key '%s' is invalid, must only contain letters, digits, '-' and '_' and start and end with a letter or digit", serviceKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package bootstrap
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-registry/v3/registry"
+	registryMocks "github.com/edgexfoundry/go-mod-registry/v3/registry/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/flags"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+func TestResolveServiceKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		override    string
+		expectedKey string
+		expectError bool
+	}{
+		{"no override", "", "core-data", false},
+		{"valid override", "core-data-2", "core-data-2", false},
+		{"valid override with underscore", "core_data_2", "core_data_2", false},
+		{"invalid - slash", "edgex/core-data", "core-data", true},
+		{"invalid - space", "core data", "core-data", true},
+		{"invalid - leading dash", "-core-data", "core-data", true},
+		{"invalid - trailing underscore", "core-data_", "core-data", true},
+		{"invalid - too long", strings.Repeat("a", maxServiceKeyLength+1), "core-data", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := resolveServiceKey("core-data", test.override)
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedKey, actual)
+		})
+	}
+}
+
+func TestRunAndReturnWaitGroup_ServiceKeyOverride(t *testing.T) {
+	configDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "configuration.yaml"), []byte(validateTestConfig), 0600))
+
+	registryClient := &registryMocks.Client{}
+	registryClient.On("Unregister").Return(nil)
+
+	var registeredKey string
+	defaultRegisterWithRegistry := registerWithRegistry
+	registerWithRegistry = func(_ context.Context, _ startup.Timer, _ interfaces.Configuration, _ logger.LoggingClient,
+		serviceKey string, _ *di.Container) (registry.Client, error) {
+		registeredKey = serviceKey
+		return registryClient, nil
+	}
+	defer func() { registerWithRegistry = defaultRegisterWithRegistry }()
+
+	commonFlags := flags.New()
+	commonFlags.Parse([]string{"-r", "-sk=core-data-2", "-cd=" + configDir})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+
+	wg, _, started := RunAndReturnWaitGroup(ctx, cancel, commonFlags, "core-data", "edgex/v3",
+		&validateTestConfiguration{}, nil, startup.NewTimer(1, 1), dic, false, "unit-test", nil)
+	require.True(t, started)
+
+	assert.Equal(t, "core-data-2", registeredKey)
+	assert.Equal(t, "core-data-2", container.ServiceKeyFrom(dic.Get))
+
+	cancel()
+	wg.Wait()
+	registryClient.AssertExpectations(t)
+}
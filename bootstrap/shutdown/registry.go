@@ -0,0 +1,97 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package shutdown
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+)
+
+// The priorities of the cleanup functions of the common kinds of resources, so the service stops accepting new
+// requests before the resources used to process them are released.
+const (
+	// PriorityRegistry is the priority for un-registering the service from the Registry, so upstreams stop routing
+	// requests to the service before its servers are stopped
+	PriorityRegistry = 50
+	// PriorityServers is the priority for stopping the servers which accept requests, i.e. the HTTP server
+	PriorityServers = 100
+	// PriorityMetrics is the priority for the final report of the metrics, so the metrics recorded while the servers
+	// drained the in-flight requests are published before the MessageBus is disconnected
+	PriorityMetrics = 150
+	// PriorityClients is the priority for disconnecting the clients of other services, i.e. the MessageBus
+	PriorityClients = 200
+	// PriorityDatabases is the priority for closing the database connection pools
+	PriorityDatabases = 300
+)
+
+type cleanupFunc struct {
+	name     string
+	priority int
+	cleanup  func()
+}
+
+// Registry implements the interfaces.ShutdownRegistry interface, running the registered cleanup functions in
+// priority order when Run is called on shutdown.
+type Registry struct {
+	lc       logger.LoggingClient
+	cleanups []cleanupFunc
+	mutex    sync.Mutex
+}
+
+// NewRegistry creates a new Registry with no cleanup functions registered.
+func NewRegistry(lc logger.LoggingClient) *Registry {
+	return &Registry{
+		lc: lc,
+	}
+}
+
+// Register registers the named cleanup function with the priority.
+func (r *Registry) Register(name string, priority int, cleanup func()) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.cleanups = append(r.cleanups, cleanupFunc{name: name, priority: priority, cleanup: cleanup})
+}
+
+// Run runs the registered cleanup functions in ascending order of priority, those of equal priority in the order
+// registered. A cleanup function which panics is logged and doesn't prevent the remaining functions from running.
+// The cleanup functions are only run once.
+func (r *Registry) Run() {
+	r.mutex.Lock()
+	cleanups := r.cleanups
+	r.cleanups = nil
+	r.mutex.Unlock()
+
+	sort.SliceStable(cleanups, func(i, j int) bool {
+		return cleanups[i].priority < cleanups[j].priority
+	})
+
+	for _, cleanup := range cleanups {
+		r.run(cleanup)
+	}
+}
+
+func (r *Registry) run(cleanup cleanupFunc) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			r.lc.Errorf("shutdown cleanup '%s' panicked: %v", cleanup.name, recovered)
+		}
+	}()
+
+	r.lc.Debugf("running shutdown cleanup '%s'", cleanup.name)
+	cleanup.cleanup()
+}
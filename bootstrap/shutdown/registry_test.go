@@ -0,0 +1,51 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package shutdown
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistryRun(t *testing.T) {
+	var ran []string
+	target := NewRegistry(logger.NewMockClient())
+
+	target.Register("database", PriorityDatabases, func() { ran = append(ran, "database") })
+	target.Register("messagebus", PriorityClients, func() { ran = append(ran, "messagebus") })
+	target.Register("http", PriorityServers, func() { ran = append(ran, "http") })
+	target.Register("grpc", PriorityServers, func() { ran = append(ran, "grpc") })
+
+	target.Run()
+	assert.Equal(t, []string{"http", "grpc", "messagebus", "database"}, ran)
+
+	// The cleanup functions only run once
+	target.Run()
+	assert.Len(t, ran, 4)
+}
+
+func TestRegistryRun_Panic(t *testing.T) {
+	var ran []string
+	target := NewRegistry(logger.NewMockClient())
+
+	target.Register("first", 1, func() { ran = append(ran, "first") })
+	target.Register("panics", 2, func() { panic("failed to close") })
+	target.Register("last", 3, func() { ran = append(ran, "last") })
+
+	assert.NotPanics(t, target.Run)
+	assert.Equal(t, []string{"first", "last"}, ran)
+}
@@ -16,6 +16,7 @@
 package startup
 
 import (
+	"math/rand"
 	"time"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/environment"
@@ -26,6 +27,8 @@ type Timer struct {
 	startTime time.Time
 	duration  time.Duration
 	interval  time.Duration
+	deadline  time.Duration
+	jitter    float64
 }
 
 // NewStartUpTimer is a factory method that returns an initialized Timer receiver struct.
@@ -36,10 +39,13 @@ func NewStartUpTimer(serviceKey string) Timer {
 		startTime: time.Now(),
 		duration:  time.Second * time.Duration(startup.Duration),
 		interval:  time.Second * time.Duration(startup.Interval),
+		deadline:  time.Second * time.Duration(startup.Deadline),
+		jitter:    startup.Jitter,
 	}
 }
 
 // NewTimer is a factory method that returns a Timer initialized with passed in duration and interval.
+// The interval has no jitter unless set using WithJitter.
 func NewTimer(duration int, interval int) Timer {
 	return Timer{
 		startTime: time.Now(),
@@ -48,6 +54,31 @@ func NewTimer(duration int, interval int) Timer {
 	}
 }
 
+// WithDeadline returns a copy of the Timer with the absolute startup deadline set to the passed in number of seconds.
+func (t Timer) WithDeadline(deadline int) Timer {
+	t.deadline = time.Second * time.Duration(deadline)
+	return t
+}
+
+// WithJitter returns a copy of the Timer with the interval randomly spread by the passed in fraction of the interval,
+// i.e. 0.2 sleeps between 80% and 120% of the interval. Zero disables the jitter.
+func (t Timer) WithJitter(jitter float64) Timer {
+	t.jitter = jitter
+	return t
+}
+
+// Deadline returns the absolute time the whole bootstrap must complete within, which is separate from the duration
+// that dependencies are retried for. Zero means there is no deadline.
+func (t Timer) Deadline() time.Duration {
+	return t.deadline
+}
+
+// RemainingUntilDeadline returns the time remaining until the startup deadline is exceeded, which is zero or negative
+// once exceeded. Only meaningful when there is a deadline.
+func (t Timer) RemainingUntilDeadline() time.Duration {
+	return t.deadline - time.Since(t.startTime)
+}
+
 // SinceAsString returns the time since the timer was created as a string.
 func (t Timer) SinceAsString() string {
 	return time.Since(t.startTime).String()
@@ -55,12 +86,22 @@ func (t Timer) SinceAsString() string {
 
 // RemainingAsString returns the time remaining on the timer as a string.
 func (t Timer) RemainingAsString() string {
+	return t.RemainingAsDuration().String()
+}
 
+// RemainingAsDuration returns the time remaining on the timer, which is zero once the timer has elapsed.
+// Handlers which do their own retries use this to avoid overshooting the overall startup window.
+func (t Timer) RemainingAsDuration() time.Duration {
 	remaining := t.duration - time.Since(t.startTime)
 	if remaining < 0 {
 		remaining = 0
 	}
-	return remaining.String()
+	return remaining
+}
+
+// Interval returns the retry interval specified during construction.
+func (t Timer) Interval() time.Duration {
+	return t.interval
 }
 
 // HasNotElapsed returns whether or not the duration specified during construction has elapsed.
@@ -68,7 +109,32 @@ func (t Timer) HasNotElapsed() bool {
 	return time.Now().Before(t.startTime.Add(t.duration))
 }
 
-// SleepForInterval pauses execution for the interval specified during construction.
+// HasElapsed returns whether or not the duration specified during construction has elapsed.
+func (t Timer) HasElapsed() bool {
+	return !t.HasNotElapsed()
+}
+
+// SleepForInterval pauses execution for the interval specified during construction with any jitter applied, or only
+// for the time remaining on the timer when that is shorter.
 func (t Timer) SleepForInterval() {
-	time.Sleep(t.interval)
+	time.Sleep(t.nextSleep())
+}
+
+// nextSleep returns the jittered interval capped to the time remaining on the timer.
+func (t Timer) nextSleep() time.Duration {
+	sleep := t.jitteredInterval()
+	if remaining := t.RemainingAsDuration(); remaining < sleep {
+		sleep = remaining
+	}
+	return sleep
+}
+
+// jitteredInterval returns the interval randomly adjusted by up to the jitter fraction in either direction.
+func (t Timer) jitteredInterval() time.Duration {
+	if t.jitter <= 0 {
+		return t.interval
+	}
+
+	delta := (rand.Float64()*2 - 1) * t.jitter * float64(t.interval)
+	return t.interval + time.Duration(delta)
 }
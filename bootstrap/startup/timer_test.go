@@ -0,0 +1,80 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package startup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimerRemainingAsDuration(t *testing.T) {
+	timer := NewTimer(60, 1)
+
+	first := timer.RemainingAsDuration()
+	assert.LessOrEqual(t, first, time.Second*60)
+	assert.Greater(t, first, time.Second*59)
+
+	time.Sleep(time.Millisecond * 10)
+	assert.Less(t, timer.RemainingAsDuration(), first)
+}
+
+func TestTimerHasNotElapsed(t *testing.T) {
+	timer := Timer{startTime: time.Now(), duration: time.Millisecond * 50, interval: time.Second}
+
+	assert.True(t, timer.HasNotElapsed())
+	assert.False(t, timer.HasElapsed())
+
+	time.Sleep(time.Millisecond * 60)
+
+	assert.False(t, timer.HasNotElapsed())
+	assert.True(t, timer.HasElapsed())
+	assert.Equal(t, time.Duration(0), timer.RemainingAsDuration())
+}
+
+func TestTimerSleepForIntervalCappedToRemaining(t *testing.T) {
+	timer := Timer{startTime: time.Now(), duration: time.Millisecond * 50, interval: time.Second * 30}
+
+	assert.LessOrEqual(t, timer.nextSleep(), time.Millisecond*50)
+
+	started := time.Now()
+	timer.SleepForInterval()
+	assert.Less(t, time.Since(started), time.Second)
+	assert.Equal(t, time.Second*30, timer.Interval())
+}
+
+func TestTimerJitter(t *testing.T) {
+	timer := NewTimer(60, 10).WithJitter(0.2)
+
+	varied := false
+	for i := 0; i < 100; i++ {
+		interval := timer.jitteredInterval()
+		assert.GreaterOrEqual(t, interval, time.Second*8)
+		assert.LessOrEqual(t, interval, time.Second*12)
+		if interval != time.Second*10 {
+			varied = true
+		}
+	}
+	assert.True(t, varied, "expected jitter to vary the interval")
+}
+
+func TestTimerNoJitter(t *testing.T) {
+	for _, timer := range []Timer{NewTimer(60, 10), NewTimer(60, 10).WithJitter(0)} {
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, time.Second*10, timer.jitteredInterval())
+		}
+	}
+}
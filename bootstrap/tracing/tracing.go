@@ -0,0 +1,221 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package tracing provides the helpers to propagate the W3C Trace Context (https://www.w3.org/TR/trace-context/)
+// headers from the service's incoming requests to the requests it sends with the EdgeX clients. The service doesn't
+// record spans of its own, so the caller's traceparent is forwarded as is.
+//
+// The EdgeX clients build their requests without the caller's context, only copying its correlation id to the
+// X-Correlation-ID header, so the trace context of each request being handled is also tracked by its correlation id
+// for the clients' requests to be matched with it.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	clientinterfaces "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+)
+
+const (
+	TraceparentHeader = "traceparent"
+	TracestateHeader  = "tracestate"
+)
+
+type contextKey struct{}
+
+// TraceContext holds the W3C Trace Context headers of a request
+type TraceContext struct {
+	Traceparent string
+	Tracestate  string
+}
+
+// FromRequest returns the trace context from the request's headers. False is returned when the request doesn't
+// have a valid traceparent header, in which case the tracestate header is ignored as required by the specification.
+func FromRequest(r *http.Request) (TraceContext, bool) {
+	traceparent := strings.TrimSpace(r.Header.Get(TraceparentHeader))
+	if !isValidTraceparent(traceparent) {
+		return TraceContext{}, false
+	}
+
+	return TraceContext{
+		Traceparent: traceparent,
+		Tracestate:  strings.Join(r.Header.Values(TracestateHeader), ","),
+	}, true
+}
+
+// NewContext returns a copy of the context holding the trace context
+func NewContext(ctx context.Context, tc TraceContext) context.Context {
+	return context.WithValue(ctx, contextKey{}, tc)
+}
+
+// FromContext returns the trace context held by the context and false when it doesn't hold one.
+func FromContext(ctx context.Context) (TraceContext, bool) {
+	tc, ok := ctx.Value(contextKey{}).(TraceContext)
+	return tc, ok
+}
+
+// InjectRequest sets the trace context held by the context in the outgoing request's headers, if it holds one.
+func InjectRequest(ctx context.Context, r *http.Request) {
+	tc, ok := FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	r.Header.Set(TraceparentHeader, tc.Traceparent)
+	if len(tc.Tracestate) > 0 {
+		r.Header.Set(TracestateHeader, tc.Tracestate)
+	}
+}
+
+// tracked holds the trace contexts of the requests being handled by their correlation id
+var tracked = struct {
+	sync.RWMutex
+	requests map[string]*trackedRequest
+}{requests: map[string]*trackedRequest{}}
+
+type trackedRequest struct {
+	tc   TraceContext
+	refs int
+}
+
+// Track records the trace context of the request being handled with the correlation id, until the returned function
+// is called once the request has been handled. The most recent trace context is used when concurrent requests share
+// the correlation id.
+func Track(correlationID string, tc TraceContext) (untrack func()) {
+	if len(correlationID) == 0 {
+		return func() {}
+	}
+
+	tracked.Lock()
+	defer tracked.Unlock()
+
+	request, ok := tracked.requests[correlationID]
+	if !ok {
+		request = &trackedRequest{}
+		tracked.requests[correlationID] = request
+	}
+	request.tc = tc
+	request.refs++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			tracked.Lock()
+			defer tracked.Unlock()
+
+			request.refs--
+			if request.refs == 0 {
+				delete(tracked.requests, correlationID)
+			}
+		})
+	}
+}
+
+// fromCorrelationID returns the trace context tracked with the correlation id and false when there isn't one.
+func fromCorrelationID(correlationID string) (TraceContext, bool) {
+	if len(correlationID) == 0 {
+		return TraceContext{}, false
+	}
+
+	tracked.RLock()
+	defer tracked.RUnlock()
+
+	request, ok := tracked.requests[correlationID]
+	if !ok {
+		return TraceContext{}, false
+	}
+	return request.tc, true
+}
+
+// RoundTripper returns a RoundTripper which sets the trace context of each request in its headers before sending it
+// with next. The trace context is the one held by the request's context, or else the one tracked with the request's
+// correlation id. Requests which already have a traceparent header, or without a trace context, are sent unchanged.
+func RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if len(r.Header.Get(TraceparentHeader)) > 0 {
+			return next.RoundTrip(r)
+		}
+
+		tc, ok := FromContext(r.Context())
+		if !ok {
+			tc, ok = fromCorrelationID(r.Header.Get(common.CorrelationHeader))
+		}
+		if !ok {
+			return next.RoundTrip(r)
+		}
+
+		// A RoundTripper must not modify the caller's request
+		r = r.Clone(r.Context())
+		InjectRequest(NewContext(r.Context(), tc), r)
+		return next.RoundTrip(r)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// NewAuthenticationInjector returns the AuthenticationInjector for the EdgeX clients which propagates the trace
+// context of their requests, after they're authenticated by the injector.
+func NewAuthenticationInjector(injector clientinterfaces.AuthenticationInjector) clientinterfaces.AuthenticationInjector {
+	return &authenticationInjector{AuthenticationInjector: injector}
+}
+
+type authenticationInjector struct {
+	clientinterfaces.AuthenticationInjector
+}
+
+func (a *authenticationInjector) RoundTripper() http.RoundTripper {
+	return RoundTripper(a.AuthenticationInjector.RoundTripper())
+}
+
+// isValidTraceparent checks the traceparent is version-traceid-parentid-flags with lowercase hex fields. Versions
+// after 00 may append fields, which are ignored.
+func isValidTraceparent(traceparent string) bool {
+	fields := strings.Split(traceparent, "-")
+	if len(fields) < 4 {
+		return false
+	}
+
+	version, traceID, parentID, flags := fields[0], fields[1], fields[2], fields[3]
+	if !isHex(version, 2) || version == "ff" || (version == "00" && len(fields) != 4) {
+		return false
+	}
+
+	return isHex(traceID, 32) && !isZero(traceID) &&
+		isHex(parentID, 16) && !isZero(parentID) &&
+		isHex(flags, 2)
+}
+
+func isHex(s string, length int) bool {
+	if len(s) != length {
+		return false
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func isZero(s string) bool {
+	return strings.Trim(s, "0") == ""
+}
@@ -0,0 +1,156 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	testTracestate  = "rojo=00f067aa0ba902b7,congo=t61rcWkgMzE"
+
+	testCorrelationID = "0f7c2ab9-1e04-4d1b-8a48-1b7a8d0e6a10"
+)
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		traceparent string
+		expectedOk  bool
+	}{
+		{"valid", testTraceparent, true},
+		{"valid future version with extra field", "cc-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-what", true},
+		{"missing", "", false},
+		{"version 00 with extra field", testTraceparent + "-01", false},
+		{"invalid version", "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", false},
+		{"uppercase", "00-4BF92F3577B34DA6A3CE929D0E0E4736-00F067AA0BA902B7-01", false},
+		{"zero trace id", "00-00000000000000000000000000000000-00f067aa0ba902b7-01", false},
+		{"zero parent id", "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", false},
+		{"short trace id", "00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01", false},
+		{"missing flags", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/api/v3/ping", nil)
+			if len(tt.traceparent) > 0 {
+				request.Header.Set(TraceparentHeader, tt.traceparent)
+			}
+			request.Header.Set(TracestateHeader, testTracestate)
+
+			tc, ok := FromRequest(request)
+			require.Equal(t, tt.expectedOk, ok)
+			if ok {
+				assert.Equal(t, TraceContext{Traceparent: tt.traceparent, Tracestate: testTracestate}, tc)
+			}
+		})
+	}
+}
+
+func TestContextRoundTrip(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	expected := TraceContext{Traceparent: testTraceparent, Tracestate: testTracestate}
+	tc, ok := FromContext(NewContext(context.Background(), expected))
+	require.True(t, ok)
+	assert.Equal(t, expected, tc)
+}
+
+func TestRoundTripper(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: RoundTripper(http.DefaultTransport)}
+	traced := NewContext(context.Background(), TraceContext{Traceparent: testTraceparent})
+
+	request, err := http.NewRequestWithContext(traced, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Equal(t, testTraceparent, received.Get(TraceparentHeader))
+	assert.Empty(t, received.Get(TracestateHeader))
+	assert.Empty(t, request.Header.Get(TraceparentHeader), "the caller's request must not be modified")
+
+	// the traceparent already set by the caller is kept
+	existing := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-00"
+	request, err = http.NewRequestWithContext(traced, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	request.Header.Set(TraceparentHeader, existing)
+	response, err = client.Do(request)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Equal(t, existing, received.Get(TraceparentHeader))
+
+	// no-op without a trace context
+	request, err = http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	response, err = client.Do(request)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Empty(t, received.Get(TraceparentHeader))
+}
+
+func TestRoundTripperTrackedCorrelationID(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: RoundTripper(http.DefaultTransport)}
+	send := func(correlationID string) {
+		// the EdgeX clients send their requests without the caller's context
+		request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		request.Header.Set(common.CorrelationHeader, correlationID)
+		response, err := client.Do(request)
+		require.NoError(t, err)
+		_ = response.Body.Close()
+	}
+
+	untrack := Track(testCorrelationID, TraceContext{Traceparent: testTraceparent, Tracestate: testTracestate})
+	send(testCorrelationID)
+	assert.Equal(t, testTraceparent, received.Get(TraceparentHeader))
+	assert.Equal(t, testTracestate, received.Get(TracestateHeader))
+
+	send("other-correlation-id")
+	assert.Empty(t, received.Get(TraceparentHeader))
+
+	// a concurrent request with the same correlation id keeps it tracked until both are handled
+	untrackOther := Track(testCorrelationID, TraceContext{Traceparent: testTraceparent})
+	untrack()
+	untrack()
+	send(testCorrelationID)
+	assert.Equal(t, testTraceparent, received.Get(TraceparentHeader))
+
+	untrackOther()
+	send(testCorrelationID)
+	assert.Empty(t, received.Get(TraceparentHeader))
+
+	_, ok := fromCorrelationID(testCorrelationID)
+	assert.False(t, ok)
+}
@@ -0,0 +1,218 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/environment"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/flags"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/registration"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+const authModeNone = "none"
+
+var (
+	// validateOutput is where the validation report is written, replaced by the tests
+	validateOutput io.Writer = os.Stdout
+	// newSecretProvider creates the SecretProvider, replaced by the tests
+	newSecretProvider = secret.NewSecretProvider
+)
+
+// validationCheck is the outcome of one of the checks made when validating the service
+type validationCheck struct {
+	name    string
+	err     error
+	skipped string
+}
+
+// validationReport collects the outcome of the checks made when validating the service
+type validationReport struct {
+	checks []validationCheck
+}
+
+func (r *validationReport) add(name string, err error) {
+	r.checks = append(r.checks, validationCheck{name: name, err: err})
+}
+
+func (r *validationReport) skip(name string, reason string) {
+	r.checks = append(r.checks, validationCheck{name: name, skipped: reason})
+}
+
+// passed returns whether none of the checks failed
+func (r *validationReport) passed() bool {
+	for _, check := range r.checks {
+		if check.err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// write writes the outcome of each check followed by the overall result
+func (r *validationReport) write(out io.Writer, serviceKey string) {
+	_, _ = fmt.Fprintf(out, "Validation report for %s:\n", serviceKey)
+
+	failed := 0
+	for _, check := range r.checks {
+		switch {
+		case check.err != nil:
+			failed++
+			_, _ = fmt.Fprintf(out, "  FAIL  %s: %s\n", check.name, check.err.Error())
+		case len(check.skipped) > 0:
+			_, _ = fmt.Fprintf(out, "  SKIP  %s: %s\n", check.name, check.skipped)
+		default:
+			_, _ = fmt.Fprintf(out, "  PASS  %s\n", check.name)
+		}
+	}
+
+	if failed > 0 {
+		_, _ = fmt.Fprintf(out, "Validation failed: %d of %d checks failed\n", failed, len(r.checks))
+		return
+	}
+
+	_, _ = fmt.Fprintln(out, "Validation passed")
+}
+
+// validateService checks the service's configuration and the dependencies its bootstrap handlers need, i.e. the
+// configuration loads and is valid, the configured secrets exist and the Registry is available, and writes a report
+// of the checks to out. The bootstrap handlers aren't run, so no ports are bound and no loops are started. Returns
+// whether all the checks passed.
+func validateService(
+	ctx context.Context,
+	commonFlags flags.Common,
+	envVars *environment.Variables,
+	serviceKey string,
+	configStem string,
+	serviceConfig interfaces.Configuration,
+	startupTimer startup.Timer,
+	dic *di.Container,
+	useSecretProvider bool,
+	serviceType string,
+	lc logger.LoggingClient,
+	out io.Writer) bool {
+
+	report := &validationReport{}
+	defer func() {
+		report.write(out, serviceKey)
+	}()
+
+	var secretProvider interfaces.SecretProviderExt
+	if useSecretProvider {
+		var err error
+		secretProvider, err = newSecretProvider(serviceConfig, envVars, ctx, startupTimer, dic, serviceKey)
+		report.add("SecretProvider", err)
+		if err != nil {
+			return false
+		}
+	}
+
+	// The Processor doesn't listen for changes once the context is cancelled
+	var wg sync.WaitGroup
+	processor := config.NewProcessor(commonFlags, envVars, startupTimer, ctx, &wg, nil, dic)
+	err := processor.Process(serviceKey, serviceType, configStem, serviceConfig, secretProvider, secret.NewJWTSecretProvider(secretProvider))
+	report.add("Configuration", err)
+	if err != nil {
+		return false
+	}
+
+	secretNames := configuredSecretNames(serviceConfig.GetBootstrap())
+	for _, secretName := range secretNames {
+		name := fmt.Sprintf("Secret '%s'", secretName)
+		if secretProvider == nil {
+			report.skip(name, "service doesn't use the SecretProvider")
+			continue
+		}
+
+		exists, err := secretProvider.HasSecret(secretName)
+		if err == nil && !exists {
+			err = errors.New("secret not found in the SecretStore")
+		}
+		report.add(name, err)
+	}
+
+	envUseRegistry, wasOverridden := envVars.UseRegistry()
+	if envUseRegistry || (commonFlags.UseRegistry() && !wasOverridden) {
+		report.add("Registry", registration.CheckRegistry(serviceConfig, lc, serviceKey, dic))
+	} else {
+		report.skip("Registry", "service doesn't use the Registry")
+	}
+
+	return report.passed()
+}
+
+// configuredSecretNames returns the names of the secrets referenced by the bootstrap configuration, which the
+// bootstrap handlers retrieve from the SecretProvider
+func configuredSecretNames(configuration bootstrapConfig.BootstrapConfiguration) []string {
+	names := make(map[string]bool)
+	add := func(name string) {
+		if len(strings.TrimSpace(name)) > 0 {
+			names[name] = true
+		}
+	}
+	usesAuth := func(authMode string) bool {
+		return len(authMode) > 0 && !strings.EqualFold(authMode, authModeNone)
+	}
+	addMessageBus := func(messageBus bootstrapConfig.MessageBusInfo) {
+		if messageBus.Disabled {
+			return
+		}
+		if usesAuth(messageBus.AuthMode) {
+			add(messageBus.SecretName)
+		}
+		add(messageBus.TLSSecretName)
+	}
+
+	if configuration.Service != nil {
+		add(configuration.Service.TLSSecretName)
+		add(configuration.Service.GrpcServer.TLSSecretName)
+	}
+
+	if configuration.MessageBus != nil {
+		addMessageBus(*configuration.MessageBus)
+	}
+
+	for _, messageBus := range configuration.MessageBuses {
+		addMessageBus(messageBus)
+	}
+
+	if configuration.ExternalMQTT != nil && len(configuration.ExternalMQTT.Url) > 0 &&
+		usesAuth(configuration.ExternalMQTT.AuthMode) {
+		add(configuration.ExternalMQTT.SecretName)
+	}
+
+	var result []string
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result
+}
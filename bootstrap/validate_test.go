@@ -0,0 +1,219 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/environment"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/flags"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+const validateTestConfig = `
+Writable:
+  LogLevel: INFO
+Service:
+  Host: localhost
+  Port: 59999
+MessageBus:
+  Type: mqtt
+  Host: localhost
+  Port: 1883
+  AuthMode: usernamepassword
+  SecretName: mqtt-bus
+`
+
+type validateTestWritable struct {
+	LogLevel  string
+	Telemetry bootstrapConfig.TelemetryInfo
+}
+
+// validateTestConfiguration is the service configuration used by the validate tests
+type validateTestConfiguration struct {
+	Writable   validateTestWritable
+	Service    bootstrapConfig.ServiceInfo
+	Registry   bootstrapConfig.RegistryInfo
+	MessageBus bootstrapConfig.MessageBusInfo
+}
+
+func (c *validateTestConfiguration) UpdateFromRaw(_ interface{}) bool {
+	return true
+}
+
+func (c *validateTestConfiguration) UpdateWritableFromRaw(_ interface{}) bool {
+	return true
+}
+
+func (c *validateTestConfiguration) EmptyWritablePtr() interface{} {
+	return &validateTestWritable{}
+}
+
+func (c *validateTestConfiguration) GetBootstrap() bootstrapConfig.BootstrapConfiguration {
+	return bootstrapConfig.BootstrapConfiguration{
+		Service:    &c.Service,
+		Registry:   &c.Registry,
+		MessageBus: &c.MessageBus,
+	}
+}
+
+func (c *validateTestConfiguration) GetLogLevel() string {
+	return c.Writable.LogLevel
+}
+
+func (c *validateTestConfiguration) GetRegistryInfo() bootstrapConfig.RegistryInfo {
+	return c.Registry
+}
+
+func (c *validateTestConfiguration) GetInsecureSecrets() bootstrapConfig.InsecureSecrets {
+	return nil
+}
+
+func (c *validateTestConfiguration) GetTelemetryInfo() *bootstrapConfig.TelemetryInfo {
+	return &c.Writable.Telemetry
+}
+
+func (c *validateTestConfiguration) GetWritablePtr() any {
+	return &c.Writable
+}
+
+func TestRunAndReturnWaitGroup_Validate(t *testing.T) {
+	configDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "configuration.yaml"), []byte(validateTestConfig), 0600))
+
+	tests := []struct {
+		name             string
+		secretExists     bool
+		secretErr        error
+		expectedExitCode int
+		expectedReport   string
+	}{
+		{"valid", true, nil, 0, "  PASS  Secret 'mqtt-bus'\n"},
+		{"missing secret", false, nil, 1, "  FAIL  Secret 'mqtt-bus': secret not found in the SecretStore\n"},
+		{"secret store error", false, errors.New("failed"), 1, "  FAIL  Secret 'mqtt-bus': failed\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			secretProvider := &mocks.SecretProviderExt{}
+			secretProvider.On("HasSecret", "mqtt-bus").Return(test.secretExists, test.secretErr)
+			defaultNewSecretProvider := newSecretProvider
+			newSecretProvider = func(_ interfaces.Configuration, _ *environment.Variables, _ context.Context, _ startup.Timer, _ *di.Container, _ string) (interfaces.SecretProviderExt, error) {
+				return secretProvider, nil
+			}
+			defer func() { newSecretProvider = defaultNewSecretProvider }()
+
+			exitCode := -1
+			exitFunc = func(code int) { exitCode = code }
+			defer func() { exitFunc = osExit }()
+
+			out := &bytes.Buffer{}
+			validateOutput = out
+			defer func() { validateOutput = os.Stdout }()
+
+			commonFlags := flags.New()
+			commonFlags.Parse([]string{"--validate", "-cd=" + configDir})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+			})
+
+			handlerCalled := false
+			handler := func(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, _ *di.Container) bool {
+				handlerCalled = true
+				return true
+			}
+
+			_, _, started := RunAndReturnWaitGroup(ctx, cancel, commonFlags, "unit-test", "edgex/v3",
+				&validateTestConfiguration{}, nil, startup.NewTimer(1, 1), dic, true, "unit-test",
+				[]interfaces.BootstrapHandler{handler})
+
+			assert.False(t, started)
+			assert.False(t, handlerCalled, "bootstrap handlers must not be run when validating")
+			assert.Equal(t, test.expectedExitCode, exitCode)
+
+			report := out.String()
+			assert.Contains(t, report, "  PASS  SecretProvider\n")
+			assert.Contains(t, report, "  PASS  Configuration\n")
+			assert.Contains(t, report, test.expectedReport)
+			assert.Contains(t, report, "  SKIP  Registry: service doesn't use the Registry\n")
+			if test.expectedExitCode == 0 {
+				assert.Contains(t, report, "Validation passed\n")
+			} else {
+				assert.Contains(t, report, "Validation failed: 1 of 4 checks failed\n")
+			}
+			secretProvider.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateServiceInvalidConfiguration(t *testing.T) {
+	configDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "configuration.yaml"), []byte("Writable: [invalid"), 0600))
+
+	commonFlags := flags.New()
+	commonFlags.Parse([]string{"--validate", "-cd=" + configDir})
+
+	lc := logger.NewMockClient()
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return lc
+		},
+	})
+
+	out := &bytes.Buffer{}
+	passed := validateService(context.Background(), commonFlags, environment.NewVariables(lc), "unit-test", "edgex/v3",
+		&validateTestConfiguration{}, startup.NewTimer(1, 1), dic, false, "unit-test", lc, out)
+
+	assert.False(t, passed)
+	assert.Contains(t, out.String(), "  FAIL  Configuration: ")
+	assert.Contains(t, out.String(), "Validation failed: 1 of 1 checks failed\n")
+}
+
+func TestConfiguredSecretNames(t *testing.T) {
+	configuration := bootstrapConfig.BootstrapConfiguration{
+		Service: &bootstrapConfig.ServiceInfo{
+			TLSSecretName: "http-tls",
+			GrpcServer:    bootstrapConfig.GrpcServerInfo{TLSSecretName: "grpc-tls"},
+		},
+		MessageBus: &bootstrapConfig.MessageBusInfo{AuthMode: "none", SecretName: "unused", TLSSecretName: "bus-tls"},
+		MessageBuses: map[string]bootstrapConfig.MessageBusInfo{
+			"north":    {AuthMode: "clientcert", SecretName: "north-bus"},
+			"disabled": {Disabled: true, AuthMode: "usernamepassword", SecretName: "disabled-bus"},
+		},
+		ExternalMQTT: &bootstrapConfig.ExternalMQTTInfo{Url: "tcp://broker:1883", AuthMode: "usernamepassword", SecretName: "mqtt"},
+	}
+
+	assert.Equal(t, []string{"bus-tls", "grpc-tls", "http-tls", "mqtt", "north-bus"}, configuredSecretNames(configuration))
+}
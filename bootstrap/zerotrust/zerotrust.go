@@ -14,11 +14,35 @@ import (
 )
 
 const (
-	OpenZitiControllerKey = "OpenZitiController"
-	ZeroTrustMode         = "zerotrust"
-	OpenZitiServicePrefix = "edgex."
+	OpenZitiControllerKey  = "OpenZitiController"
+	OpenZitiServiceNameKey = "OpenZitiServiceName"
+	ZeroTrustMode          = "zerotrust"
+	OpenZitiServicePrefix  = "edgex."
 )
 
+// ServiceDialer dials the OpenZiti service by its name over the overlay
+type ServiceDialer interface {
+	Dial(serviceName string) (net.Conn, error)
+}
+
+// contextDialer is the ServiceDialer which dials using the authenticated OpenZiti context
+type contextDialer struct {
+	ctx ziti.Context
+}
+
+func (d contextDialer) Dial(serviceName string) (net.Conn, error) {
+	return d.ctx.Dial(serviceName)
+}
+
+// newServiceDialer returns the ServiceDialer authenticated to the OpenZiti controller with the service's JWT
+var newServiceDialer = func(secretProvider interfaces.SecretProviderExt, ozController string) (ServiceDialer, error) {
+	ctx, err := authenticate(secretProvider, ozController)
+	if err != nil {
+		return nil, err
+	}
+	return contextDialer{ctx: ctx}, nil
+}
+
 func AuthToOpenZiti(ozController, jwt string) (ziti.Context, error) {
 	if !strings.Contains(ozController, "://") {
 		ozController = "https://" + ozController
@@ -74,7 +98,45 @@ func HttpTransportFromClient(secretProvider interfaces.SecretProviderExt, client
 	return roundTripper, nil
 }
 
-func createZitifiedTransport(secretProvider interfaces.SecretProviderExt, ozController string) (http.RoundTripper, error) {
+// HttpTransportFromClientService returns the transport for the client of the service. When zero trust is enabled the
+// transport dials the service's OpenZiti service over the overlay rather than the client's host and port, otherwise
+// the standard transport is used.
+func HttpTransportFromClientService(secretProvider interfaces.SecretProviderExt, serviceKey string, clientInfo *config.ClientInfo, lc logger.LoggingClient) (http.RoundTripper, error) {
+	if !secretProvider.IsZeroTrustEnabled() {
+		return http.DefaultTransport, nil
+	}
+
+	serviceName := ClientServiceName(serviceKey, clientInfo)
+	lc.Debugf("zero trust client detected for client: %s, using OpenZiti service name: %s", serviceKey, serviceName)
+
+	dialer, err := newServiceDialer(secretProvider, clientInfo.SecurityOptions[OpenZitiControllerKey])
+	if err != nil {
+		return nil, err
+	}
+
+	return newServiceTransport(dialer, serviceName), nil
+}
+
+// ClientServiceName returns the name of the OpenZiti service of the client's service, which is the name set in the
+// client's SecurityOptions or defaults to the name the service listens on, i.e. 'edgex.core-data'.
+func ClientServiceName(serviceKey string, clientInfo *config.ClientInfo) string {
+	if serviceName := clientInfo.SecurityOptions[OpenZitiServiceNameKey]; len(serviceName) > 0 {
+		return serviceName
+	}
+	return OpenZitiServicePrefix + serviceKey
+}
+
+// newServiceTransport returns the transport which dials the OpenZiti service for all requests, whatever their host
+func newServiceTransport(dialer ServiceDialer, serviceName string) http.RoundTripper {
+	zitiTransport := http.DefaultTransport.(*http.Transport).Clone() // copy default transport
+	zitiTransport.Proxy = nil                                        // the overlay is dialed directly
+	zitiTransport.DialContext = func(_ context.Context, _, _ string) (net.Conn, error) {
+		return dialer.Dial(serviceName)
+	}
+	return zitiTransport
+}
+
+func authenticate(secretProvider interfaces.SecretProviderExt, ozController string) (ziti.Context, error) {
 	jwt, errJwt := secretProvider.GetSelfJWT()
 	if errJwt != nil {
 		return nil, fmt.Errorf("could not load jwt: %v", errJwt)
@@ -83,6 +145,14 @@ func createZitifiedTransport(secretProvider interfaces.SecretProviderExt, ozCont
 	if authErr != nil {
 		return nil, fmt.Errorf("could not authenticate to OpenZiti: %v", authErr)
 	}
+	return ctx, nil
+}
+
+func createZitifiedTransport(secretProvider interfaces.SecretProviderExt, ozController string) (http.RoundTripper, error) {
+	ctx, err := authenticate(secretProvider, ozController)
+	if err != nil {
+		return nil, err
+	}
 
 	zitiContexts := ziti.NewSdkCollection()
 	zitiContexts.Add(ctx)
@@ -0,0 +1,107 @@
+/*******************************************************************************
+ * Copyright 2024 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package zerotrust
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
+
+// mockDialer dials the overlay's service, which is served by the test server, recording the service names dialed
+type mockDialer struct {
+	address  string
+	services []string
+}
+
+func (d *mockDialer) Dial(serviceName string) (net.Conn, error) {
+	d.services = append(d.services, serviceName)
+	return net.Dial("tcp", d.address)
+}
+
+func useMockDialer(t *testing.T, dialer *mockDialer) {
+	defaultServiceDialer := newServiceDialer
+	newServiceDialer = func(_ interfaces.SecretProviderExt, ozController string) (ServiceDialer, error) {
+		assert.Equal(t, "openziti:1280", ozController)
+		return dialer, nil
+	}
+	t.Cleanup(func() { newServiceDialer = defaultServiceDialer })
+}
+
+func TestHttpTransportFromClientService(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("pong"))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name            string
+		securityOptions map[string]string
+		expectedService string
+	}{
+		{"default service name", map[string]string{OpenZitiControllerKey: "openziti:1280"}, "edgex.core-data"},
+		{"configured service name", map[string]string{OpenZitiControllerKey: "openziti:1280", OpenZitiServiceNameKey: "core-data-ha"}, "core-data-ha"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dialer := &mockDialer{address: server.Listener.Addr().String()}
+			useMockDialer(t, dialer)
+
+			secretProvider := &mocks.SecretProviderExt{}
+			secretProvider.On("IsZeroTrustEnabled").Return(true)
+
+			clientInfo := &config.ClientInfo{Host: "unreachable.invalid", Port: 59880, Protocol: "http", SecurityOptions: test.securityOptions}
+			transport, err := HttpTransportFromClientService(secretProvider, "core-data", clientInfo, logger.NewMockClient())
+			require.NoError(t, err)
+
+			// The client's host isn't reachable, so the request only succeeds over the overlay
+			client := &http.Client{Transport: transport}
+			response, err := client.Get(clientInfo.Url() + "/api/v3/ping")
+			require.NoError(t, err)
+			defer func() { _ = response.Body.Close() }()
+
+			body, err := io.ReadAll(response.Body)
+			require.NoError(t, err)
+			assert.Equal(t, "pong", string(body))
+			assert.Equal(t, []string{test.expectedService}, dialer.services)
+		})
+	}
+}
+
+func TestHttpTransportFromClientServiceZeroTrustDisabled(t *testing.T) {
+	dialer := &mockDialer{}
+	useMockDialer(t, dialer)
+
+	secretProvider := &mocks.SecretProviderExt{}
+	secretProvider.On("IsZeroTrustEnabled").Return(false)
+
+	clientInfo := &config.ClientInfo{Host: "localhost", Port: 59880, Protocol: "http"}
+	transport, err := HttpTransportFromClientService(secretProvider, "core-data", clientInfo, logger.NewMockClient())
+	require.NoError(t, err)
+
+	assert.Equal(t, http.DefaultTransport, transport)
+	assert.Empty(t, dialer.services)
+}
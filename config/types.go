@@ -18,11 +18,14 @@ package config
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
 	"github.com/edgexfoundry/go-mod-secrets/v3/secrets"
+	"github.com/hashicorp/go-multierror"
 )
 
 const (
@@ -40,37 +43,96 @@ const (
 	CommonConfigDone = "IsCommonConfigReady"
 )
 
+// MinimumMetricInterval is the shortest reporting interval allowed for a metric's interval override
+const MinimumMetricInterval = time.Second
+
+// topicPlaceholderRegex matches the well-formed {name} placeholders allowed in a topic prefix
+var topicPlaceholderRegex = regexp.MustCompile(`\{[^{}/]+\}`)
+
+// DefaultHistogramPercentiles are the percentiles reported for Histogram metrics when none are configured
+var DefaultHistogramPercentiles = []float64{50, 75, 95, 99}
+
 // ServiceInfo contains configuration settings necessary for the basic operation of any EdgeX service.
 type ServiceInfo struct {
 	// HealthCheckInterval is the interval for Registry heal check callback
 	HealthCheckInterval string
+	// HealthCheckType is the type of health check the service is registered with in the Registry, which is 'http'
+	// (the default) for the Registry to call the ping endpoint, 'tcp' for the Registry to connect to the service's
+	// port or 'ttl' for the service to send heartbeats within the HealthCheckInterval using the RegistryHeartbeat.
+	HealthCheckType string
 	// Host is the hostname or IP address of the service.
 	Host string
 	// Port is the HTTP port of the service.
 	Port int
 	// ServerBindAddr specifies an IP address or hostname
-	// for ListenAndServe to bind to, such as 0.0.0.0
+	// for ListenAndServe to bind to, such as 0.0.0.0, :: for IPv6 and IPv4
+	// (dual-stack) or a bracketed IPv6 literal such as [::1], or a unix:// address,
+	// such as unix:///run/edgex/core-data.sock, to listen on a Unix domain socket
 	ServerBindAddr string
+	// UnixSocketFileMode is the octal file mode of the Unix domain socket
+	// when ServerBindAddr is a unix:// address. Defaults to 0660.
+	UnixSocketFileMode string
 	// StartupMsg specifies a string to log once service
 	// initialization and startup is completed.
 	StartupMsg string
 	// MaxResultCount specifies the maximum size list supported
 	// in response to REST calls to other services.
 	MaxResultCount int
-	// MaxRequestSize defines the maximum size of http request body in kilobytes
+	// MaxRequestSize defines the maximum size of http request body in kilobytes. Defaults to 32768 (32 MB) when not
+	// set, a negative size is unlimited.
 	MaxRequestSize int64
+	// MaxResponseSize defines the maximum size of http response body in kilobytes, which cuts short the responses
+	// streamed past it. Unlimited when not set.
+	MaxResponseSize int64
 	// RequestTimeout specifies a timeout (in milliseconds) for
 	// processing REST request calls from other services.
 	RequestTimeout string
+	// ShutdownTimeout is how long the HTTP server waits on shutdown for the in-flight requests to finish, after it
+	// stops accepting new connections, before closing their connections, i.e. "30s". Defaults to 30s when not set.
+	ShutdownTimeout string
 	// EnableNameFieldEscape indicates whether enables NameFieldEscape in this service
 	// The name field escape could allow the system to use special or Chinese characters in the different name fields, including device, profile, and so on.  If the EnableNameFieldEscape is false, some special characters might cause system error.
 	// TODO: remove in EdgeX 4.0
 	EnableNameFieldEscape bool
 	// CORSConfiguration defines the cross-origin resource sharing related settings
 	CORSConfiguration CORSConfigurationInfo
+	// RateLimit defines the limits on the rate of requests served by the HTTP server, which is unlimited by default
+	RateLimit RateLimitInfo
+	// Compression defines the compression of the HTTP server's responses, which is off by default
+	Compression CompressionInfo
+	// Profiling defines the serving of the net/http/pprof profiling handlers, which is off by default
+	Profiling ProfilingInfo
 	// SecurityOptions is a key/value map, used for configuring hosted services. Currently used for zero trust but
 	// could be for other options additional security related configuration
 	SecurityOptions map[string]string
+	// TLSSecretName is the optional name of the secret holding the PEM encoded certificate and private key, with the
+	// "cert" and "key" keys, used by the HTTP server for TLS. The certificate is reloaded when the secret is updated,
+	// so it is rotated without restarting the service. TLS is not used when neither it nor TLSCertFile is set.
+	TLSSecretName string
+	// RequireClientCert indicates whether the HTTP server requires and verifies the callers' client certificates
+	// (mutual TLS) against the PEM encoded CA certificate with the "cacert" key in the TLSSecretName secret. Callers
+	// without a valid certificate are rejected. Requires TLSSecretName, or TLSCACertFile when the certificate is
+	// loaded from files, to be set.
+	RequireClientCert bool
+	// TLSCertFile and TLSKeyFile are the optional paths of the PEM encoded certificate and private key files used by
+	// the HTTP server for TLS when TLSSecretName is not set. Unlike the secret, the files are only loaded on startup.
+	TLSCertFile string
+	TLSKeyFile  string
+	// TLSCACertFile is the optional path of the PEM encoded CA certificate file the callers' client certificates are
+	// verified against when RequireClientCert is set and the certificate is loaded from files.
+	TLSCACertFile string
+	// GrpcServer defines the settings of the gRPC server, which is only started by services using the GrpcServer
+	// bootstrap handler
+	GrpcServer GrpcServerInfo
+}
+
+// GrpcServerInfo defines the settings of the service's gRPC server
+type GrpcServerInfo struct {
+	// Port is the port the gRPC server listens on. The gRPC server binds to the same address as the HTTP server.
+	Port int
+	// TLSSecretName is the optional name of the secret holding the PEM encoded certificate and private key, with the
+	// "cert" and "key" keys, used by the gRPC server for TLS. TLS is not used when not set.
+	TLSSecretName string
 }
 
 // HealthCheck is a URL specifying a health check REST endpoint used by the Registry to determine if the
@@ -87,6 +149,42 @@ func (s ServiceInfo) Url() string {
 	return url
 }
 
+// RateLimitInfo defines the limits on the rate of requests served by the HTTP server, across all callers and per caller
+// IP address. Requests over the limits are rejected with 429 Too Many Requests. A rate of 0 is unlimited.
+type RateLimitInfo struct {
+	// RequestsPerSecond is the sustained rate of requests served across all callers
+	RequestsPerSecond float64
+	// Burst is the number of requests served across all callers in a burst above the RequestsPerSecond. Defaults to
+	// the RequestsPerSecond when not set.
+	Burst int
+	// PerClientRequestsPerSecond is the sustained rate of requests served for each caller IP address
+	PerClientRequestsPerSecond float64
+	// PerClientBurst is the number of requests served for each caller IP address in a burst above the
+	// PerClientRequestsPerSecond. Defaults to the PerClientRequestsPerSecond when not set.
+	PerClientBurst int
+}
+
+// CompressionInfo defines the gzip or deflate compression of the HTTP server's responses, as accepted by the caller
+type CompressionInfo struct {
+	// Enabled indicates whether the responses are compressed
+	Enabled bool
+	// MinSize is the size in bytes below which responses are not compressed, as compressing them isn't worthwhile.
+	// Defaults to 1024 when not set.
+	MinSize int
+}
+
+// ProfilingInfo defines the serving of the net/http/pprof profiling handlers at /debug/pprof/
+type ProfilingInfo struct {
+	// Enabled indicates whether the profiling handlers are served. Only enable when diagnosing a service, as the
+	// profiles expose the service's internals.
+	Enabled bool
+	// Port is the admin port the profiling handlers are served on, bound to the same address as the HTTP server. The
+	// handlers are served by the HTTP server itself, behind the same authentication as the common admin routes, when
+	// not set. The admin port uses the HTTP server's TLS settings, so callers must present a valid client certificate
+	// when RequireClientCert is set, in which case Port must be set.
+	Port int
+}
+
 // CORSConfigurationInfo defines the cross-origin resource sharing related settings
 type CORSConfigurationInfo struct {
 	// EnableCORS indicates whether enables CORS in this service
@@ -94,10 +192,12 @@ type CORSConfigurationInfo struct {
 	// CORSAllowCredentials defines the value of Access-Control-Allow-Credentials in the response header.
 	// The Access-Control-Allow-Credentials response header tells browsers whether to expose the response
 	// to the frontend JavaScript code when the request's credentials mode (Request.credentials) is included.
+	// It is never sent for origins allowed by "*" in CORSAllowedOrigin.
 	CORSAllowCredentials bool
 	// CORSAllowedOrigin defines the value of Access-Control-Allow-Origin in the response header.
 	// The Access-Control-Allow-Origin response header indicates whether the response can be shared
-	// with requesting code from the given origin.
+	// with requesting code from the given origin. Multiple origins are comma separated, "*" allows any origin.
+	// The CORS headers are only set for the requests from the allowed origins.
 	CORSAllowedOrigin string
 	// CORSAllowedMethods defines the value of Access-Control-Allow-Methods in the response header.
 	// The Access-Control-Allow-Methods response header specifies one or more methods allowed when
@@ -129,8 +229,17 @@ type RegistryInfo struct {
 	Host string
 	// Port is the port number that the Registry client is listening
 	Port int
-	// Type is the type of Registry client to use, i.e. 'consul'
+	// Type is the type of Registry client to use, i.e. 'consul' or 'kubernetes'
 	Type string
+	// TokenRenewInterval is how often a new Registry access token is generated and used by the Registry client when
+	// security is enabled, i.e. "30m", which must be shorter than the access token's TTL. Not renewed when not set.
+	TokenRenewInterval string
+	// DrainGracePeriod is how long the service is marked as draining in the Registry on shutdown, failing its health
+	// so upstreams stop routing to it, before it is un-registered, i.e. "10s". Un-registered immediately when not set.
+	DrainGracePeriod string
+	// Namespace is the Kubernetes namespace in which the client services are discovered when the Registry Type is
+	// 'kubernetes'. Defaults to the namespace of the service's pod when not set.
+	Namespace string
 }
 
 // ClientInfo provides the host and port of another service in the eco-system.
@@ -146,6 +255,30 @@ type ClientInfo struct {
 	// SecurityOptions is a key/value map, used for configuring clients. Currently used for zero trust but
 	// could be for other options additional security related configuration
 	SecurityOptions map[string]string
+	// LoadBalancePolicy is how the client's requests are spread across the healthy instances of the service in the
+	// Registry, either 'round-robin' or 'least-connections'. Requests all go to the one resolved instance when not set.
+	LoadBalancePolicy string
+	// InstanceRefreshInterval is how often the healthy instances of the service are refreshed from the Registry when
+	// load balancing, i.e. "10s". Defaults to 30s when not set.
+	InstanceRefreshInterval string
+	// MinimumVersion is the minimum semantic version of the service, i.e. "3.1.0", the service depends on. The service
+	// fails to start when the version reported by the service is older. The version isn't checked when not set.
+	MinimumVersion string
+	// VersionConstraint is the semantic version range the version of the service must satisfy, i.e. ">=2.3.0 <3.0.0",
+	// with the alternatives separated by "||". The version isn't checked when not set.
+	VersionConstraint string
+	// ConnectTimeout is how long to wait for the connection to the service to be established, i.e. "5s".
+	// Defaults to 5s when not set.
+	ConnectTimeout string
+	// ResponseHeaderTimeout is how long to wait for the service's response headers once the request has been sent,
+	// i.e. "30s". Defaults to 30s when not set.
+	ResponseHeaderTimeout string
+	// Timeout is the overall time limit of a request to the service, including connecting and reading the response
+	// body, i.e. "60s". Defaults to 60s when not set.
+	Timeout string
+	// CircuitBreaker defines the retrying of the failed requests to the service and the failing fast of the requests
+	// while the service is failing, which are both off by default
+	CircuitBreaker CircuitBreakerInfo
 }
 
 func (c ClientInfo) Url() string {
@@ -153,17 +286,40 @@ func (c ClientInfo) Url() string {
 	return url
 }
 
+// CircuitBreakerInfo defines the circuit breaker of a client, which stops sending requests to the service once a number
+// of consecutive requests have failed, and the retrying of its failed idempotent requests. A request fails when it
+// can't be sent or the service responds with a 5xx status.
+type CircuitBreakerInfo struct {
+	// FailureThreshold is the number of consecutive failed requests which opens the circuit breaker, so the requests
+	// fail immediately without being sent. The circuit breaker never opens when not set.
+	FailureThreshold int
+	// Cooldown is how long the circuit breaker stays open before a trial request is sent to the service, i.e. "30s",
+	// which closes the circuit breaker when it succeeds. Defaults to 30s when not set.
+	Cooldown string
+	// Retries is the number of times a failed idempotent request is retried while the circuit breaker is closed.
+	// Not retried when not set.
+	Retries int
+	// RetryInterval is how long to wait before retrying a failed request, i.e. "500ms". Retried immediately when not
+	// set.
+	RetryInterval string
+}
+
 // SecretStoreInfo encapsulates configuration properties used to create a SecretClient.
 type SecretStoreInfo struct {
-	Type           string
-	Host           string
-	Port           int
-	StoreName      string
-	Protocol       string
+	// Type is the type of the SecretStore, "vault" by default or "azurekeyvault" for Azure Key Vault, in which case the
+	// Host is the vault's host name, i.e. "my-vault.vault.azure.net", and the Port and Protocol are not used.
+	Type      string
+	Host      string
+	Port      int
+	StoreName string
+	Protocol  string
+	// Namespace is the optional Vault Enterprise namespace of the service's secrets, which allows multiple tenants to
+	// share a SecretStore. When set it is sent as the X-Vault-Namespace header on the secret requests.
 	Namespace      string
 	RootCaCertPath string
 	ServerName     string
-	Authentication types.AuthenticationInfo
+	// Authentication is redacted when the configuration is logged or returned from the /config endpoint
+	Authentication types.AuthenticationInfo `secret:"true"`
 	// TokenFile provides a location to a token file.
 	TokenFile string
 	// SecretsFile is optional Path to JSON file containing secrets to seed into service's SecretStore
@@ -174,6 +330,9 @@ type SecretStoreInfo struct {
 
 	// RuntimeTokenProvider is optional if not using delayed start from spiffe-token provider
 	RuntimeTokenProvider types.RuntimeTokenProviderInfo
+	// CacheTTL optionally limits how long the secrets retrieved from the SecretStore are cached for to the time
+	// duration, i.e. "5m". When not set the cached secrets are kept until secrets are stored.
+	CacheTTL string
 }
 
 func NewSecretStoreInfo(serviceKey string) SecretStoreInfo {
@@ -213,16 +372,45 @@ type Database struct {
 	Name    string
 }
 
+// Validate checks that the Database settings are valid. A Database with no settings is considered not configured
+// and is valid.
+func (d Database) Validate() error {
+	if d == (Database{}) {
+		return nil
+	}
+
+	var result error
+	if len(d.Type) == 0 {
+		result = multierror.Append(result, fmt.Errorf("Type is required"))
+	}
+	if len(d.Host) == 0 {
+		result = multierror.Append(result, fmt.Errorf("Host is required"))
+	}
+	if d.Port <= 0 || d.Port > 65535 {
+		result = multierror.Append(result, fmt.Errorf("Port %d is not a valid port number", d.Port))
+	}
+	if len(d.Timeout) > 0 {
+		timeout, err := time.ParseDuration(d.Timeout)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("Timeout '%s' is invalid time duration: %s", d.Timeout, err.Error()))
+		} else if timeout < 0 {
+			result = multierror.Append(result, fmt.Errorf("Timeout '%s' must not be negative", d.Timeout))
+		}
+	}
+
+	return result
+}
+
 // Credentials encapsulates username-password attributes.
 type Credentials struct {
 	Username string
-	Password string
+	Password string `secret:"true"`
 }
 
 // CertKeyPair encapsulates public certificate/private key pair for an SSL certificate
 type CertKeyPair struct {
 	Cert string
-	Key  string
+	Key  string `secret:"true"`
 }
 
 // InsecureSecrets is used to hold the secrets stored in the configuration
@@ -231,20 +419,26 @@ type InsecureSecrets map[string]InsecureSecretsInfo
 // InsecureSecretsInfo encapsulates info used to retrieve insecure secrets
 type InsecureSecretsInfo struct {
 	SecretName string
-	SecretData map[string]string
+	SecretData map[string]string `secret:"true"`
 }
 
 // ClientsCollection is a collection of Client information for communicating to dependent clients.
 type ClientsCollection map[string]*ClientInfo
 
 // BootstrapConfiguration defines the configuration elements required by the bootstrap.
+// Databases are the additional named databases, i.e. a read replica or a time-series database, for services which
+// use more than one database. The key is the name the database's resolved credentials are stored under in the DIC.
+// MessageBuses are the additional named MessageBus connections, i.e. for services which bridge two brokers. The key
+// is the name the connected MessageClient is stored under in the DIC.
 type BootstrapConfiguration struct {
 	Clients      *ClientsCollection
 	Service      *ServiceInfo
 	Config       *ConfigProviderInfo
 	Registry     *RegistryInfo
 	MessageBus   *MessageBusInfo
+	MessageBuses map[string]MessageBusInfo
 	Database     *Database
+	Databases    map[string]Database
 	ExternalMQTT *ExternalMQTTInfo
 }
 
@@ -268,9 +462,19 @@ type MessageBusInfo struct {
 	// dynamically loaded using this name and store the Option property below where the implementation expected to
 	// find them.
 	SecretName string
+	// TLSSecretName is the name of the secret in the SecretStore that contains the client certificate and key and/or
+	// the CA certificate used for a TLS connection to the message bus, independent of the AuthMode. The connection is
+	// not TLS when not set, unless the AuthMode uses certificates.
+	TLSSecretName string
 	// BaseTopicPrefix is the base topic prefix that all topics start with.
 	// If not set the DefaultBaseTopic constant is used.
 	BaseTopicPrefix string
+	// SubscriberWaitTopics are the topics, under the BaseTopicPrefix, which must have a subscriber before the service
+	// is ready, so the first messages published on startup aren't lost. Not waited for when empty.
+	SubscriberWaitTopics []string
+	// SubscriberWaitDelay is how long to wait, i.e. "5s", instead of waiting for the subscribers when the MessageBus
+	// can't report the subscribers to a topic. Defaults to 5s when not set.
+	SubscriberWaitDelay string
 	// Provides additional configuration properties which do not fit within the existing field.
 	// Typically, the key is the name of the configuration property and the value is a string representation of the
 	// desired value for the configuration property.
@@ -337,6 +541,118 @@ type TelemetryInfo struct {
 	// Tags is a list of service level tags that are attached to every metric reported for the service
 	// Example: Gateway = "Gateway123"
 	Tags map[string]string
+	// HistogramPercentiles is the list of percentiles (i.e. 50, 95) reported for each Histogram metric, each of which
+	// must be greater than 0 and at most 100. If not set the DefaultHistogramPercentiles are reported.
+	HistogramPercentiles []float64
+	// BatchPublish indicates whether all metrics collected in a reporting interval are published as a single
+	// JSON array to the base metrics topic rather than individually to each metric's own topic.
+	BatchPublish bool
+	// CounterDeltas indicates whether Counter metrics also report the change in count since the previous report,
+	// which avoids consumers having to calculate the rate from the cumulative count.
+	CounterDeltas bool
+	// MaxMetricsPerReport caps the number of metrics published to the MessageBus in each report, so a registry which
+	// grows large, i.e. with per-device metrics, doesn't flood the broker. When there are more enabled metrics than
+	// the cap, the remainder are published in the following reports in a round-robin fashion. Not capped when 0.
+	MaxMetricsPerReport int
+	// MetricIntervals optionally overrides the reporting Interval for individual metrics. The key is the configured
+	// Metric name and the value is the time duration in which to report that metric. The name is matched as a prefix
+	// of the registered metric names, an exact match or else the longest matching name taking precedence.
+	// Example: MyMetric = "5s"
+	MetricIntervals map[string]string
+	// PublishTopicPrefix optionally overrides the topic prefix the metrics are published under, which by default is
+	// <BaseTopicPrefix>/telemetry/<service-name>. It may contain placeholders such as {env} and {service} which
+	// are expanded from the service's topic substitutions when the metrics are reported.
+	// Example: PublishTopicPrefix = "edgex/{env}/metrics/{service}"
+	PublishTopicPrefix string
+	// RuntimeMetrics indicates whether the Go runtime metrics, i.e. RuntimeGoroutines, RuntimeHeapAlloc and
+	// RuntimeGCPauseTotal, are reported along with the service's metrics. They don't need to be listed in Metrics.
+	// Changes only take effect when the service is restarted.
+	RuntimeMetrics bool
+	// RequestLatencyMetrics indicates whether the latency of the requests served by the HTTP server is recorded in a
+	// Timer per method and route, which are reported as HttpRequestLatency with the method and route as tags. They
+	// don't need to be listed in Metrics. Changes only take effect when the service is restarted.
+	RequestLatencyMetrics bool
+}
+
+// GetMetricIntervals returns the parsed per-metric reporting interval overrides.
+// An error is returned if any of the intervals is not a valid time duration or is less than MinimumMetricInterval.
+func (t *TelemetryInfo) GetMetricIntervals() (map[string]time.Duration, error) {
+	intervals := make(map[string]time.Duration)
+	for metricName, value := range t.MetricIntervals {
+		interval, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("interval for metric '%s' is invalid time duration: %s", metricName, err.Error())
+		}
+
+		if interval < MinimumMetricInterval {
+			return nil, fmt.Errorf("interval '%s' for metric '%s' is less than the minimum of %s", value, metricName, MinimumMetricInterval.String())
+		}
+
+		intervals[metricName] = interval
+	}
+
+	return intervals, nil
+}
+
+// Validate checks that the Telemetry settings are valid so that invalid settings are reported at startup rather than
+// when the metrics are reported.
+func (t *TelemetryInfo) Validate() error {
+	var result error
+
+	if len(t.Interval) > 0 {
+		interval, err := time.ParseDuration(t.Interval)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("Interval '%s' is invalid time duration: %s", t.Interval, err.Error()))
+		} else if interval < 0 {
+			result = multierror.Append(result, fmt.Errorf("Interval '%s' must not be negative", t.Interval))
+		}
+	}
+
+	if _, err := t.GetMetricIntervals(); err != nil {
+		result = multierror.Append(result, fmt.Errorf("MetricIntervals %s", err.Error()))
+	}
+
+	for _, percentile := range t.HistogramPercentiles {
+		if !(percentile > 0 && percentile <= 100) {
+			result = multierror.Append(result, fmt.Errorf("HistogramPercentiles value '%v' must be greater than 0 and at most 100", percentile))
+		}
+	}
+
+	if len(t.PublishTopicPrefix) > 0 {
+		if err := validateTopicPrefix(t.PublishTopicPrefix); err != nil {
+			result = multierror.Append(result, fmt.Errorf("PublishTopicPrefix '%s' %s", t.PublishTopicPrefix, err.Error()))
+		}
+	}
+
+	return result
+}
+
+// validateTopicPrefix checks the topic prefix has no empty levels, wildcards or malformed {name} placeholders
+func validateTopicPrefix(prefix string) error {
+	if strings.ContainsAny(prefix, "#+") {
+		return fmt.Errorf("must not contain the '#' or '+' wildcards")
+	}
+
+	for _, level := range strings.Split(prefix, "/") {
+		if len(level) == 0 {
+			return fmt.Errorf("must not contain empty levels")
+		}
+	}
+
+	if strings.ContainsAny(topicPlaceholderRegex.ReplaceAllString(prefix, ""), "{}") {
+		return fmt.Errorf("has malformed placeholders, which must be of the form {name}")
+	}
+
+	return nil
+}
+
+// GetHistogramPercentiles returns the configured Histogram percentiles or the defaults if none are configured.
+func (t *TelemetryInfo) GetHistogramPercentiles() []float64 {
+	if len(t.HistogramPercentiles) == 0 {
+		return DefaultHistogramPercentiles
+	}
+
+	return t.HistogramPercentiles
 }
 
 // GetEnabledMetricName returns the matching configured Metric name and if it is enabled.
@@ -16,8 +16,10 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTelemetryInfo_MetricEnabled(t *testing.T) {
@@ -51,3 +53,108 @@ func TestTelemetryInfo_MetricEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestTelemetryInfo_GetHistogramPercentiles(t *testing.T) {
+	target := TelemetryInfo{}
+	assert.Equal(t, DefaultHistogramPercentiles, target.GetHistogramPercentiles())
+
+	target.HistogramPercentiles = []float64{50, 99}
+	assert.Equal(t, []float64{50, 99}, target.GetHistogramPercentiles())
+}
+
+func TestTelemetryInfo_GetMetricIntervals(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Intervals     map[string]string
+		Expected      map[string]time.Duration
+		ErrorContains string
+	}{
+		{"Valid", map[string]string{"MyMetric": "1s", "YourMetric": "1m"},
+			map[string]time.Duration{"MyMetric": time.Second, "YourMetric": time.Minute}, ""},
+		{"None", nil, map[string]time.Duration{}, ""},
+		{"Invalid duration", map[string]string{"MyMetric": "one second"}, nil, "invalid time duration"},
+		{"Below minimum", map[string]string{"MyMetric": "10ms"}, nil, "less than the minimum"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			target := TelemetryInfo{MetricIntervals: test.Intervals}
+			actual, err := target.GetMetricIntervals()
+			if len(test.ErrorContains) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.ErrorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.Expected, actual)
+		})
+	}
+}
+
+func TestTelemetryInfo_Validate(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Telemetry     TelemetryInfo
+		ErrorContains string
+	}{
+		{"Valid", TelemetryInfo{Interval: "30s", MetricIntervals: map[string]string{"MyMetric": "5s"}, PublishTopicPrefix: "edgex/{env}/metrics/{service}"}, ""},
+		{"Valid - not configured", TelemetryInfo{}, ""},
+		{"Valid - disabled", TelemetryInfo{Interval: "0s"}, ""},
+		{"Invalid interval", TelemetryInfo{Interval: "thirty seconds"}, "Interval 'thirty seconds' is invalid time duration"},
+		{"Negative interval", TelemetryInfo{Interval: "-30s"}, "Interval '-30s' must not be negative"},
+		{"Valid percentiles", TelemetryInfo{HistogramPercentiles: []float64{0.1, 50, 99.9, 100}}, ""},
+		{"Zero percentile", TelemetryInfo{HistogramPercentiles: []float64{0, 50}}, "HistogramPercentiles value '0' must be greater than 0"},
+		{"Negative percentile", TelemetryInfo{HistogramPercentiles: []float64{-5}}, "HistogramPercentiles value '-5' must be greater than 0"},
+		{"Percentile over 100", TelemetryInfo{HistogramPercentiles: []float64{50, 100.5}}, "HistogramPercentiles value '100.5' must be greater than 0 and at most 100"},
+		{"Invalid metric interval", TelemetryInfo{MetricIntervals: map[string]string{"MyMetric": "10ms"}}, "MetricIntervals interval '10ms' for metric 'MyMetric'"},
+		{"Topic prefix with wildcard", TelemetryInfo{PublishTopicPrefix: "edgex/#"}, "PublishTopicPrefix 'edgex/#' must not contain"},
+		{"Topic prefix with empty level", TelemetryInfo{PublishTopicPrefix: "edgex//metrics"}, "must not contain empty levels"},
+		{"Topic prefix with malformed placeholder", TelemetryInfo{PublishTopicPrefix: "edgex/{service"}, "malformed placeholders"},
+		{"Topic prefix with empty placeholder", TelemetryInfo{PublishTopicPrefix: "edgex/{}"}, "malformed placeholders"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Telemetry.Validate()
+			if len(test.ErrorContains) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.ErrorContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDatabase_Validate(t *testing.T) {
+	valid := Database{Type: "postgres", Host: "localhost", Port: 5432, Timeout: "5s", Name: "edgex"}
+
+	tests := []struct {
+		Name          string
+		Database      func(database Database) Database
+		ErrorContains string
+	}{
+		{"Valid", func(database Database) Database { return database }, ""},
+		{"Valid - not configured", func(_ Database) Database { return Database{} }, ""},
+		{"Missing type", func(database Database) Database { database.Type = ""; return database }, "Type is required"},
+		{"Missing host", func(database Database) Database { database.Host = ""; return database }, "Host is required"},
+		{"Invalid port", func(database Database) Database { database.Port = 70000; return database }, "Port 70000 is not a valid port number"},
+		{"Invalid timeout", func(database Database) Database { database.Timeout = "5"; return database }, "Timeout '5' is invalid time duration"},
+		{"Negative timeout", func(database Database) Database { database.Timeout = "-5s"; return database }, "Timeout '-5s' must not be negative"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Database(valid).Validate()
+			if len(test.ErrorContains) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.ErrorContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -64,6 +64,8 @@
 package di
 
 import (
+	"fmt"
+	"sort"
 	"sync"
 )
 
@@ -79,19 +81,40 @@ type ServiceConstructorMap map[string]ServiceConstructor
 type service struct {
 	constructor ServiceConstructor
 	instance    interface{}
+	constructed bool
+}
+
+// Watcher is notified with the name of a service after its registration has been updated or replaced, so holders of
+// the previous instance can get the new one from the Container.
+type Watcher func(serviceName string)
+
+type watcher struct {
+	id     uint64
+	notify Watcher
+}
+
+// Registration describes a service registered in the Container without exposing its instance. Type is the concrete
+// type of the instance, which is empty when the instance hasn't been constructed yet.
+type Registration struct {
+	Name string
+	Type string
 }
 
 // Container is a receiver that maintains a list of services, their constructors, and their constructed instances in a
-// thread-safe manner.
+// thread-safe manner. A child Container resolves the services it doesn't register from its parent.
 type Container struct {
-	serviceMap map[string]service
-	mutex      sync.RWMutex
+	parent        *Container
+	serviceMap    map[string]service
+	watchers      map[string][]watcher
+	nextWatcherId uint64
+	mutex         sync.RWMutex
 }
 
 // NewContainer is a factory method that returns an initialized Container receiver struct.
 func NewContainer(serviceConstructors ServiceConstructorMap) *Container {
 	c := Container{
 		serviceMap: map[string]service{},
+		watchers:   map[string][]watcher{},
 		mutex:      sync.RWMutex{},
 	}
 	if serviceConstructors != nil {
@@ -100,29 +123,108 @@ func NewContainer(serviceConstructors ServiceConstructorMap) *Container {
 	return &c
 }
 
-// Set updates its internal serviceMap with the contents of the provided ServiceConstructorMap.
+// NewChild returns a Container which resolves the provided services itself and falls through to c for the services it
+// doesn't register, i.e. to override some services for the scope of a request. The child's services may depend on
+// the parent's, while the parent is never changed by, nor resolves services from, the child. The child's watchers
+// are only notified of the updates and replacements made to the child.
+func (c *Container) NewChild(serviceConstructors ServiceConstructorMap) *Container {
+	child := NewContainer(serviceConstructors)
+	child.parent = c
+	return child
+}
+
+// Update updates its internal serviceMap with the contents of the provided ServiceConstructorMap and notifies the
+// watchers of the updated services.
 func (c *Container) Update(serviceConstructors ServiceConstructorMap) {
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
+	var notify []func()
 	for serviceName, constructor := range serviceConstructors {
 		c.serviceMap[serviceName] = service{
 			constructor: constructor,
 			instance:    nil,
+			constructed: false,
+		}
+		notify = append(notify, c.notifier(serviceName))
+	}
+	c.mutex.Unlock()
+
+	for _, n := range notify {
+		n()
+	}
+}
+
+// Replace atomically swaps the instance of the named service, registering it when absent, and then notifies the
+// service's watchers. Concurrent calls to Get return either the previous or the new instance.
+func (c *Container) Replace(serviceName string, instance interface{}) {
+	c.mutex.Lock()
+	c.serviceMap[serviceName] = service{
+		constructor: func(get Get) interface{} { return instance },
+		instance:    instance,
+		constructed: true,
+	}
+	notify := c.notifier(serviceName)
+	c.mutex.Unlock()
+
+	notify()
+}
+
+// Watch registers the watcher to be notified after the named service is updated or replaced, and returns the function
+// which unregisters it. The watchers are called in registration order, outside the Container's lock, so they may get
+// the new instance from the Container.
+func (c *Container) Watch(serviceName string, w Watcher) (cancel func()) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.nextWatcherId++
+	id := c.nextWatcherId
+	c.watchers[serviceName] = append(c.watchers[serviceName], watcher{id: id, notify: w})
+
+	return func() {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		watchers := c.watchers[serviceName]
+		for i := range watchers {
+			if watchers[i].id == id {
+				// copy so a notification in progress keeps iterating its own snapshot
+				c.watchers[serviceName] = append(append([]watcher{}, watchers[:i]...), watchers[i+1:]...)
+				break
+			}
+		}
+		if len(c.watchers[serviceName]) == 0 {
+			delete(c.watchers, serviceName)
+		}
+	}
+}
+
+// notifier returns the function which notifies the current watchers of the named service. It must be called while
+// holding the lock, and the returned function called after releasing it.
+func (c *Container) notifier(serviceName string) func() {
+	watchers := c.watchers[serviceName]
+	return func() {
+		for _, w := range watchers {
+			w.notify(serviceName)
 		}
 	}
 }
 
 // get looks up the requested serviceName and, if it exists, returns a constructed instance.  If the requested service
 // does not exist, it returns nil.  Get wraps instance construction in a singleton; the implementation assumes an instance,
-// once constructed, will be reused and returned for all subsequent get(serviceName) calls.
+// once constructed, will be reused and returned for all subsequent get(serviceName) calls.  Construction is deferred
+// until the first get, so expensive services that are never used are never constructed, and the constructor is
+// invoked at most once, even when it returns nil.
 func (c *Container) get(serviceName string) interface{} {
 	service, ok := c.serviceMap[serviceName]
+	if !ok && c.parent != nil {
+		return c.parent.Get(serviceName)
+	}
 	if !ok {
 		// Returning nil allows the DIC to be queried for a object and not panic if it doesn't exist.
 		return nil
 	}
-	if service.instance == nil {
+	if !service.constructed {
 		service.instance = service.constructor(c.get)
+		service.constructed = true
 		c.serviceMap[serviceName] = service
 	}
 	return service.instance
@@ -134,3 +236,67 @@ func (c *Container) Get(serviceName string) interface{} {
 	defer c.mutex.Unlock()
 	return c.get(serviceName)
 }
+
+// GetOk wraps get to make it thread-safe and also returns whether the requested serviceName has been registered.
+// This distinguishes a service that is not registered from one that has been registered with a nil instance.
+func (c *Container) GetOk(serviceName string) (interface{}, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.serviceMap[serviceName]; !ok {
+		if c.parent != nil {
+			return c.parent.GetOk(serviceName)
+		}
+		return nil, false
+	}
+
+	return c.get(serviceName), true
+}
+
+// Names returns the sorted names of the registered services, including the ones a child resolves from its parent.
+func (c *Container) Names() []string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	names := make([]string, 0, len(c.serviceMap))
+	if c.parent != nil {
+		for _, serviceName := range c.parent.Names() {
+			if _, ok := c.serviceMap[serviceName]; !ok {
+				names = append(names, serviceName)
+			}
+		}
+	}
+	for serviceName := range c.serviceMap {
+		names = append(names, serviceName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Registrations returns the registered services sorted by name. The services are not constructed by this call, so
+// only the services which have already been constructed report their type. A child includes the registrations it
+// resolves from its parent.
+func (c *Container) Registrations() []Registration {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	registrations := make([]Registration, 0, len(c.serviceMap))
+	if c.parent != nil {
+		for _, registration := range c.parent.Registrations() {
+			if _, ok := c.serviceMap[registration.Name]; !ok {
+				registrations = append(registrations, registration)
+			}
+		}
+	}
+	for serviceName, service := range c.serviceMap {
+		registration := Registration{Name: serviceName}
+		if service.constructed {
+			registration.Type = fmt.Sprintf("%T", service.instance)
+		}
+		registrations = append(registrations, registration)
+	}
+	sort.Slice(registrations, func(i, j int) bool {
+		return registrations[i].Name < registrations[j].Name
+	})
+	return registrations
+}
@@ -15,9 +15,12 @@
 package di
 
 import (
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const serviceName = "serviceName"
@@ -106,3 +109,250 @@ func TestGetInsideGetReturnsAsExpected(t *testing.T) {
 	assert.NotNil(t, result.Foo)
 	assert.Equal(t, fooName, result.Foo.FooMessage)
 }
+
+func TestGetOk(t *testing.T) {
+	type serviceType struct{}
+	service := &serviceType{}
+
+	tests := []struct {
+		name               string
+		constructors       ServiceConstructorMap
+		expectedInstance   interface{}
+		expectedRegistered bool
+	}{
+		{"registered non-nil", ServiceConstructorMap{serviceName: func(get Get) interface{} { return service }}, service, true},
+		{"registered nil", ServiceConstructorMap{serviceName: func(get Get) interface{} { return nil }}, nil, true},
+		{"unregistered", ServiceConstructorMap{}, nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sut := NewContainer(test.constructors)
+
+			result, registered := sut.GetOk(serviceName)
+
+			assert.Equal(t, test.expectedInstance, result)
+			assert.Equal(t, test.expectedRegistered, registered)
+		})
+	}
+}
+
+func TestGetConstructsOnceUnderConcurrentAccess(t *testing.T) {
+	type serviceType struct{}
+	mutex := sync.Mutex{}
+	constructedCount := 0
+	sut := NewContainer(ServiceConstructorMap{
+		serviceName: func(get Get) interface{} {
+			mutex.Lock()
+			defer mutex.Unlock()
+			constructedCount++
+			return &serviceType{}
+		},
+	})
+
+	const goroutines = 50
+	results := make([]interface{}, goroutines)
+	wg := sync.WaitGroup{}
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			results[index] = sut.Get(serviceName)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, constructedCount)
+	for _, result := range results {
+		assert.Same(t, results[0], result)
+	}
+}
+
+func TestGetConstructsNilInstanceOnce(t *testing.T) {
+	constructedCount := 0
+	sut := NewContainer(ServiceConstructorMap{
+		serviceName: func(get Get) interface{} {
+			constructedCount++
+			return nil
+		},
+	})
+
+	assert.Equal(t, 0, constructedCount)
+	assert.Nil(t, sut.Get(serviceName))
+	assert.Nil(t, sut.Get(serviceName))
+	assert.Equal(t, 1, constructedCount)
+}
+
+func TestNames(t *testing.T) {
+	sut := NewContainer(nil)
+	assert.Empty(t, sut.Names())
+
+	var serviceConstructor = func(get Get) interface{} { return nil }
+	sut.Update(ServiceConstructorMap{
+		"zeta":  serviceConstructor,
+		"alpha": serviceConstructor,
+		"mu":    serviceConstructor,
+	})
+
+	assert.Equal(t, []string{"alpha", "mu", "zeta"}, sut.Names())
+}
+
+func TestRegistrations(t *testing.T) {
+	type serviceType struct{}
+	constructed := false
+	sut := NewContainer(ServiceConstructorMap{
+		"lazy": func(get Get) interface{} {
+			constructed = true
+			return &serviceType{}
+		},
+		"nil":    func(get Get) interface{} { return nil },
+		"string": func(get Get) interface{} { return "value" },
+	})
+	sut.Get("nil")
+	sut.Get("string")
+
+	expected := []Registration{
+		{Name: "lazy"},
+		{Name: "nil", Type: "<nil>"},
+		{Name: "string", Type: "string"},
+	}
+	assert.Equal(t, expected, sut.Registrations())
+	assert.False(t, constructed, "listing the registrations must not construct the services")
+
+	sut.Get("lazy")
+	assert.Equal(t, Registration{Name: "lazy", Type: "*di.serviceType"}, sut.Registrations()[0])
+}
+
+func TestReplace(t *testing.T) {
+	const original = "original"
+	const replacement = "replacement"
+	sut := NewContainer(ServiceConstructorMap{serviceName: func(get Get) interface{} { return original }})
+	require.Equal(t, original, sut.Get(serviceName))
+
+	var notified []string
+	var instanceOnNotify interface{}
+	cancel := sut.Watch(serviceName, func(name string) {
+		notified = append(notified, name)
+		instanceOnNotify = sut.Get(name)
+	})
+	otherNotified := false
+	sut.Watch("otherService", func(string) { otherNotified = true })
+
+	sut.Replace(serviceName, replacement)
+
+	assert.Equal(t, replacement, sut.Get(serviceName))
+	assert.Equal(t, []string{serviceName}, notified)
+	assert.Equal(t, replacement, instanceOnNotify)
+	assert.False(t, otherNotified)
+
+	sut.Update(ServiceConstructorMap{serviceName: func(get Get) interface{} { return original }})
+	assert.Equal(t, []string{serviceName, serviceName}, notified)
+	assert.Equal(t, original, instanceOnNotify)
+
+	cancel()
+	sut.Replace(serviceName, replacement)
+	assert.Len(t, notified, 2)
+	assert.Equal(t, replacement, sut.Get(serviceName))
+}
+
+func TestReplaceRegistersAbsentService(t *testing.T) {
+	sut := NewContainer(nil)
+	notified := false
+	sut.Watch(serviceName, func(string) { notified = true })
+
+	sut.Replace(serviceName, "value")
+
+	result, ok := sut.GetOk(serviceName)
+	assert.True(t, ok)
+	assert.Equal(t, "value", result)
+	assert.True(t, notified)
+}
+
+func TestReplaceUnderConcurrentAccess(t *testing.T) {
+	sut := NewContainer(ServiceConstructorMap{serviceName: func(get Get) interface{} { return 0 }})
+	var notifications atomic.Int64
+	sut.Watch(serviceName, func(string) { notifications.Add(1) })
+
+	const replacements = 100
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 1; i <= replacements; i++ {
+			sut.Replace(serviceName, i)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		previous := 0
+		for i := 0; i < replacements; i++ {
+			current := sut.Get(serviceName).(int)
+			assert.GreaterOrEqual(t, current, previous)
+			previous = current
+		}
+	}()
+	wg.Wait()
+
+	assert.Equal(t, replacements, sut.Get(serviceName))
+	assert.Equal(t, int64(replacements), notifications.Load())
+}
+
+func TestNewChild(t *testing.T) {
+	type logger struct{ scope string }
+	type handler struct{ lc *logger }
+	parent := NewContainer(ServiceConstructorMap{
+		"logger":  func(get Get) interface{} { return &logger{scope: "global"} },
+		"handler": func(get Get) interface{} { return &handler{lc: get("logger").(*logger)} },
+		"config":  func(get Get) interface{} { return "config" },
+	})
+	child := parent.NewChild(ServiceConstructorMap{
+		"logger":  func(get Get) interface{} { return &logger{scope: "request"} },
+		"handler": func(get Get) interface{} { return &handler{lc: get("logger").(*logger)} },
+		"request": func(get Get) interface{} { return get("config").(string) + "/request" },
+	})
+
+	// overrides resolve locally, including their dependencies
+	assert.Equal(t, "request", child.Get("logger").(*logger).scope)
+	assert.Equal(t, "request", child.Get("handler").(*handler).lc.scope)
+	assert.Equal(t, "config/request", child.Get("request"))
+
+	// unset names fall through to the parent
+	assert.Equal(t, "config", child.Get("config"))
+	result, ok := child.GetOk("config")
+	assert.True(t, ok)
+	assert.Equal(t, "config", result)
+	_, ok = child.GetOk("unknownService")
+	assert.False(t, ok)
+	assert.Nil(t, child.Get("unknownService"))
+
+	// the parent isn't changed by the child
+	assert.Equal(t, "global", parent.Get("logger").(*logger).scope)
+	assert.Equal(t, "global", parent.Get("handler").(*handler).lc.scope)
+	assert.Nil(t, parent.Get("request"))
+	assert.Equal(t, []string{"config", "handler", "logger"}, parent.Names())
+	assert.Equal(t, []string{"config", "handler", "logger", "request"}, child.Names())
+
+	// the child resolves the parent's current instance
+	parent.Replace("config", "reloaded")
+	assert.Equal(t, "reloaded", child.Get("config"))
+	child.Replace("logger", &logger{scope: "replaced"})
+	assert.Equal(t, "global", parent.Get("logger").(*logger).scope)
+}
+
+func TestNewChildRegistrations(t *testing.T) {
+	parent := NewContainer(ServiceConstructorMap{
+		"parentOnly": func(get Get) interface{} { return 1 },
+		"overridden": func(get Get) interface{} { return 2 },
+	})
+	parent.Get("parentOnly")
+	parent.Get("overridden")
+	child := parent.NewChild(ServiceConstructorMap{
+		"overridden": func(get Get) interface{} { return "child" },
+	})
+
+	expected := []Registration{
+		{Name: "overridden"},
+		{Name: "parentOnly", Type: "int"},
+	}
+	assert.Equal(t, expected, child.Registrations())
+}